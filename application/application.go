@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
@@ -16,6 +17,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -92,6 +94,15 @@ func (s *server) Start() error {
 		if err := s.dep.CredManager().Upsert(context.Background(), credentials...); err != nil {
 			return fmt.Errorf("failed to upsert OneDrive credentials to CredManager: %w", err)
 		}
+
+		// Initialize Google Drive credentials
+		gdCredentials, err := googledrive.RetrieveGoogleDriveCredentials(context.Background(), s.dep.StoragePolicyClient())
+		if err != nil {
+			return fmt.Errorf("failed to retrieve Google Drive credentials for CredManager: %w", err)
+		}
+		if err := s.dep.CredManager().Upsert(context.Background(), gdCredentials...); err != nil {
+			return fmt.Errorf("failed to upsert Google Drive credentials to CredManager: %w", err)
+		}
 		crontab.Register(setting.CronTypeOauthCredRefresh, func(ctx context.Context) {
 			dep := dependency.FromContext(ctx)
 			cred := dep.CredManager()
@@ -124,7 +135,16 @@ func (s *server) Start() error {
 	s.dep.ThumbQueue(context.Background()).Start()
 
 	api := routers.InitRouter(s.dep)
-	api.TrustedPlatform = s.config.System().ProxyHeader
+	// TrustedPlatform 会无条件采信请求头，改为与 X-Forwarded-For 一样仅采信来自可信代理的请求
+	if header := s.config.System().ProxyHeader; header != "" {
+		api.RemoteIPHeaders = append([]string{header}, api.RemoteIPHeaders...)
+	}
+	if err := api.SetTrustedProxies(s.config.System().TrustedProxies); err != nil {
+		return fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+	if slices.Equal(s.config.System().TrustedProxies, conf.SystemConfig.TrustedProxies) {
+		s.logger.Warning("Client IP headers are only trusted from loopback addresses, set \"TrustedProxies\" in [System] section of config file if Cloudreve is behind a reverse proxy or CDN on another host.")
+	}
 	s.server = &http.Server{Handler: api}
 
 	// 如果启用了SSL
@@ -26,6 +26,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-contrib/static"
@@ -74,6 +75,9 @@ type Dep interface {
 	GroupClient() inventory.GroupClient
 	// EmailClient Get a singleton email.Driver instance for sending emails.
 	EmailClient(ctx context.Context) email.Driver
+	// SMSProvider Get a singleton sms.SMSProvider instance built from site settings. If settings are invalid,
+	// the returned provider reports the configuration error on sending.
+	SMSProvider(ctx context.Context) sms.SMSProvider
 	// GeneralAuth Get a singleton auth.Auth instance for general authentication.
 	GeneralAuth() auth.Auth
 	// Shutdown the dependencies gracefully.
@@ -86,6 +90,20 @@ type Dep interface {
 	DavAccountClient() inventory.DavAccountClient
 	// DirectLinkClient Creates a new inventory.DirectLinkClient instance for access DB direct link store.
 	DirectLinkClient() inventory.DirectLinkClient
+	// CourseClient Creates a new inventory.CourseClient instance for access DB course store.
+	CourseClient() inventory.CourseClient
+	// InstitutionClient Creates a new inventory.InstitutionClient instance for access DB institution directory.
+	InstitutionClient() inventory.InstitutionClient
+	// ExternalIdentityClient Creates a new inventory.ExternalIdentityClient instance for access DB linked SSO identities.
+	ExternalIdentityClient() inventory.ExternalIdentityClient
+	// AuditLogClient Creates a new inventory.AuditLogClient instance for access DB audit logs.
+	AuditLogClient() inventory.AuditLogClient
+	// LoginSessionClient Creates a new inventory.LoginSessionClient instance for access DB login sessions.
+	LoginSessionClient() inventory.LoginSessionClient
+	// PersonalAccessTokenClient Creates a new inventory.PersonalAccessTokenClient instance for access DB personal access tokens.
+	PersonalAccessTokenClient() inventory.PersonalAccessTokenClient
+	// OAuthAppClient Creates a new inventory.OAuthAppClient instance for access DB OAuth apps and grants.
+	OAuthAppClient() inventory.OAuthAppClient
 	// HashIDEncoder Get a singleton hashid.Encoder instance for encoding/decoding hashids.
 	HashIDEncoder() hashid.Encoder
 	// TokenAuth Get a singleton auth.TokenAuth instance for token authentication.
@@ -156,7 +174,15 @@ type dependency struct {
 	nodeClient            inventory.NodeClient
 	davAccountClient      inventory.DavAccountClient
 	directLinkClient      inventory.DirectLinkClient
+	courseClient          inventory.CourseClient
+	institutionClient     inventory.InstitutionClient
+	identityClient        inventory.ExternalIdentityClient
+	auditLogClient        inventory.AuditLogClient
+	loginSessionClient    inventory.LoginSessionClient
+	patClient             inventory.PersonalAccessTokenClient
+	oauthAppClient        inventory.OAuthAppClient
 	emailClient           email.Driver
+	smsProvider           sms.SMSProvider
 	generalAuth           auth.Auth
 	hashidEncoder         hashid.Encoder
 	tokenAuth             auth.TokenAuth
@@ -463,6 +489,23 @@ func (d *dependency) EmailClient(ctx context.Context) email.Driver {
 	return d.emailClient
 }
 
+func (d *dependency) SMSProvider(ctx context.Context) sms.SMSProvider {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if reload, _ := ctx.Value(ReloadCtx{}).(bool); reload || d.smsProvider == nil {
+		l := d.Logger()
+		provider, err := sms.NewProvider(d.SettingProvider().SMS(ctx), l, d.RequestClient(request.WithLogger(l)))
+		if err != nil {
+			l.Warning("Failed to initialize SMS provider: %s", err)
+			provider = sms.NewErrorProvider(err)
+		}
+		d.smsProvider = provider
+	}
+
+	return d.smsProvider
+}
+
 func (d *dependency) MimeDetector(ctx context.Context) mime.MimeDetector {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -576,7 +619,8 @@ func (d *dependency) IoIntenseQueue(ctx context.Context) queue.Queue {
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("IoIntenseQueue"),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
-		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType),
+		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType,
+			queue.ReapplyMappingTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
 	return d.ioIntenseQueue
@@ -724,6 +768,62 @@ func (d *dependency) DavAccountClient() inventory.DavAccountClient {
 	return inventory.NewDavAccountClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
 }
 
+func (d *dependency) CourseClient() inventory.CourseClient {
+	if d.courseClient != nil {
+		return d.courseClient
+	}
+
+	return inventory.NewCourseClient(d.DBClient())
+}
+
+func (d *dependency) InstitutionClient() inventory.InstitutionClient {
+	if d.institutionClient != nil {
+		return d.institutionClient
+	}
+
+	return inventory.NewInstitutionClient(d.DBClient())
+}
+
+func (d *dependency) ExternalIdentityClient() inventory.ExternalIdentityClient {
+	if d.identityClient != nil {
+		return d.identityClient
+	}
+
+	return inventory.NewExternalIdentityClient(d.DBClient())
+}
+
+func (d *dependency) AuditLogClient() inventory.AuditLogClient {
+	if d.auditLogClient != nil {
+		return d.auditLogClient
+	}
+
+	return inventory.NewAuditLogClient(d.DBClient())
+}
+
+func (d *dependency) LoginSessionClient() inventory.LoginSessionClient {
+	if d.loginSessionClient != nil {
+		return d.loginSessionClient
+	}
+
+	return inventory.NewLoginSessionClient(d.DBClient())
+}
+
+func (d *dependency) PersonalAccessTokenClient() inventory.PersonalAccessTokenClient {
+	if d.patClient != nil {
+		return d.patClient
+	}
+
+	return inventory.NewPersonalAccessTokenClient(d.DBClient())
+}
+
+func (d *dependency) OAuthAppClient() inventory.OAuthAppClient {
+	if d.oauthAppClient != nil {
+		return d.oauthAppClient
+	}
+
+	return inventory.NewOAuthAppClient(d.DBClient())
+}
+
 func (d *dependency) DirectLinkClient() inventory.DirectLinkClient {
 	if d.directLinkClient != nil {
 		return d.directLinkClient
@@ -765,7 +865,7 @@ func (d *dependency) TokenAuth() auth.TokenAuth {
 	}
 
 	d.tokenAuth = auth.NewTokenAuth(d.HashIDEncoder(), d.SettingProvider(),
-		[]byte(d.SettingProvider().SecretKey(context.Background())), d.UserClient(), d.Logger(), d.KV())
+		[]byte(d.SettingProvider().SecretKey(context.Background())), d.UserClient(), d.LoginSessionClient(), d.Logger(), d.KV())
 	return d.tokenAuth
 }
 
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/roster"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importGroup  int
+	importDryRun bool
+	importFormat string
+)
+
+func init() {
+	rootCmd.AddCommand(usersCmd)
+	usersCmd.AddCommand(usersImportCmd)
+
+	usersImportCmd.Flags().IntVarP(&importGroup, "group", "g", 0, "Group ID for rows without group column, defaults to site default group")
+	usersImportCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Validate the roster and print the report without writing to database")
+	usersImportCmd.Flags().StringVarP(&importFormat, "format", "f", "", "Roster format (csv or xlsx), inferred from file extension if not set")
+}
+
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "User account management",
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var usersImportCmd = &cobra.Command{
+	Use:   "import <roster file>",
+	Short: "Create or update student accounts from a CSV/XLSX roster",
+	Long: `Create or update student accounts from a CSV/XLSX roster.
+Columns: student ID, name, phone, university, major, group. A header line with
+column names (English or Chinese) can be used to reorder or omit columns.
+Existing users are matched by phone or student ID, so the same roster can be imported again safely.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		dep := dependency.NewDependency(
+			dependency.WithConfigPath(confPath),
+		)
+		logger := dep.Logger()
+
+		content, err := os.ReadFile(args[0])
+		if err != nil {
+			logger.Error("Failed to read roster file: %s", err)
+			os.Exit(1)
+		}
+
+		format := roster.Format(importFormat)
+		if format == "" {
+			format = roster.FormatFromName(args[0])
+		}
+
+		rows, err := roster.Parse(content, format)
+		if err != nil {
+			logger.Error("Failed to parse roster: %s", err)
+			os.Exit(1)
+		}
+
+		if importGroup == 0 {
+			importGroup = dep.SettingProvider().DefaultGroup(ctx)
+		}
+
+		importer, err := roster.NewImporter(ctx, dep.UserClient(), dep.GroupClient(), dep.InstitutionClient(), importGroup,
+			dep.SettingProvider().InstitutionDirectoryEnforced(ctx), importDryRun)
+		if err != nil {
+			logger.Error("Failed to initialize importer: %s", err)
+			os.Exit(1)
+		}
+
+		report := importer.Import(ctx, rows)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "LINE\tSTUDENT ID\tNAME\tPHONE\tRESULT\tUSER ID\tERROR")
+		for _, row := range report.Rows {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%s\n", row.Line, row.StudentID, row.Name, row.Phone, row.Action, row.UserID, row.Error)
+		}
+		_ = w.Flush()
+
+		if report.DryRun {
+			logger.Info("Dry run, no change is written.")
+		}
+		logger.Info("%d rows in total: %d created, %d updated, %d unchanged, %d failed.",
+			report.Total, report.Created, report.Updated, report.Unchanged, report.Failed)
+
+		if report.Failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
@@ -0,0 +1,1091 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AssignmentCreate is the builder for creating a Assignment entity.
+type AssignmentCreate struct {
+	config
+	mutation *AssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AssignmentCreate) SetCreatedAt(t time.Time) *AssignmentCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableCreatedAt(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AssignmentCreate) SetUpdatedAt(t time.Time) *AssignmentCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableUpdatedAt(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ac *AssignmentCreate) SetDeletedAt(t time.Time) *AssignmentCreate {
+	ac.mutation.SetDeletedAt(t)
+	return ac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableDeletedAt(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetDeletedAt(*t)
+	}
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *AssignmentCreate) SetName(s string) *AssignmentCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetCourseID sets the "course_id" field.
+func (ac *AssignmentCreate) SetCourseID(i int) *AssignmentCreate {
+	ac.mutation.SetCourseID(i)
+	return ac
+}
+
+// SetFolderID sets the "folder_id" field.
+func (ac *AssignmentCreate) SetFolderID(i int) *AssignmentCreate {
+	ac.mutation.SetFolderID(i)
+	return ac
+}
+
+// SetDeadline sets the "deadline" field.
+func (ac *AssignmentCreate) SetDeadline(t time.Time) *AssignmentCreate {
+	ac.mutation.SetDeadline(t)
+	return ac
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (ac *AssignmentCreate) SetLatePolicy(ap assignment.LatePolicy) *AssignmentCreate {
+	ac.mutation.SetLatePolicy(ap)
+	return ac
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableLatePolicy(ap *assignment.LatePolicy) *AssignmentCreate {
+	if ap != nil {
+		ac.SetLatePolicy(*ap)
+	}
+	return ac
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (ac *AssignmentCreate) SetLateDeadline(t time.Time) *AssignmentCreate {
+	ac.mutation.SetLateDeadline(t)
+	return ac
+}
+
+// SetNillableLateDeadline sets the "late_deadline" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableLateDeadline(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetLateDeadline(*t)
+	}
+	return ac
+}
+
+// SetSettings sets the "settings" field.
+func (ac *AssignmentCreate) SetSettings(ts *types.AssignmentSetting) *AssignmentCreate {
+	ac.mutation.SetSettings(ts)
+	return ac
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (ac *AssignmentCreate) SetCourse(c *Course) *AssignmentCreate {
+	return ac.SetCourseID(c.ID)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (ac *AssignmentCreate) Mutation() *AssignmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Assignment in the database.
+func (ac *AssignmentCreate) Save(ctx context.Context) (*Assignment, error) {
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AssignmentCreate) SaveX(ctx context.Context) *Assignment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AssignmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AssignmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AssignmentCreate) defaults() error {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		if assignment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized assignment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := assignment.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		if assignment.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized assignment.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := assignment.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ac.mutation.LatePolicy(); !ok {
+		v := assignment.DefaultLatePolicy
+		ac.mutation.SetLatePolicy(v)
+	}
+	if _, ok := ac.mutation.Settings(); !ok {
+		v := assignment.DefaultSettings
+		ac.mutation.SetSettings(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AssignmentCreate) check() error {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Assignment.created_at"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Assignment.updated_at"`)}
+	}
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Assignment.name"`)}
+	}
+	if v, ok := ac.mutation.Name(); ok {
+		if err := assignment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Assignment.name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "Assignment.course_id"`)}
+	}
+	if _, ok := ac.mutation.FolderID(); !ok {
+		return &ValidationError{Name: "folder_id", err: errors.New(`ent: missing required field "Assignment.folder_id"`)}
+	}
+	if _, ok := ac.mutation.Deadline(); !ok {
+		return &ValidationError{Name: "deadline", err: errors.New(`ent: missing required field "Assignment.deadline"`)}
+	}
+	if _, ok := ac.mutation.LatePolicy(); !ok {
+		return &ValidationError{Name: "late_policy", err: errors.New(`ent: missing required field "Assignment.late_policy"`)}
+	}
+	if v, ok := ac.mutation.LatePolicy(); ok {
+		if err := assignment.LatePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "late_policy", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_policy": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "Assignment.course"`)}
+	}
+	return nil
+}
+
+func (ac *AssignmentCreate) sqlSave(ctx context.Context) (*Assignment, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AssignmentCreate) createSpec() (*Assignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Assignment{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(assignment.Table, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt))
+	)
+
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = ac.conflict
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(assignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ac.mutation.DeletedAt(); ok {
+		_spec.SetField(assignment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(assignment.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.FolderID(); ok {
+		_spec.SetField(assignment.FieldFolderID, field.TypeInt, value)
+		_node.FolderID = value
+	}
+	if value, ok := ac.mutation.Deadline(); ok {
+		_spec.SetField(assignment.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = value
+	}
+	if value, ok := ac.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeEnum, value)
+		_node.LatePolicy = value
+	}
+	if value, ok := ac.mutation.LateDeadline(); ok {
+		_spec.SetField(assignment.FieldLateDeadline, field.TypeTime, value)
+		_node.LateDeadline = &value
+	}
+	if value, ok := ac.mutation.Settings(); ok {
+		_spec.SetField(assignment.FieldSettings, field.TypeJSON, value)
+		_node.Settings = value
+	}
+	if nodes := ac.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.CourseTable,
+			Columns: []string{assignment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Assignment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AssignmentCreate) OnConflict(opts ...sql.ConflictOption) *AssignmentUpsertOne {
+	ac.conflict = opts
+	return &AssignmentUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AssignmentCreate) OnConflictColumns(columns ...string) *AssignmentUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AssignmentUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AssignmentUpsertOne is the builder for "upsert"-ing
+	//  one Assignment node.
+	AssignmentUpsertOne struct {
+		create *AssignmentCreate
+	}
+
+	// AssignmentUpsert is the "OnConflict" setter.
+	AssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsert) SetUpdatedAt(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateUpdatedAt() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AssignmentUpsert) SetDeletedAt(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateDeletedAt() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AssignmentUpsert) ClearDeletedAt() *AssignmentUpsert {
+	u.SetNull(assignment.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AssignmentUpsert) SetName(v string) *AssignmentUpsert {
+	u.Set(assignment.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateName() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldName)
+	return u
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *AssignmentUpsert) SetCourseID(v int) *AssignmentUpsert {
+	u.Set(assignment.FieldCourseID, v)
+	return u
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateCourseID() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldCourseID)
+	return u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *AssignmentUpsert) SetFolderID(v int) *AssignmentUpsert {
+	u.Set(assignment.FieldFolderID, v)
+	return u
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateFolderID() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldFolderID)
+	return u
+}
+
+// AddFolderID adds v to the "folder_id" field.
+func (u *AssignmentUpsert) AddFolderID(v int) *AssignmentUpsert {
+	u.Add(assignment.FieldFolderID, v)
+	return u
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *AssignmentUpsert) SetDeadline(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldDeadline, v)
+	return u
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateDeadline() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldDeadline)
+	return u
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (u *AssignmentUpsert) SetLatePolicy(v assignment.LatePolicy) *AssignmentUpsert {
+	u.Set(assignment.FieldLatePolicy, v)
+	return u
+}
+
+// UpdateLatePolicy sets the "late_policy" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateLatePolicy() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldLatePolicy)
+	return u
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (u *AssignmentUpsert) SetLateDeadline(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldLateDeadline, v)
+	return u
+}
+
+// UpdateLateDeadline sets the "late_deadline" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateLateDeadline() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldLateDeadline)
+	return u
+}
+
+// ClearLateDeadline clears the value of the "late_deadline" field.
+func (u *AssignmentUpsert) ClearLateDeadline() *AssignmentUpsert {
+	u.SetNull(assignment.FieldLateDeadline)
+	return u
+}
+
+// SetSettings sets the "settings" field.
+func (u *AssignmentUpsert) SetSettings(v *types.AssignmentSetting) *AssignmentUpsert {
+	u.Set(assignment.FieldSettings, v)
+	return u
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateSettings() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldSettings)
+	return u
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *AssignmentUpsert) ClearSettings() *AssignmentUpsert {
+	u.SetNull(assignment.FieldSettings)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AssignmentUpsertOne) UpdateNewValues() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(assignment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AssignmentUpsertOne) Ignore() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssignmentUpsertOne) DoNothing() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssignmentCreate.OnConflict
+// documentation for more info.
+func (u *AssignmentUpsertOne) Update(set func(*AssignmentUpsert)) *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsertOne) SetUpdatedAt(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateUpdatedAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AssignmentUpsertOne) SetDeletedAt(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateDeletedAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AssignmentUpsertOne) ClearDeletedAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AssignmentUpsertOne) SetName(v string) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateName() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *AssignmentUpsertOne) SetCourseID(v int) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetCourseID(v)
+	})
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateCourseID() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateCourseID()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *AssignmentUpsertOne) SetFolderID(v int) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// AddFolderID adds v to the "folder_id" field.
+func (u *AssignmentUpsertOne) AddFolderID(v int) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.AddFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateFolderID() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *AssignmentUpsertOne) SetDeadline(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateDeadline() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (u *AssignmentUpsertOne) SetLatePolicy(v assignment.LatePolicy) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetLatePolicy(v)
+	})
+}
+
+// UpdateLatePolicy sets the "late_policy" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateLatePolicy() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateLatePolicy()
+	})
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (u *AssignmentUpsertOne) SetLateDeadline(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetLateDeadline(v)
+	})
+}
+
+// UpdateLateDeadline sets the "late_deadline" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateLateDeadline() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateLateDeadline()
+	})
+}
+
+// ClearLateDeadline clears the value of the "late_deadline" field.
+func (u *AssignmentUpsertOne) ClearLateDeadline() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearLateDeadline()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *AssignmentUpsertOne) SetSettings(v *types.AssignmentSetting) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateSettings() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *AssignmentUpsertOne) ClearSettings() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearSettings()
+	})
+}
+
+// Exec executes the query.
+func (u *AssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AssignmentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AssignmentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *AssignmentCreate) SetRawID(t int) *AssignmentCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// AssignmentCreateBulk is the builder for creating many Assignment entities in bulk.
+type AssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*AssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Assignment entities in the database.
+func (acb *AssignmentCreateBulk) Save(ctx context.Context) ([]*Assignment, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Assignment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AssignmentCreateBulk) SaveX(ctx context.Context) []*Assignment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Assignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *AssignmentUpsertBulk {
+	acb.conflict = opts
+	return &AssignmentUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AssignmentCreateBulk) OnConflictColumns(columns ...string) *AssignmentUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AssignmentUpsertBulk{
+		create: acb,
+	}
+}
+
+// AssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Assignment nodes.
+type AssignmentUpsertBulk struct {
+	create *AssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AssignmentUpsertBulk) UpdateNewValues() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(assignment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AssignmentUpsertBulk) Ignore() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssignmentUpsertBulk) DoNothing() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *AssignmentUpsertBulk) Update(set func(*AssignmentUpsert)) *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsertBulk) SetUpdatedAt(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateUpdatedAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AssignmentUpsertBulk) SetDeletedAt(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateDeletedAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AssignmentUpsertBulk) ClearDeletedAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AssignmentUpsertBulk) SetName(v string) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateName() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *AssignmentUpsertBulk) SetCourseID(v int) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetCourseID(v)
+	})
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateCourseID() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateCourseID()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *AssignmentUpsertBulk) SetFolderID(v int) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// AddFolderID adds v to the "folder_id" field.
+func (u *AssignmentUpsertBulk) AddFolderID(v int) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.AddFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateFolderID() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *AssignmentUpsertBulk) SetDeadline(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateDeadline() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (u *AssignmentUpsertBulk) SetLatePolicy(v assignment.LatePolicy) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetLatePolicy(v)
+	})
+}
+
+// UpdateLatePolicy sets the "late_policy" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateLatePolicy() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateLatePolicy()
+	})
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (u *AssignmentUpsertBulk) SetLateDeadline(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetLateDeadline(v)
+	})
+}
+
+// UpdateLateDeadline sets the "late_deadline" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateLateDeadline() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateLateDeadline()
+	})
+}
+
+// ClearLateDeadline clears the value of the "late_deadline" field.
+func (u *AssignmentUpsertBulk) ClearLateDeadline() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearLateDeadline()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *AssignmentUpsertBulk) SetSettings(v *types.AssignmentSetting) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateSettings() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *AssignmentUpsertBulk) ClearSettings() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearSettings()
+	})
+}
+
+// Exec executes the query.
+func (u *AssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AssignmentUpdate is the builder for updating Assignment entities.
+type AssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (au *AssignmentUpdate) Where(ps ...predicate.Assignment) *AssignmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AssignmentUpdate) SetUpdatedAt(t time.Time) *AssignmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AssignmentUpdate) SetDeletedAt(t time.Time) *AssignmentUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableDeletedAt(t *time.Time) *AssignmentUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AssignmentUpdate) ClearDeletedAt() *AssignmentUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AssignmentUpdate) SetName(s string) *AssignmentUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableName(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// SetCourseID sets the "course_id" field.
+func (au *AssignmentUpdate) SetCourseID(i int) *AssignmentUpdate {
+	au.mutation.SetCourseID(i)
+	return au
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableCourseID(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetCourseID(*i)
+	}
+	return au
+}
+
+// SetFolderID sets the "folder_id" field.
+func (au *AssignmentUpdate) SetFolderID(i int) *AssignmentUpdate {
+	au.mutation.ResetFolderID()
+	au.mutation.SetFolderID(i)
+	return au
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableFolderID(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetFolderID(*i)
+	}
+	return au
+}
+
+// AddFolderID adds i to the "folder_id" field.
+func (au *AssignmentUpdate) AddFolderID(i int) *AssignmentUpdate {
+	au.mutation.AddFolderID(i)
+	return au
+}
+
+// SetDeadline sets the "deadline" field.
+func (au *AssignmentUpdate) SetDeadline(t time.Time) *AssignmentUpdate {
+	au.mutation.SetDeadline(t)
+	return au
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableDeadline(t *time.Time) *AssignmentUpdate {
+	if t != nil {
+		au.SetDeadline(*t)
+	}
+	return au
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (au *AssignmentUpdate) SetLatePolicy(ap assignment.LatePolicy) *AssignmentUpdate {
+	au.mutation.SetLatePolicy(ap)
+	return au
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableLatePolicy(ap *assignment.LatePolicy) *AssignmentUpdate {
+	if ap != nil {
+		au.SetLatePolicy(*ap)
+	}
+	return au
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (au *AssignmentUpdate) SetLateDeadline(t time.Time) *AssignmentUpdate {
+	au.mutation.SetLateDeadline(t)
+	return au
+}
+
+// SetNillableLateDeadline sets the "late_deadline" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableLateDeadline(t *time.Time) *AssignmentUpdate {
+	if t != nil {
+		au.SetLateDeadline(*t)
+	}
+	return au
+}
+
+// ClearLateDeadline clears the value of the "late_deadline" field.
+func (au *AssignmentUpdate) ClearLateDeadline() *AssignmentUpdate {
+	au.mutation.ClearLateDeadline()
+	return au
+}
+
+// SetSettings sets the "settings" field.
+func (au *AssignmentUpdate) SetSettings(ts *types.AssignmentSetting) *AssignmentUpdate {
+	au.mutation.SetSettings(ts)
+	return au
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (au *AssignmentUpdate) ClearSettings() *AssignmentUpdate {
+	au.mutation.ClearSettings()
+	return au
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (au *AssignmentUpdate) SetCourse(c *Course) *AssignmentUpdate {
+	return au.SetCourseID(c.ID)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (au *AssignmentUpdate) Mutation() *AssignmentMutation {
+	return au.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (au *AssignmentUpdate) ClearCourse() *AssignmentUpdate {
+	au.mutation.ClearCourse()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AssignmentUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AssignmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AssignmentUpdate) defaults() error {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		if assignment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized assignment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := assignment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AssignmentUpdate) check() error {
+	if v, ok := au.mutation.Name(); ok {
+		if err := assignment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Assignment.name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.LatePolicy(); ok {
+		if err := assignment.LatePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "late_policy", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_policy": %w`, err)}
+		}
+	}
+	if _, ok := au.mutation.CourseID(); au.mutation.CourseCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Assignment.course"`)
+	}
+	return nil
+}
+
+func (au *AssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(assignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(assignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(assignment.FieldName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.FolderID(); ok {
+		_spec.SetField(assignment.FieldFolderID, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedFolderID(); ok {
+		_spec.AddField(assignment.FieldFolderID, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.Deadline(); ok {
+		_spec.SetField(assignment.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.LateDeadline(); ok {
+		_spec.SetField(assignment.FieldLateDeadline, field.TypeTime, value)
+	}
+	if au.mutation.LateDeadlineCleared() {
+		_spec.ClearField(assignment.FieldLateDeadline, field.TypeTime)
+	}
+	if value, ok := au.mutation.Settings(); ok {
+		_spec.SetField(assignment.FieldSettings, field.TypeJSON, value)
+	}
+	if au.mutation.SettingsCleared() {
+		_spec.ClearField(assignment.FieldSettings, field.TypeJSON)
+	}
+	if au.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.CourseTable,
+			Columns: []string{assignment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.CourseTable,
+			Columns: []string{assignment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AssignmentUpdateOne is the builder for updating a single Assignment entity.
+type AssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AssignmentUpdateOne) SetUpdatedAt(t time.Time) *AssignmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AssignmentUpdateOne) SetDeletedAt(t time.Time) *AssignmentUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableDeletedAt(t *time.Time) *AssignmentUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AssignmentUpdateOne) ClearDeletedAt() *AssignmentUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AssignmentUpdateOne) SetName(s string) *AssignmentUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableName(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetCourseID sets the "course_id" field.
+func (auo *AssignmentUpdateOne) SetCourseID(i int) *AssignmentUpdateOne {
+	auo.mutation.SetCourseID(i)
+	return auo
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableCourseID(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetCourseID(*i)
+	}
+	return auo
+}
+
+// SetFolderID sets the "folder_id" field.
+func (auo *AssignmentUpdateOne) SetFolderID(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetFolderID()
+	auo.mutation.SetFolderID(i)
+	return auo
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableFolderID(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetFolderID(*i)
+	}
+	return auo
+}
+
+// AddFolderID adds i to the "folder_id" field.
+func (auo *AssignmentUpdateOne) AddFolderID(i int) *AssignmentUpdateOne {
+	auo.mutation.AddFolderID(i)
+	return auo
+}
+
+// SetDeadline sets the "deadline" field.
+func (auo *AssignmentUpdateOne) SetDeadline(t time.Time) *AssignmentUpdateOne {
+	auo.mutation.SetDeadline(t)
+	return auo
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableDeadline(t *time.Time) *AssignmentUpdateOne {
+	if t != nil {
+		auo.SetDeadline(*t)
+	}
+	return auo
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (auo *AssignmentUpdateOne) SetLatePolicy(ap assignment.LatePolicy) *AssignmentUpdateOne {
+	auo.mutation.SetLatePolicy(ap)
+	return auo
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableLatePolicy(ap *assignment.LatePolicy) *AssignmentUpdateOne {
+	if ap != nil {
+		auo.SetLatePolicy(*ap)
+	}
+	return auo
+}
+
+// SetLateDeadline sets the "late_deadline" field.
+func (auo *AssignmentUpdateOne) SetLateDeadline(t time.Time) *AssignmentUpdateOne {
+	auo.mutation.SetLateDeadline(t)
+	return auo
+}
+
+// SetNillableLateDeadline sets the "late_deadline" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableLateDeadline(t *time.Time) *AssignmentUpdateOne {
+	if t != nil {
+		auo.SetLateDeadline(*t)
+	}
+	return auo
+}
+
+// ClearLateDeadline clears the value of the "late_deadline" field.
+func (auo *AssignmentUpdateOne) ClearLateDeadline() *AssignmentUpdateOne {
+	auo.mutation.ClearLateDeadline()
+	return auo
+}
+
+// SetSettings sets the "settings" field.
+func (auo *AssignmentUpdateOne) SetSettings(ts *types.AssignmentSetting) *AssignmentUpdateOne {
+	auo.mutation.SetSettings(ts)
+	return auo
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (auo *AssignmentUpdateOne) ClearSettings() *AssignmentUpdateOne {
+	auo.mutation.ClearSettings()
+	return auo
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (auo *AssignmentUpdateOne) SetCourse(c *Course) *AssignmentUpdateOne {
+	return auo.SetCourseID(c.ID)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (auo *AssignmentUpdateOne) Mutation() *AssignmentMutation {
+	return auo.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (auo *AssignmentUpdateOne) ClearCourse() *AssignmentUpdateOne {
+	auo.mutation.ClearCourse()
+	return auo
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (auo *AssignmentUpdateOne) Where(ps ...predicate.Assignment) *AssignmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AssignmentUpdateOne) Select(field string, fields ...string) *AssignmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Assignment entity.
+func (auo *AssignmentUpdateOne) Save(ctx context.Context) (*Assignment, error) {
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AssignmentUpdateOne) SaveX(ctx context.Context) *Assignment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AssignmentUpdateOne) defaults() error {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		if assignment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized assignment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := assignment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AssignmentUpdateOne) check() error {
+	if v, ok := auo.mutation.Name(); ok {
+		if err := assignment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Assignment.name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.LatePolicy(); ok {
+		if err := assignment.LatePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "late_policy", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_policy": %w`, err)}
+		}
+	}
+	if _, ok := auo.mutation.CourseID(); auo.mutation.CourseCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Assignment.course"`)
+	}
+	return nil
+}
+
+func (auo *AssignmentUpdateOne) sqlSave(ctx context.Context) (_node *Assignment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Assignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, assignment.FieldID)
+		for _, f := range fields {
+			if !assignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != assignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(assignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(assignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(assignment.FieldName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.FolderID(); ok {
+		_spec.SetField(assignment.FieldFolderID, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedFolderID(); ok {
+		_spec.AddField(assignment.FieldFolderID, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.Deadline(); ok {
+		_spec.SetField(assignment.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.LateDeadline(); ok {
+		_spec.SetField(assignment.FieldLateDeadline, field.TypeTime, value)
+	}
+	if auo.mutation.LateDeadlineCleared() {
+		_spec.ClearField(assignment.FieldLateDeadline, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Settings(); ok {
+		_spec.SetField(assignment.FieldSettings, field.TypeJSON, value)
+	}
+	if auo.mutation.SettingsCleared() {
+		_spec.ClearField(assignment.FieldSettings, field.TypeJSON)
+	}
+	if auo.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.CourseTable,
+			Columns: []string{assignment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.CourseTable,
+			Columns: []string{assignment.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Assignment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1027 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CourseCreate is the builder for creating a Course entity.
+type CourseCreate struct {
+	config
+	mutation *CourseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CourseCreate) SetCreatedAt(t time.Time) *CourseCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableCreatedAt(t *time.Time) *CourseCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CourseCreate) SetUpdatedAt(t time.Time) *CourseCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableUpdatedAt(t *time.Time) *CourseCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CourseCreate) SetDeletedAt(t time.Time) *CourseCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableDeletedAt(t *time.Time) *CourseCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *CourseCreate) SetName(s string) *CourseCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetCode sets the "code" field.
+func (cc *CourseCreate) SetCode(s string) *CourseCreate {
+	cc.mutation.SetCode(s)
+	return cc
+}
+
+// SetDescription sets the "description" field.
+func (cc *CourseCreate) SetDescription(s string) *CourseCreate {
+	cc.mutation.SetDescription(s)
+	return cc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableDescription(s *string) *CourseCreate {
+	if s != nil {
+		cc.SetDescription(*s)
+	}
+	return cc
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (cc *CourseCreate) SetMaxStorage(i int64) *CourseCreate {
+	cc.mutation.SetMaxStorage(i)
+	return cc
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (cc *CourseCreate) SetNillableMaxStorage(i *int64) *CourseCreate {
+	if i != nil {
+		cc.SetMaxStorage(*i)
+	}
+	return cc
+}
+
+// SetAccountID sets the "account_id" field.
+func (cc *CourseCreate) SetAccountID(i int) *CourseCreate {
+	cc.mutation.SetAccountID(i)
+	return cc
+}
+
+// SetAccount sets the "account" edge to the User entity.
+func (cc *CourseCreate) SetAccount(u *User) *CourseCreate {
+	return cc.SetAccountID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the CourseMember entity by IDs.
+func (cc *CourseCreate) AddMemberIDs(ids ...int) *CourseCreate {
+	cc.mutation.AddMemberIDs(ids...)
+	return cc
+}
+
+// AddMembers adds the "members" edges to the CourseMember entity.
+func (cc *CourseCreate) AddMembers(c ...*CourseMember) *CourseCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddMemberIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (cc *CourseCreate) AddAssignmentIDs(ids ...int) *CourseCreate {
+	cc.mutation.AddAssignmentIDs(ids...)
+	return cc
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (cc *CourseCreate) AddAssignments(a ...*Assignment) *CourseCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cc.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the CourseMutation object of the builder.
+func (cc *CourseCreate) Mutation() *CourseMutation {
+	return cc.mutation
+}
+
+// Save creates the Course in the database.
+func (cc *CourseCreate) Save(ctx context.Context) (*Course, error) {
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CourseCreate) SaveX(ctx context.Context) *Course {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CourseCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CourseCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CourseCreate) defaults() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		if course.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized course.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := course.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		if course.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized course.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := course.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.MaxStorage(); !ok {
+		v := course.DefaultMaxStorage
+		cc.mutation.SetMaxStorage(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CourseCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Course.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Course.updated_at"`)}
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Course.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := course.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Course.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Course.code"`)}
+	}
+	if v, ok := cc.mutation.Code(); ok {
+		if err := course.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Course.code": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.MaxStorage(); !ok {
+		return &ValidationError{Name: "max_storage", err: errors.New(`ent: missing required field "Course.max_storage"`)}
+	}
+	if _, ok := cc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Course.account_id"`)}
+	}
+	if _, ok := cc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Course.account"`)}
+	}
+	return nil
+}
+
+func (cc *CourseCreate) sqlSave(ctx context.Context) (*Course, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CourseCreate) createSpec() (*Course, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Course{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(course.Table, sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt))
+	)
+
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = cc.conflict
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(course.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(course.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(course.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(course.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.Code(); ok {
+		_spec.SetField(course.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := cc.mutation.Description(); ok {
+		_spec.SetField(course.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := cc.mutation.MaxStorage(); ok {
+		_spec.SetField(course.FieldMaxStorage, field.TypeInt64, value)
+		_node.MaxStorage = value
+	}
+	if nodes := cc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   course.AccountTable,
+			Columns: []string{course.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Course.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CourseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CourseCreate) OnConflict(opts ...sql.ConflictOption) *CourseUpsertOne {
+	cc.conflict = opts
+	return &CourseUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CourseCreate) OnConflictColumns(columns ...string) *CourseUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CourseUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CourseUpsertOne is the builder for "upsert"-ing
+	//  one Course node.
+	CourseUpsertOne struct {
+		create *CourseCreate
+	}
+
+	// CourseUpsert is the "OnConflict" setter.
+	CourseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseUpsert) SetUpdatedAt(v time.Time) *CourseUpsert {
+	u.Set(course.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateUpdatedAt() *CourseUpsert {
+	u.SetExcluded(course.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseUpsert) SetDeletedAt(v time.Time) *CourseUpsert {
+	u.Set(course.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateDeletedAt() *CourseUpsert {
+	u.SetExcluded(course.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseUpsert) ClearDeletedAt() *CourseUpsert {
+	u.SetNull(course.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CourseUpsert) SetName(v string) *CourseUpsert {
+	u.Set(course.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateName() *CourseUpsert {
+	u.SetExcluded(course.FieldName)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *CourseUpsert) SetCode(v string) *CourseUpsert {
+	u.Set(course.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateCode() *CourseUpsert {
+	u.SetExcluded(course.FieldCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CourseUpsert) SetDescription(v string) *CourseUpsert {
+	u.Set(course.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateDescription() *CourseUpsert {
+	u.SetExcluded(course.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CourseUpsert) ClearDescription() *CourseUpsert {
+	u.SetNull(course.FieldDescription)
+	return u
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *CourseUpsert) SetMaxStorage(v int64) *CourseUpsert {
+	u.Set(course.FieldMaxStorage, v)
+	return u
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateMaxStorage() *CourseUpsert {
+	u.SetExcluded(course.FieldMaxStorage)
+	return u
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *CourseUpsert) AddMaxStorage(v int64) *CourseUpsert {
+	u.Add(course.FieldMaxStorage, v)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *CourseUpsert) SetAccountID(v int) *CourseUpsert {
+	u.Set(course.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CourseUpsert) UpdateAccountID() *CourseUpsert {
+	u.SetExcluded(course.FieldAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CourseUpsertOne) UpdateNewValues() *CourseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(course.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CourseUpsertOne) Ignore() *CourseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CourseUpsertOne) DoNothing() *CourseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CourseCreate.OnConflict
+// documentation for more info.
+func (u *CourseUpsertOne) Update(set func(*CourseUpsert)) *CourseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CourseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseUpsertOne) SetUpdatedAt(v time.Time) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateUpdatedAt() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseUpsertOne) SetDeletedAt(v time.Time) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateDeletedAt() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseUpsertOne) ClearDeletedAt() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CourseUpsertOne) SetName(v string) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateName() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *CourseUpsertOne) SetCode(v string) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateCode() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CourseUpsertOne) SetDescription(v string) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateDescription() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CourseUpsertOne) ClearDescription() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *CourseUpsertOne) SetMaxStorage(v int64) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetMaxStorage(v)
+	})
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *CourseUpsertOne) AddMaxStorage(v int64) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.AddMaxStorage(v)
+	})
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateMaxStorage() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateMaxStorage()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *CourseUpsertOne) SetAccountID(v int) *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CourseUpsertOne) UpdateAccountID() *CourseUpsertOne {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *CourseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CourseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CourseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CourseUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CourseUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *CourseCreate) SetRawID(t int) *CourseCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// CourseCreateBulk is the builder for creating many Course entities in bulk.
+type CourseCreateBulk struct {
+	config
+	err      error
+	builders []*CourseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Course entities in the database.
+func (ccb *CourseCreateBulk) Save(ctx context.Context) ([]*Course, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Course, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CourseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CourseCreateBulk) SaveX(ctx context.Context) []*Course {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CourseCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CourseCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Course.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CourseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CourseCreateBulk) OnConflict(opts ...sql.ConflictOption) *CourseUpsertBulk {
+	ccb.conflict = opts
+	return &CourseUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CourseCreateBulk) OnConflictColumns(columns ...string) *CourseUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CourseUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CourseUpsertBulk is the builder for "upsert"-ing
+// a bulk of Course nodes.
+type CourseUpsertBulk struct {
+	create *CourseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CourseUpsertBulk) UpdateNewValues() *CourseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(course.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Course.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CourseUpsertBulk) Ignore() *CourseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CourseUpsertBulk) DoNothing() *CourseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CourseCreateBulk.OnConflict
+// documentation for more info.
+func (u *CourseUpsertBulk) Update(set func(*CourseUpsert)) *CourseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CourseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseUpsertBulk) SetUpdatedAt(v time.Time) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateUpdatedAt() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseUpsertBulk) SetDeletedAt(v time.Time) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateDeletedAt() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseUpsertBulk) ClearDeletedAt() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CourseUpsertBulk) SetName(v string) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateName() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *CourseUpsertBulk) SetCode(v string) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateCode() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CourseUpsertBulk) SetDescription(v string) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateDescription() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CourseUpsertBulk) ClearDescription() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *CourseUpsertBulk) SetMaxStorage(v int64) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetMaxStorage(v)
+	})
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *CourseUpsertBulk) AddMaxStorage(v int64) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.AddMaxStorage(v)
+	})
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateMaxStorage() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateMaxStorage()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *CourseUpsertBulk) SetAccountID(v int) *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CourseUpsertBulk) UpdateAccountID() *CourseUpsertBulk {
+	return u.Update(func(s *CourseUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *CourseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CourseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CourseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CourseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
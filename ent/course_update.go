@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CourseUpdate is the builder for updating Course entities.
+type CourseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CourseMutation
+}
+
+// Where appends a list predicates to the CourseUpdate builder.
+func (cu *CourseUpdate) Where(ps ...predicate.Course) *CourseUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CourseUpdate) SetUpdatedAt(t time.Time) *CourseUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CourseUpdate) SetDeletedAt(t time.Time) *CourseUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableDeletedAt(t *time.Time) *CourseUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CourseUpdate) ClearDeletedAt() *CourseUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CourseUpdate) SetName(s string) *CourseUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableName(s *string) *CourseUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetCode sets the "code" field.
+func (cu *CourseUpdate) SetCode(s string) *CourseUpdate {
+	cu.mutation.SetCode(s)
+	return cu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableCode(s *string) *CourseUpdate {
+	if s != nil {
+		cu.SetCode(*s)
+	}
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CourseUpdate) SetDescription(s string) *CourseUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableDescription(s *string) *CourseUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cu *CourseUpdate) ClearDescription() *CourseUpdate {
+	cu.mutation.ClearDescription()
+	return cu
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (cu *CourseUpdate) SetMaxStorage(i int64) *CourseUpdate {
+	cu.mutation.ResetMaxStorage()
+	cu.mutation.SetMaxStorage(i)
+	return cu
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableMaxStorage(i *int64) *CourseUpdate {
+	if i != nil {
+		cu.SetMaxStorage(*i)
+	}
+	return cu
+}
+
+// AddMaxStorage adds i to the "max_storage" field.
+func (cu *CourseUpdate) AddMaxStorage(i int64) *CourseUpdate {
+	cu.mutation.AddMaxStorage(i)
+	return cu
+}
+
+// SetAccountID sets the "account_id" field.
+func (cu *CourseUpdate) SetAccountID(i int) *CourseUpdate {
+	cu.mutation.SetAccountID(i)
+	return cu
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (cu *CourseUpdate) SetNillableAccountID(i *int) *CourseUpdate {
+	if i != nil {
+		cu.SetAccountID(*i)
+	}
+	return cu
+}
+
+// SetAccount sets the "account" edge to the User entity.
+func (cu *CourseUpdate) SetAccount(u *User) *CourseUpdate {
+	return cu.SetAccountID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the CourseMember entity by IDs.
+func (cu *CourseUpdate) AddMemberIDs(ids ...int) *CourseUpdate {
+	cu.mutation.AddMemberIDs(ids...)
+	return cu
+}
+
+// AddMembers adds the "members" edges to the CourseMember entity.
+func (cu *CourseUpdate) AddMembers(c ...*CourseMember) *CourseUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddMemberIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (cu *CourseUpdate) AddAssignmentIDs(ids ...int) *CourseUpdate {
+	cu.mutation.AddAssignmentIDs(ids...)
+	return cu
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (cu *CourseUpdate) AddAssignments(a ...*Assignment) *CourseUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the CourseMutation object of the builder.
+func (cu *CourseUpdate) Mutation() *CourseMutation {
+	return cu.mutation
+}
+
+// ClearAccount clears the "account" edge to the User entity.
+func (cu *CourseUpdate) ClearAccount() *CourseUpdate {
+	cu.mutation.ClearAccount()
+	return cu
+}
+
+// ClearMembers clears all "members" edges to the CourseMember entity.
+func (cu *CourseUpdate) ClearMembers() *CourseUpdate {
+	cu.mutation.ClearMembers()
+	return cu
+}
+
+// RemoveMemberIDs removes the "members" edge to CourseMember entities by IDs.
+func (cu *CourseUpdate) RemoveMemberIDs(ids ...int) *CourseUpdate {
+	cu.mutation.RemoveMemberIDs(ids...)
+	return cu
+}
+
+// RemoveMembers removes "members" edges to CourseMember entities.
+func (cu *CourseUpdate) RemoveMembers(c ...*CourseMember) *CourseUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveMemberIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the Assignment entity.
+func (cu *CourseUpdate) ClearAssignments() *CourseUpdate {
+	cu.mutation.ClearAssignments()
+	return cu
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to Assignment entities by IDs.
+func (cu *CourseUpdate) RemoveAssignmentIDs(ids ...int) *CourseUpdate {
+	cu.mutation.RemoveAssignmentIDs(ids...)
+	return cu
+}
+
+// RemoveAssignments removes "assignments" edges to Assignment entities.
+func (cu *CourseUpdate) RemoveAssignments(a ...*Assignment) *CourseUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.RemoveAssignmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CourseUpdate) Save(ctx context.Context) (int, error) {
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CourseUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CourseUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CourseUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CourseUpdate) defaults() error {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		if course.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized course.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := course.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CourseUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := course.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Course.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Code(); ok {
+		if err := course.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Course.code": %w`, err)}
+		}
+	}
+	if _, ok := cu.mutation.AccountID(); cu.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Course.account"`)
+	}
+	return nil
+}
+
+func (cu *CourseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(course.Table, course.Columns, sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(course.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(course.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(course.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(course.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Code(); ok {
+		_spec.SetField(course.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(course.FieldDescription, field.TypeString, value)
+	}
+	if cu.mutation.DescriptionCleared() {
+		_spec.ClearField(course.FieldDescription, field.TypeString)
+	}
+	if value, ok := cu.mutation.MaxStorage(); ok {
+		_spec.SetField(course.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedMaxStorage(); ok {
+		_spec.AddField(course.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if cu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   course.AccountTable,
+			Columns: []string{course.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   course.AccountTable,
+			Columns: []string{course.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !cu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !cu.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{course.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CourseUpdateOne is the builder for updating a single Course entity.
+type CourseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CourseMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CourseUpdateOne) SetUpdatedAt(t time.Time) *CourseUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CourseUpdateOne) SetDeletedAt(t time.Time) *CourseUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableDeletedAt(t *time.Time) *CourseUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CourseUpdateOne) ClearDeletedAt() *CourseUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CourseUpdateOne) SetName(s string) *CourseUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableName(s *string) *CourseUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetCode sets the "code" field.
+func (cuo *CourseUpdateOne) SetCode(s string) *CourseUpdateOne {
+	cuo.mutation.SetCode(s)
+	return cuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableCode(s *string) *CourseUpdateOne {
+	if s != nil {
+		cuo.SetCode(*s)
+	}
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CourseUpdateOne) SetDescription(s string) *CourseUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableDescription(s *string) *CourseUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cuo *CourseUpdateOne) ClearDescription() *CourseUpdateOne {
+	cuo.mutation.ClearDescription()
+	return cuo
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (cuo *CourseUpdateOne) SetMaxStorage(i int64) *CourseUpdateOne {
+	cuo.mutation.ResetMaxStorage()
+	cuo.mutation.SetMaxStorage(i)
+	return cuo
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableMaxStorage(i *int64) *CourseUpdateOne {
+	if i != nil {
+		cuo.SetMaxStorage(*i)
+	}
+	return cuo
+}
+
+// AddMaxStorage adds i to the "max_storage" field.
+func (cuo *CourseUpdateOne) AddMaxStorage(i int64) *CourseUpdateOne {
+	cuo.mutation.AddMaxStorage(i)
+	return cuo
+}
+
+// SetAccountID sets the "account_id" field.
+func (cuo *CourseUpdateOne) SetAccountID(i int) *CourseUpdateOne {
+	cuo.mutation.SetAccountID(i)
+	return cuo
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (cuo *CourseUpdateOne) SetNillableAccountID(i *int) *CourseUpdateOne {
+	if i != nil {
+		cuo.SetAccountID(*i)
+	}
+	return cuo
+}
+
+// SetAccount sets the "account" edge to the User entity.
+func (cuo *CourseUpdateOne) SetAccount(u *User) *CourseUpdateOne {
+	return cuo.SetAccountID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the CourseMember entity by IDs.
+func (cuo *CourseUpdateOne) AddMemberIDs(ids ...int) *CourseUpdateOne {
+	cuo.mutation.AddMemberIDs(ids...)
+	return cuo
+}
+
+// AddMembers adds the "members" edges to the CourseMember entity.
+func (cuo *CourseUpdateOne) AddMembers(c ...*CourseMember) *CourseUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddMemberIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (cuo *CourseUpdateOne) AddAssignmentIDs(ids ...int) *CourseUpdateOne {
+	cuo.mutation.AddAssignmentIDs(ids...)
+	return cuo
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (cuo *CourseUpdateOne) AddAssignments(a ...*Assignment) *CourseUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the CourseMutation object of the builder.
+func (cuo *CourseUpdateOne) Mutation() *CourseMutation {
+	return cuo.mutation
+}
+
+// ClearAccount clears the "account" edge to the User entity.
+func (cuo *CourseUpdateOne) ClearAccount() *CourseUpdateOne {
+	cuo.mutation.ClearAccount()
+	return cuo
+}
+
+// ClearMembers clears all "members" edges to the CourseMember entity.
+func (cuo *CourseUpdateOne) ClearMembers() *CourseUpdateOne {
+	cuo.mutation.ClearMembers()
+	return cuo
+}
+
+// RemoveMemberIDs removes the "members" edge to CourseMember entities by IDs.
+func (cuo *CourseUpdateOne) RemoveMemberIDs(ids ...int) *CourseUpdateOne {
+	cuo.mutation.RemoveMemberIDs(ids...)
+	return cuo
+}
+
+// RemoveMembers removes "members" edges to CourseMember entities.
+func (cuo *CourseUpdateOne) RemoveMembers(c ...*CourseMember) *CourseUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveMemberIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the Assignment entity.
+func (cuo *CourseUpdateOne) ClearAssignments() *CourseUpdateOne {
+	cuo.mutation.ClearAssignments()
+	return cuo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to Assignment entities by IDs.
+func (cuo *CourseUpdateOne) RemoveAssignmentIDs(ids ...int) *CourseUpdateOne {
+	cuo.mutation.RemoveAssignmentIDs(ids...)
+	return cuo
+}
+
+// RemoveAssignments removes "assignments" edges to Assignment entities.
+func (cuo *CourseUpdateOne) RemoveAssignments(a ...*Assignment) *CourseUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.RemoveAssignmentIDs(ids...)
+}
+
+// Where appends a list predicates to the CourseUpdate builder.
+func (cuo *CourseUpdateOne) Where(ps ...predicate.Course) *CourseUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CourseUpdateOne) Select(field string, fields ...string) *CourseUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Course entity.
+func (cuo *CourseUpdateOne) Save(ctx context.Context) (*Course, error) {
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CourseUpdateOne) SaveX(ctx context.Context) *Course {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CourseUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CourseUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CourseUpdateOne) defaults() error {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		if course.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized course.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := course.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CourseUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := course.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Course.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Code(); ok {
+		if err := course.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Course.code": %w`, err)}
+		}
+	}
+	if _, ok := cuo.mutation.AccountID(); cuo.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Course.account"`)
+	}
+	return nil
+}
+
+func (cuo *CourseUpdateOne) sqlSave(ctx context.Context) (_node *Course, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(course.Table, course.Columns, sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Course.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, course.FieldID)
+		for _, f := range fields {
+			if !course.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != course.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(course.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(course.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(course.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(course.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Code(); ok {
+		_spec.SetField(course.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(course.FieldDescription, field.TypeString, value)
+	}
+	if cuo.mutation.DescriptionCleared() {
+		_spec.ClearField(course.FieldDescription, field.TypeString)
+	}
+	if value, ok := cuo.mutation.MaxStorage(); ok {
+		_spec.SetField(course.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedMaxStorage(); ok {
+		_spec.AddField(course.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if cuo.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   course.AccountTable,
+			Columns: []string{course.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   course.AccountTable,
+			Columns: []string{course.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !cuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.MembersTable,
+			Columns: []string{course.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !cuo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.AssignmentsTable,
+			Columns: []string{course.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Course{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{course.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
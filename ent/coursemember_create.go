@@ -0,0 +1,829 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CourseMemberCreate is the builder for creating a CourseMember entity.
+type CourseMemberCreate struct {
+	config
+	mutation *CourseMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cmc *CourseMemberCreate) SetCreatedAt(t time.Time) *CourseMemberCreate {
+	cmc.mutation.SetCreatedAt(t)
+	return cmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cmc *CourseMemberCreate) SetNillableCreatedAt(t *time.Time) *CourseMemberCreate {
+	if t != nil {
+		cmc.SetCreatedAt(*t)
+	}
+	return cmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmc *CourseMemberCreate) SetUpdatedAt(t time.Time) *CourseMemberCreate {
+	cmc.mutation.SetUpdatedAt(t)
+	return cmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cmc *CourseMemberCreate) SetNillableUpdatedAt(t *time.Time) *CourseMemberCreate {
+	if t != nil {
+		cmc.SetUpdatedAt(*t)
+	}
+	return cmc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmc *CourseMemberCreate) SetDeletedAt(t time.Time) *CourseMemberCreate {
+	cmc.mutation.SetDeletedAt(t)
+	return cmc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmc *CourseMemberCreate) SetNillableDeletedAt(t *time.Time) *CourseMemberCreate {
+	if t != nil {
+		cmc.SetDeletedAt(*t)
+	}
+	return cmc
+}
+
+// SetCourseID sets the "course_id" field.
+func (cmc *CourseMemberCreate) SetCourseID(i int) *CourseMemberCreate {
+	cmc.mutation.SetCourseID(i)
+	return cmc
+}
+
+// SetUserID sets the "user_id" field.
+func (cmc *CourseMemberCreate) SetUserID(i int) *CourseMemberCreate {
+	cmc.mutation.SetUserID(i)
+	return cmc
+}
+
+// SetRole sets the "role" field.
+func (cmc *CourseMemberCreate) SetRole(c coursemember.Role) *CourseMemberCreate {
+	cmc.mutation.SetRole(c)
+	return cmc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cmc *CourseMemberCreate) SetNillableRole(c *coursemember.Role) *CourseMemberCreate {
+	if c != nil {
+		cmc.SetRole(*c)
+	}
+	return cmc
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (cmc *CourseMemberCreate) SetCourse(c *Course) *CourseMemberCreate {
+	return cmc.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cmc *CourseMemberCreate) SetUser(u *User) *CourseMemberCreate {
+	return cmc.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseMemberMutation object of the builder.
+func (cmc *CourseMemberCreate) Mutation() *CourseMemberMutation {
+	return cmc.mutation
+}
+
+// Save creates the CourseMember in the database.
+func (cmc *CourseMemberCreate) Save(ctx context.Context) (*CourseMember, error) {
+	if err := cmc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cmc.sqlSave, cmc.mutation, cmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cmc *CourseMemberCreate) SaveX(ctx context.Context) *CourseMember {
+	v, err := cmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmc *CourseMemberCreate) Exec(ctx context.Context) error {
+	_, err := cmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmc *CourseMemberCreate) ExecX(ctx context.Context) {
+	if err := cmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmc *CourseMemberCreate) defaults() error {
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		if coursemember.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursemember.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursemember.DefaultCreatedAt()
+		cmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cmc.mutation.UpdatedAt(); !ok {
+		if coursemember.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursemember.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursemember.DefaultUpdatedAt()
+		cmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cmc.mutation.Role(); !ok {
+		v := coursemember.DefaultRole
+		cmc.mutation.SetRole(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmc *CourseMemberCreate) check() error {
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CourseMember.created_at"`)}
+	}
+	if _, ok := cmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CourseMember.updated_at"`)}
+	}
+	if _, ok := cmc.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "CourseMember.course_id"`)}
+	}
+	if _, ok := cmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CourseMember.user_id"`)}
+	}
+	if _, ok := cmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "CourseMember.role"`)}
+	}
+	if v, ok := cmc.mutation.Role(); ok {
+		if err := coursemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseMember.role": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "CourseMember.course"`)}
+	}
+	if _, ok := cmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CourseMember.user"`)}
+	}
+	return nil
+}
+
+func (cmc *CourseMemberCreate) sqlSave(ctx context.Context) (*CourseMember, error) {
+	if err := cmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cmc.mutation.id = &_node.ID
+	cmc.mutation.done = true
+	return _node, nil
+}
+
+func (cmc *CourseMemberCreate) createSpec() (*CourseMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CourseMember{config: cmc.config}
+		_spec = sqlgraph.NewCreateSpec(coursemember.Table, sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt))
+	)
+
+	if id, ok := cmc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = cmc.conflict
+	if value, ok := cmc.mutation.CreatedAt(); ok {
+		_spec.SetField(coursemember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursemember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cmc.mutation.DeletedAt(); ok {
+		_spec.SetField(coursemember.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cmc.mutation.Role(); ok {
+		_spec.SetField(coursemember.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if nodes := cmc.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.CourseTable,
+			Columns: []string{coursemember.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.UserTable,
+			Columns: []string{coursemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CourseMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CourseMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cmc *CourseMemberCreate) OnConflict(opts ...sql.ConflictOption) *CourseMemberUpsertOne {
+	cmc.conflict = opts
+	return &CourseMemberUpsertOne{
+		create: cmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cmc *CourseMemberCreate) OnConflictColumns(columns ...string) *CourseMemberUpsertOne {
+	cmc.conflict = append(cmc.conflict, sql.ConflictColumns(columns...))
+	return &CourseMemberUpsertOne{
+		create: cmc,
+	}
+}
+
+type (
+	// CourseMemberUpsertOne is the builder for "upsert"-ing
+	//  one CourseMember node.
+	CourseMemberUpsertOne struct {
+		create *CourseMemberCreate
+	}
+
+	// CourseMemberUpsert is the "OnConflict" setter.
+	CourseMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseMemberUpsert) SetUpdatedAt(v time.Time) *CourseMemberUpsert {
+	u.Set(coursemember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseMemberUpsert) UpdateUpdatedAt() *CourseMemberUpsert {
+	u.SetExcluded(coursemember.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseMemberUpsert) SetDeletedAt(v time.Time) *CourseMemberUpsert {
+	u.Set(coursemember.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseMemberUpsert) UpdateDeletedAt() *CourseMemberUpsert {
+	u.SetExcluded(coursemember.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseMemberUpsert) ClearDeletedAt() *CourseMemberUpsert {
+	u.SetNull(coursemember.FieldDeletedAt)
+	return u
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *CourseMemberUpsert) SetCourseID(v int) *CourseMemberUpsert {
+	u.Set(coursemember.FieldCourseID, v)
+	return u
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *CourseMemberUpsert) UpdateCourseID() *CourseMemberUpsert {
+	u.SetExcluded(coursemember.FieldCourseID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CourseMemberUpsert) SetUserID(v int) *CourseMemberUpsert {
+	u.Set(coursemember.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CourseMemberUpsert) UpdateUserID() *CourseMemberUpsert {
+	u.SetExcluded(coursemember.FieldUserID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *CourseMemberUpsert) SetRole(v coursemember.Role) *CourseMemberUpsert {
+	u.Set(coursemember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *CourseMemberUpsert) UpdateRole() *CourseMemberUpsert {
+	u.SetExcluded(coursemember.FieldRole)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CourseMemberUpsertOne) UpdateNewValues() *CourseMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coursemember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CourseMemberUpsertOne) Ignore() *CourseMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CourseMemberUpsertOne) DoNothing() *CourseMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CourseMemberCreate.OnConflict
+// documentation for more info.
+func (u *CourseMemberUpsertOne) Update(set func(*CourseMemberUpsert)) *CourseMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CourseMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseMemberUpsertOne) SetUpdatedAt(v time.Time) *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseMemberUpsertOne) UpdateUpdatedAt() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseMemberUpsertOne) SetDeletedAt(v time.Time) *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseMemberUpsertOne) UpdateDeletedAt() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseMemberUpsertOne) ClearDeletedAt() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *CourseMemberUpsertOne) SetCourseID(v int) *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetCourseID(v)
+	})
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *CourseMemberUpsertOne) UpdateCourseID() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateCourseID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CourseMemberUpsertOne) SetUserID(v int) *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CourseMemberUpsertOne) UpdateUserID() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *CourseMemberUpsertOne) SetRole(v coursemember.Role) *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *CourseMemberUpsertOne) UpdateRole() *CourseMemberUpsertOne {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *CourseMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CourseMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CourseMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CourseMemberUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CourseMemberUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *CourseMemberCreate) SetRawID(t int) *CourseMemberCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// CourseMemberCreateBulk is the builder for creating many CourseMember entities in bulk.
+type CourseMemberCreateBulk struct {
+	config
+	err      error
+	builders []*CourseMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CourseMember entities in the database.
+func (cmcb *CourseMemberCreateBulk) Save(ctx context.Context) ([]*CourseMember, error) {
+	if cmcb.err != nil {
+		return nil, cmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cmcb.builders))
+	nodes := make([]*CourseMember, len(cmcb.builders))
+	mutators := make([]Mutator, len(cmcb.builders))
+	for i := range cmcb.builders {
+		func(i int, root context.Context) {
+			builder := cmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CourseMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmcb *CourseMemberCreateBulk) SaveX(ctx context.Context) []*CourseMember {
+	v, err := cmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmcb *CourseMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := cmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmcb *CourseMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := cmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CourseMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CourseMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cmcb *CourseMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *CourseMemberUpsertBulk {
+	cmcb.conflict = opts
+	return &CourseMemberUpsertBulk{
+		create: cmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cmcb *CourseMemberCreateBulk) OnConflictColumns(columns ...string) *CourseMemberUpsertBulk {
+	cmcb.conflict = append(cmcb.conflict, sql.ConflictColumns(columns...))
+	return &CourseMemberUpsertBulk{
+		create: cmcb,
+	}
+}
+
+// CourseMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of CourseMember nodes.
+type CourseMemberUpsertBulk struct {
+	create *CourseMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CourseMemberUpsertBulk) UpdateNewValues() *CourseMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coursemember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CourseMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CourseMemberUpsertBulk) Ignore() *CourseMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CourseMemberUpsertBulk) DoNothing() *CourseMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CourseMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *CourseMemberUpsertBulk) Update(set func(*CourseMemberUpsert)) *CourseMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CourseMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CourseMemberUpsertBulk) SetUpdatedAt(v time.Time) *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CourseMemberUpsertBulk) UpdateUpdatedAt() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CourseMemberUpsertBulk) SetDeletedAt(v time.Time) *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CourseMemberUpsertBulk) UpdateDeletedAt() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CourseMemberUpsertBulk) ClearDeletedAt() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCourseID sets the "course_id" field.
+func (u *CourseMemberUpsertBulk) SetCourseID(v int) *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetCourseID(v)
+	})
+}
+
+// UpdateCourseID sets the "course_id" field to the value that was provided on create.
+func (u *CourseMemberUpsertBulk) UpdateCourseID() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateCourseID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CourseMemberUpsertBulk) SetUserID(v int) *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CourseMemberUpsertBulk) UpdateUserID() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *CourseMemberUpsertBulk) SetRole(v coursemember.Role) *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *CourseMemberUpsertBulk) UpdateRole() *CourseMemberUpsertBulk {
+	return u.Update(func(s *CourseMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *CourseMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CourseMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CourseMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CourseMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// CourseMemberDelete is the builder for deleting a CourseMember entity.
+type CourseMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *CourseMemberMutation
+}
+
+// Where appends a list predicates to the CourseMemberDelete builder.
+func (cmd *CourseMemberDelete) Where(ps ...predicate.CourseMember) *CourseMemberDelete {
+	cmd.mutation.Where(ps...)
+	return cmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cmd *CourseMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmd.sqlExec, cmd.mutation, cmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmd *CourseMemberDelete) ExecX(ctx context.Context) int {
+	n, err := cmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cmd *CourseMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coursemember.Table, sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt))
+	if ps := cmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cmd.mutation.done = true
+	return affected, err
+}
+
+// CourseMemberDeleteOne is the builder for deleting a single CourseMember entity.
+type CourseMemberDeleteOne struct {
+	cmd *CourseMemberDelete
+}
+
+// Where appends a list predicates to the CourseMemberDelete builder.
+func (cmdo *CourseMemberDeleteOne) Where(ps ...predicate.CourseMember) *CourseMemberDeleteOne {
+	cmdo.cmd.mutation.Where(ps...)
+	return cmdo
+}
+
+// Exec executes the deletion query.
+func (cmdo *CourseMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := cmdo.cmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coursemember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmdo *CourseMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := cmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
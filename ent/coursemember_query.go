@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CourseMemberQuery is the builder for querying CourseMember entities.
+type CourseMemberQuery struct {
+	config
+	ctx        *QueryContext
+	order      []coursemember.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CourseMember
+	withCourse *CourseQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CourseMemberQuery builder.
+func (cmq *CourseMemberQuery) Where(ps ...predicate.CourseMember) *CourseMemberQuery {
+	cmq.predicates = append(cmq.predicates, ps...)
+	return cmq
+}
+
+// Limit the number of records to be returned by this query.
+func (cmq *CourseMemberQuery) Limit(limit int) *CourseMemberQuery {
+	cmq.ctx.Limit = &limit
+	return cmq
+}
+
+// Offset to start from.
+func (cmq *CourseMemberQuery) Offset(offset int) *CourseMemberQuery {
+	cmq.ctx.Offset = &offset
+	return cmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cmq *CourseMemberQuery) Unique(unique bool) *CourseMemberQuery {
+	cmq.ctx.Unique = &unique
+	return cmq
+}
+
+// Order specifies how the records should be ordered.
+func (cmq *CourseMemberQuery) Order(o ...coursemember.OrderOption) *CourseMemberQuery {
+	cmq.order = append(cmq.order, o...)
+	return cmq
+}
+
+// QueryCourse chains the current query on the "course" edge.
+func (cmq *CourseMemberQuery) QueryCourse() *CourseQuery {
+	query := (&CourseClient{config: cmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursemember.Table, coursemember.FieldID, selector),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursemember.CourseTable, coursemember.CourseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (cmq *CourseMemberQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: cmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coursemember.Table, coursemember.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursemember.UserTable, coursemember.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CourseMember entity from the query.
+// Returns a *NotFoundError when no CourseMember was found.
+func (cmq *CourseMemberQuery) First(ctx context.Context) (*CourseMember, error) {
+	nodes, err := cmq.Limit(1).All(setContextOp(ctx, cmq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{coursemember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cmq *CourseMemberQuery) FirstX(ctx context.Context) *CourseMember {
+	node, err := cmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CourseMember ID from the query.
+// Returns a *NotFoundError when no CourseMember ID was found.
+func (cmq *CourseMemberQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cmq.Limit(1).IDs(setContextOp(ctx, cmq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{coursemember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cmq *CourseMemberQuery) FirstIDX(ctx context.Context) int {
+	id, err := cmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CourseMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CourseMember entity is found.
+// Returns a *NotFoundError when no CourseMember entities are found.
+func (cmq *CourseMemberQuery) Only(ctx context.Context) (*CourseMember, error) {
+	nodes, err := cmq.Limit(2).All(setContextOp(ctx, cmq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{coursemember.Label}
+	default:
+		return nil, &NotSingularError{coursemember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cmq *CourseMemberQuery) OnlyX(ctx context.Context) *CourseMember {
+	node, err := cmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CourseMember ID in the query.
+// Returns a *NotSingularError when more than one CourseMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cmq *CourseMemberQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cmq.Limit(2).IDs(setContextOp(ctx, cmq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{coursemember.Label}
+	default:
+		err = &NotSingularError{coursemember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cmq *CourseMemberQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CourseMembers.
+func (cmq *CourseMemberQuery) All(ctx context.Context) ([]*CourseMember, error) {
+	ctx = setContextOp(ctx, cmq.ctx, "All")
+	if err := cmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CourseMember, *CourseMemberQuery]()
+	return withInterceptors[[]*CourseMember](ctx, cmq, qr, cmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cmq *CourseMemberQuery) AllX(ctx context.Context) []*CourseMember {
+	nodes, err := cmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CourseMember IDs.
+func (cmq *CourseMemberQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cmq.ctx.Unique == nil && cmq.path != nil {
+		cmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cmq.ctx, "IDs")
+	if err = cmq.Select(coursemember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cmq *CourseMemberQuery) IDsX(ctx context.Context) []int {
+	ids, err := cmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cmq *CourseMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cmq.ctx, "Count")
+	if err := cmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cmq, querierCount[*CourseMemberQuery](), cmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cmq *CourseMemberQuery) CountX(ctx context.Context) int {
+	count, err := cmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cmq *CourseMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cmq.ctx, "Exist")
+	switch _, err := cmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cmq *CourseMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := cmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CourseMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cmq *CourseMemberQuery) Clone() *CourseMemberQuery {
+	if cmq == nil {
+		return nil
+	}
+	return &CourseMemberQuery{
+		config:     cmq.config,
+		ctx:        cmq.ctx.Clone(),
+		order:      append([]coursemember.OrderOption{}, cmq.order...),
+		inters:     append([]Interceptor{}, cmq.inters...),
+		predicates: append([]predicate.CourseMember{}, cmq.predicates...),
+		withCourse: cmq.withCourse.Clone(),
+		withUser:   cmq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  cmq.sql.Clone(),
+		path: cmq.path,
+	}
+}
+
+// WithCourse tells the query-builder to eager-load the nodes that are connected to
+// the "course" edge. The optional arguments are used to configure the query builder of the edge.
+func (cmq *CourseMemberQuery) WithCourse(opts ...func(*CourseQuery)) *CourseMemberQuery {
+	query := (&CourseClient{config: cmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cmq.withCourse = query
+	return cmq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cmq *CourseMemberQuery) WithUser(opts ...func(*UserQuery)) *CourseMemberQuery {
+	query := (&UserClient{config: cmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cmq.withUser = query
+	return cmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CourseMember.Query().
+//		GroupBy(coursemember.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cmq *CourseMemberQuery) GroupBy(field string, fields ...string) *CourseMemberGroupBy {
+	cmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CourseMemberGroupBy{build: cmq}
+	grbuild.flds = &cmq.ctx.Fields
+	grbuild.label = coursemember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CourseMember.Query().
+//		Select(coursemember.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (cmq *CourseMemberQuery) Select(fields ...string) *CourseMemberSelect {
+	cmq.ctx.Fields = append(cmq.ctx.Fields, fields...)
+	sbuild := &CourseMemberSelect{CourseMemberQuery: cmq}
+	sbuild.label = coursemember.Label
+	sbuild.flds, sbuild.scan = &cmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CourseMemberSelect configured with the given aggregations.
+func (cmq *CourseMemberQuery) Aggregate(fns ...AggregateFunc) *CourseMemberSelect {
+	return cmq.Select().Aggregate(fns...)
+}
+
+func (cmq *CourseMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cmq.ctx.Fields {
+		if !coursemember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cmq.path != nil {
+		prev, err := cmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cmq.sql = prev
+	}
+	return nil
+}
+
+func (cmq *CourseMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CourseMember, error) {
+	var (
+		nodes       = []*CourseMember{}
+		_spec       = cmq.querySpec()
+		loadedTypes = [2]bool{
+			cmq.withCourse != nil,
+			cmq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CourseMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CourseMember{config: cmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cmq.withCourse; query != nil {
+		if err := cmq.loadCourse(ctx, query, nodes, nil,
+			func(n *CourseMember, e *Course) { n.Edges.Course = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cmq.withUser; query != nil {
+		if err := cmq.loadUser(ctx, query, nodes, nil,
+			func(n *CourseMember, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cmq *CourseMemberQuery) loadCourse(ctx context.Context, query *CourseQuery, nodes []*CourseMember, init func(*CourseMember), assign func(*CourseMember, *Course)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CourseMember)
+	for i := range nodes {
+		fk := nodes[i].CourseID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(course.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "course_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cmq *CourseMemberQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*CourseMember, init func(*CourseMember), assign func(*CourseMember, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CourseMember)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cmq *CourseMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cmq.querySpec()
+	_spec.Node.Columns = cmq.ctx.Fields
+	if len(cmq.ctx.Fields) > 0 {
+		_spec.Unique = cmq.ctx.Unique != nil && *cmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cmq.driver, _spec)
+}
+
+func (cmq *CourseMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(coursemember.Table, coursemember.Columns, sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt))
+	_spec.From = cmq.sql
+	if unique := cmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coursemember.FieldID)
+		for i := range fields {
+			if fields[i] != coursemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cmq.withCourse != nil {
+			_spec.Node.AddColumnOnce(coursemember.FieldCourseID)
+		}
+		if cmq.withUser != nil {
+			_spec.Node.AddColumnOnce(coursemember.FieldUserID)
+		}
+	}
+	if ps := cmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cmq *CourseMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cmq.driver.Dialect())
+	t1 := builder.Table(coursemember.Table)
+	columns := cmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = coursemember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cmq.sql != nil {
+		selector = cmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cmq.ctx.Unique != nil && *cmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cmq.predicates {
+		p(selector)
+	}
+	for _, p := range cmq.order {
+		p(selector)
+	}
+	if offset := cmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CourseMemberGroupBy is the group-by builder for CourseMember entities.
+type CourseMemberGroupBy struct {
+	selector
+	build *CourseMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cmgb *CourseMemberGroupBy) Aggregate(fns ...AggregateFunc) *CourseMemberGroupBy {
+	cmgb.fns = append(cmgb.fns, fns...)
+	return cmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cmgb *CourseMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cmgb.build.ctx, "GroupBy")
+	if err := cmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseMemberQuery, *CourseMemberGroupBy](ctx, cmgb.build, cmgb, cmgb.build.inters, v)
+}
+
+func (cmgb *CourseMemberGroupBy) sqlScan(ctx context.Context, root *CourseMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cmgb.fns))
+	for _, fn := range cmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cmgb.flds)+len(cmgb.fns))
+		for _, f := range *cmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CourseMemberSelect is the builder for selecting fields of CourseMember entities.
+type CourseMemberSelect struct {
+	*CourseMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cms *CourseMemberSelect) Aggregate(fns ...AggregateFunc) *CourseMemberSelect {
+	cms.fns = append(cms.fns, fns...)
+	return cms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cms *CourseMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cms.ctx, "Select")
+	if err := cms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CourseMemberQuery, *CourseMemberSelect](ctx, cms.CourseMemberQuery, cms, cms.inters, v)
+}
+
+func (cms *CourseMemberSelect) sqlScan(ctx context.Context, root *CourseMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cms.fns))
+	for _, fn := range cms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CourseMemberUpdate is the builder for updating CourseMember entities.
+type CourseMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CourseMemberMutation
+}
+
+// Where appends a list predicates to the CourseMemberUpdate builder.
+func (cmu *CourseMemberUpdate) Where(ps ...predicate.CourseMember) *CourseMemberUpdate {
+	cmu.mutation.Where(ps...)
+	return cmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmu *CourseMemberUpdate) SetUpdatedAt(t time.Time) *CourseMemberUpdate {
+	cmu.mutation.SetUpdatedAt(t)
+	return cmu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmu *CourseMemberUpdate) SetDeletedAt(t time.Time) *CourseMemberUpdate {
+	cmu.mutation.SetDeletedAt(t)
+	return cmu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmu *CourseMemberUpdate) SetNillableDeletedAt(t *time.Time) *CourseMemberUpdate {
+	if t != nil {
+		cmu.SetDeletedAt(*t)
+	}
+	return cmu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cmu *CourseMemberUpdate) ClearDeletedAt() *CourseMemberUpdate {
+	cmu.mutation.ClearDeletedAt()
+	return cmu
+}
+
+// SetCourseID sets the "course_id" field.
+func (cmu *CourseMemberUpdate) SetCourseID(i int) *CourseMemberUpdate {
+	cmu.mutation.SetCourseID(i)
+	return cmu
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (cmu *CourseMemberUpdate) SetNillableCourseID(i *int) *CourseMemberUpdate {
+	if i != nil {
+		cmu.SetCourseID(*i)
+	}
+	return cmu
+}
+
+// SetUserID sets the "user_id" field.
+func (cmu *CourseMemberUpdate) SetUserID(i int) *CourseMemberUpdate {
+	cmu.mutation.SetUserID(i)
+	return cmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cmu *CourseMemberUpdate) SetNillableUserID(i *int) *CourseMemberUpdate {
+	if i != nil {
+		cmu.SetUserID(*i)
+	}
+	return cmu
+}
+
+// SetRole sets the "role" field.
+func (cmu *CourseMemberUpdate) SetRole(c coursemember.Role) *CourseMemberUpdate {
+	cmu.mutation.SetRole(c)
+	return cmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cmu *CourseMemberUpdate) SetNillableRole(c *coursemember.Role) *CourseMemberUpdate {
+	if c != nil {
+		cmu.SetRole(*c)
+	}
+	return cmu
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (cmu *CourseMemberUpdate) SetCourse(c *Course) *CourseMemberUpdate {
+	return cmu.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cmu *CourseMemberUpdate) SetUser(u *User) *CourseMemberUpdate {
+	return cmu.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseMemberMutation object of the builder.
+func (cmu *CourseMemberUpdate) Mutation() *CourseMemberMutation {
+	return cmu.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (cmu *CourseMemberUpdate) ClearCourse() *CourseMemberUpdate {
+	cmu.mutation.ClearCourse()
+	return cmu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cmu *CourseMemberUpdate) ClearUser() *CourseMemberUpdate {
+	cmu.mutation.ClearUser()
+	return cmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cmu *CourseMemberUpdate) Save(ctx context.Context) (int, error) {
+	if err := cmu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cmu.sqlSave, cmu.mutation, cmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmu *CourseMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := cmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cmu *CourseMemberUpdate) Exec(ctx context.Context) error {
+	_, err := cmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmu *CourseMemberUpdate) ExecX(ctx context.Context) {
+	if err := cmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmu *CourseMemberUpdate) defaults() error {
+	if _, ok := cmu.mutation.UpdatedAt(); !ok {
+		if coursemember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursemember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursemember.UpdateDefaultUpdatedAt()
+		cmu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmu *CourseMemberUpdate) check() error {
+	if v, ok := cmu.mutation.Role(); ok {
+		if err := coursemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseMember.role": %w`, err)}
+		}
+	}
+	if _, ok := cmu.mutation.CourseID(); cmu.mutation.CourseCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CourseMember.course"`)
+	}
+	if _, ok := cmu.mutation.UserID(); cmu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CourseMember.user"`)
+	}
+	return nil
+}
+
+func (cmu *CourseMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coursemember.Table, coursemember.Columns, sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt))
+	if ps := cmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursemember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cmu.mutation.DeletedAt(); ok {
+		_spec.SetField(coursemember.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cmu.mutation.DeletedAtCleared() {
+		_spec.ClearField(coursemember.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cmu.mutation.Role(); ok {
+		_spec.SetField(coursemember.FieldRole, field.TypeEnum, value)
+	}
+	if cmu.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.CourseTable,
+			Columns: []string{coursemember.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmu.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.CourseTable,
+			Columns: []string{coursemember.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.UserTable,
+			Columns: []string{coursemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.UserTable,
+			Columns: []string{coursemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coursemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cmu.mutation.done = true
+	return n, nil
+}
+
+// CourseMemberUpdateOne is the builder for updating a single CourseMember entity.
+type CourseMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CourseMemberMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmuo *CourseMemberUpdateOne) SetUpdatedAt(t time.Time) *CourseMemberUpdateOne {
+	cmuo.mutation.SetUpdatedAt(t)
+	return cmuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmuo *CourseMemberUpdateOne) SetDeletedAt(t time.Time) *CourseMemberUpdateOne {
+	cmuo.mutation.SetDeletedAt(t)
+	return cmuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmuo *CourseMemberUpdateOne) SetNillableDeletedAt(t *time.Time) *CourseMemberUpdateOne {
+	if t != nil {
+		cmuo.SetDeletedAt(*t)
+	}
+	return cmuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cmuo *CourseMemberUpdateOne) ClearDeletedAt() *CourseMemberUpdateOne {
+	cmuo.mutation.ClearDeletedAt()
+	return cmuo
+}
+
+// SetCourseID sets the "course_id" field.
+func (cmuo *CourseMemberUpdateOne) SetCourseID(i int) *CourseMemberUpdateOne {
+	cmuo.mutation.SetCourseID(i)
+	return cmuo
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (cmuo *CourseMemberUpdateOne) SetNillableCourseID(i *int) *CourseMemberUpdateOne {
+	if i != nil {
+		cmuo.SetCourseID(*i)
+	}
+	return cmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (cmuo *CourseMemberUpdateOne) SetUserID(i int) *CourseMemberUpdateOne {
+	cmuo.mutation.SetUserID(i)
+	return cmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cmuo *CourseMemberUpdateOne) SetNillableUserID(i *int) *CourseMemberUpdateOne {
+	if i != nil {
+		cmuo.SetUserID(*i)
+	}
+	return cmuo
+}
+
+// SetRole sets the "role" field.
+func (cmuo *CourseMemberUpdateOne) SetRole(c coursemember.Role) *CourseMemberUpdateOne {
+	cmuo.mutation.SetRole(c)
+	return cmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cmuo *CourseMemberUpdateOne) SetNillableRole(c *coursemember.Role) *CourseMemberUpdateOne {
+	if c != nil {
+		cmuo.SetRole(*c)
+	}
+	return cmuo
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (cmuo *CourseMemberUpdateOne) SetCourse(c *Course) *CourseMemberUpdateOne {
+	return cmuo.SetCourseID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cmuo *CourseMemberUpdateOne) SetUser(u *User) *CourseMemberUpdateOne {
+	return cmuo.SetUserID(u.ID)
+}
+
+// Mutation returns the CourseMemberMutation object of the builder.
+func (cmuo *CourseMemberUpdateOne) Mutation() *CourseMemberMutation {
+	return cmuo.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (cmuo *CourseMemberUpdateOne) ClearCourse() *CourseMemberUpdateOne {
+	cmuo.mutation.ClearCourse()
+	return cmuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cmuo *CourseMemberUpdateOne) ClearUser() *CourseMemberUpdateOne {
+	cmuo.mutation.ClearUser()
+	return cmuo
+}
+
+// Where appends a list predicates to the CourseMemberUpdate builder.
+func (cmuo *CourseMemberUpdateOne) Where(ps ...predicate.CourseMember) *CourseMemberUpdateOne {
+	cmuo.mutation.Where(ps...)
+	return cmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cmuo *CourseMemberUpdateOne) Select(field string, fields ...string) *CourseMemberUpdateOne {
+	cmuo.fields = append([]string{field}, fields...)
+	return cmuo
+}
+
+// Save executes the query and returns the updated CourseMember entity.
+func (cmuo *CourseMemberUpdateOne) Save(ctx context.Context) (*CourseMember, error) {
+	if err := cmuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cmuo.sqlSave, cmuo.mutation, cmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmuo *CourseMemberUpdateOne) SaveX(ctx context.Context) *CourseMember {
+	node, err := cmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cmuo *CourseMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := cmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmuo *CourseMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := cmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmuo *CourseMemberUpdateOne) defaults() error {
+	if _, ok := cmuo.mutation.UpdatedAt(); !ok {
+		if coursemember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coursemember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coursemember.UpdateDefaultUpdatedAt()
+		cmuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmuo *CourseMemberUpdateOne) check() error {
+	if v, ok := cmuo.mutation.Role(); ok {
+		if err := coursemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "CourseMember.role": %w`, err)}
+		}
+	}
+	if _, ok := cmuo.mutation.CourseID(); cmuo.mutation.CourseCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CourseMember.course"`)
+	}
+	if _, ok := cmuo.mutation.UserID(); cmuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CourseMember.user"`)
+	}
+	return nil
+}
+
+func (cmuo *CourseMemberUpdateOne) sqlSave(ctx context.Context) (_node *CourseMember, err error) {
+	if err := cmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coursemember.Table, coursemember.Columns, sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt))
+	id, ok := cmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CourseMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coursemember.FieldID)
+		for _, f := range fields {
+			if !coursemember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coursemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(coursemember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cmuo.mutation.DeletedAt(); ok {
+		_spec.SetField(coursemember.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cmuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(coursemember.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cmuo.mutation.Role(); ok {
+		_spec.SetField(coursemember.FieldRole, field.TypeEnum, value)
+	}
+	if cmuo.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.CourseTable,
+			Columns: []string{coursemember.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmuo.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.CourseTable,
+			Columns: []string{coursemember.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.UserTable,
+			Columns: []string{coursemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coursemember.UserTable,
+			Columns: []string{coursemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CourseMember{config: cmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coursemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cmuo.mutation.done = true
+	return _node, nil
+}
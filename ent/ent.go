@@ -12,14 +12,25 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/institution"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/major"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthapp"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
@@ -85,19 +96,30 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			davaccount.Table:    davaccount.ValidColumn,
-			directlink.Table:    directlink.ValidColumn,
-			entity.Table:        entity.ValidColumn,
-			file.Table:          file.ValidColumn,
-			group.Table:         group.ValidColumn,
-			metadata.Table:      metadata.ValidColumn,
-			node.Table:          node.ValidColumn,
-			passkey.Table:       passkey.ValidColumn,
-			setting.Table:       setting.ValidColumn,
-			share.Table:         share.ValidColumn,
-			storagepolicy.Table: storagepolicy.ValidColumn,
-			task.Table:          task.ValidColumn,
-			user.Table:          user.ValidColumn,
+			assignment.Table:          assignment.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			course.Table:              course.ValidColumn,
+			coursemember.Table:        coursemember.ValidColumn,
+			davaccount.Table:          davaccount.ValidColumn,
+			directlink.Table:          directlink.ValidColumn,
+			entity.Table:              entity.ValidColumn,
+			externalidentity.Table:    externalidentity.ValidColumn,
+			file.Table:                file.ValidColumn,
+			group.Table:               group.ValidColumn,
+			institution.Table:         institution.ValidColumn,
+			loginsession.Table:        loginsession.ValidColumn,
+			major.Table:               major.ValidColumn,
+			metadata.Table:            metadata.ValidColumn,
+			node.Table:                node.ValidColumn,
+			oauthapp.Table:            oauthapp.ValidColumn,
+			oauthgrant.Table:          oauthgrant.ValidColumn,
+			passkey.Table:             passkey.ValidColumn,
+			personalaccesstoken.Table: personalaccesstoken.ValidColumn,
+			setting.Table:             setting.ValidColumn,
+			share.Table:               share.ValidColumn,
+			storagepolicy.Table:       storagepolicy.ValidColumn,
+			task.Table:                task.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// ExternalIdentityCreate is the builder for creating a ExternalIdentity entity.
+type ExternalIdentityCreate struct {
+	config
+	mutation *ExternalIdentityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eic *ExternalIdentityCreate) SetCreatedAt(t time.Time) *ExternalIdentityCreate {
+	eic.mutation.SetCreatedAt(t)
+	return eic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eic *ExternalIdentityCreate) SetNillableCreatedAt(t *time.Time) *ExternalIdentityCreate {
+	if t != nil {
+		eic.SetCreatedAt(*t)
+	}
+	return eic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eic *ExternalIdentityCreate) SetUpdatedAt(t time.Time) *ExternalIdentityCreate {
+	eic.mutation.SetUpdatedAt(t)
+	return eic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (eic *ExternalIdentityCreate) SetNillableUpdatedAt(t *time.Time) *ExternalIdentityCreate {
+	if t != nil {
+		eic.SetUpdatedAt(*t)
+	}
+	return eic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (eic *ExternalIdentityCreate) SetDeletedAt(t time.Time) *ExternalIdentityCreate {
+	eic.mutation.SetDeletedAt(t)
+	return eic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (eic *ExternalIdentityCreate) SetNillableDeletedAt(t *time.Time) *ExternalIdentityCreate {
+	if t != nil {
+		eic.SetDeletedAt(*t)
+	}
+	return eic
+}
+
+// SetUserID sets the "user_id" field.
+func (eic *ExternalIdentityCreate) SetUserID(i int) *ExternalIdentityCreate {
+	eic.mutation.SetUserID(i)
+	return eic
+}
+
+// SetProvider sets the "provider" field.
+func (eic *ExternalIdentityCreate) SetProvider(s string) *ExternalIdentityCreate {
+	eic.mutation.SetProvider(s)
+	return eic
+}
+
+// SetSubject sets the "subject" field.
+func (eic *ExternalIdentityCreate) SetSubject(s string) *ExternalIdentityCreate {
+	eic.mutation.SetSubject(s)
+	return eic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (eic *ExternalIdentityCreate) SetUser(u *User) *ExternalIdentityCreate {
+	return eic.SetUserID(u.ID)
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (eic *ExternalIdentityCreate) Mutation() *ExternalIdentityMutation {
+	return eic.mutation
+}
+
+// Save creates the ExternalIdentity in the database.
+func (eic *ExternalIdentityCreate) Save(ctx context.Context) (*ExternalIdentity, error) {
+	if err := eic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, eic.sqlSave, eic.mutation, eic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eic *ExternalIdentityCreate) SaveX(ctx context.Context) *ExternalIdentity {
+	v, err := eic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eic *ExternalIdentityCreate) Exec(ctx context.Context) error {
+	_, err := eic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eic *ExternalIdentityCreate) ExecX(ctx context.Context) {
+	if err := eic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eic *ExternalIdentityCreate) defaults() error {
+	if _, ok := eic.mutation.CreatedAt(); !ok {
+		if externalidentity.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized externalidentity.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := externalidentity.DefaultCreatedAt()
+		eic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := eic.mutation.UpdatedAt(); !ok {
+		if externalidentity.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized externalidentity.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := externalidentity.DefaultUpdatedAt()
+		eic.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eic *ExternalIdentityCreate) check() error {
+	if _, ok := eic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExternalIdentity.created_at"`)}
+	}
+	if _, ok := eic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExternalIdentity.updated_at"`)}
+	}
+	if _, ok := eic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ExternalIdentity.user_id"`)}
+	}
+	if _, ok := eic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ExternalIdentity.provider"`)}
+	}
+	if _, ok := eic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "ExternalIdentity.subject"`)}
+	}
+	if _, ok := eic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ExternalIdentity.user"`)}
+	}
+	return nil
+}
+
+func (eic *ExternalIdentityCreate) sqlSave(ctx context.Context) (*ExternalIdentity, error) {
+	if err := eic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eic.mutation.id = &_node.ID
+	eic.mutation.done = true
+	return _node, nil
+}
+
+func (eic *ExternalIdentityCreate) createSpec() (*ExternalIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExternalIdentity{config: eic.config}
+		_spec = sqlgraph.NewCreateSpec(externalidentity.Table, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	)
+
+	if id, ok := eic.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = eic.conflict
+	if value, ok := eic.mutation.CreatedAt(); ok {
+		_spec.SetField(externalidentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := eic.mutation.UpdatedAt(); ok {
+		_spec.SetField(externalidentity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := eic.mutation.DeletedAt(); ok {
+		_spec.SetField(externalidentity.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := eic.mutation.Provider(); ok {
+		_spec.SetField(externalidentity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := eic.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if nodes := eic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExternalIdentity.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExternalIdentityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (eic *ExternalIdentityCreate) OnConflict(opts ...sql.ConflictOption) *ExternalIdentityUpsertOne {
+	eic.conflict = opts
+	return &ExternalIdentityUpsertOne{
+		create: eic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eic *ExternalIdentityCreate) OnConflictColumns(columns ...string) *ExternalIdentityUpsertOne {
+	eic.conflict = append(eic.conflict, sql.ConflictColumns(columns...))
+	return &ExternalIdentityUpsertOne{
+		create: eic,
+	}
+}
+
+type (
+	// ExternalIdentityUpsertOne is the builder for "upsert"-ing
+	//  one ExternalIdentity node.
+	ExternalIdentityUpsertOne struct {
+		create *ExternalIdentityCreate
+	}
+
+	// ExternalIdentityUpsert is the "OnConflict" setter.
+	ExternalIdentityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExternalIdentityUpsert) SetUpdatedAt(v time.Time) *ExternalIdentityUpsert {
+	u.Set(externalidentity.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsert) UpdateUpdatedAt() *ExternalIdentityUpsert {
+	u.SetExcluded(externalidentity.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ExternalIdentityUpsert) SetDeletedAt(v time.Time) *ExternalIdentityUpsert {
+	u.Set(externalidentity.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsert) UpdateDeletedAt() *ExternalIdentityUpsert {
+	u.SetExcluded(externalidentity.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ExternalIdentityUpsert) ClearDeletedAt() *ExternalIdentityUpsert {
+	u.SetNull(externalidentity.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ExternalIdentityUpsert) SetUserID(v int) *ExternalIdentityUpsert {
+	u.Set(externalidentity.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ExternalIdentityUpsert) UpdateUserID() *ExternalIdentityUpsert {
+	u.SetExcluded(externalidentity.FieldUserID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *ExternalIdentityUpsert) SetProvider(v string) *ExternalIdentityUpsert {
+	u.Set(externalidentity.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ExternalIdentityUpsert) UpdateProvider() *ExternalIdentityUpsert {
+	u.SetExcluded(externalidentity.FieldProvider)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *ExternalIdentityUpsert) SetSubject(v string) *ExternalIdentityUpsert {
+	u.Set(externalidentity.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ExternalIdentityUpsert) UpdateSubject() *ExternalIdentityUpsert {
+	u.SetExcluded(externalidentity.FieldSubject)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExternalIdentityUpsertOne) UpdateNewValues() *ExternalIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(externalidentity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExternalIdentityUpsertOne) Ignore() *ExternalIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExternalIdentityUpsertOne) DoNothing() *ExternalIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExternalIdentityCreate.OnConflict
+// documentation for more info.
+func (u *ExternalIdentityUpsertOne) Update(set func(*ExternalIdentityUpsert)) *ExternalIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExternalIdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExternalIdentityUpsertOne) SetUpdatedAt(v time.Time) *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertOne) UpdateUpdatedAt() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ExternalIdentityUpsertOne) SetDeletedAt(v time.Time) *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertOne) UpdateDeletedAt() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ExternalIdentityUpsertOne) ClearDeletedAt() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ExternalIdentityUpsertOne) SetUserID(v int) *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertOne) UpdateUserID() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ExternalIdentityUpsertOne) SetProvider(v string) *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertOne) UpdateProvider() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *ExternalIdentityUpsertOne) SetSubject(v string) *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertOne) UpdateSubject() *ExternalIdentityUpsertOne {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *ExternalIdentityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExternalIdentityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExternalIdentityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExternalIdentityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExternalIdentityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ExternalIdentityCreate) SetRawID(t int) *ExternalIdentityCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ExternalIdentityCreateBulk is the builder for creating many ExternalIdentity entities in bulk.
+type ExternalIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*ExternalIdentityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExternalIdentity entities in the database.
+func (eicb *ExternalIdentityCreateBulk) Save(ctx context.Context) ([]*ExternalIdentity, error) {
+	if eicb.err != nil {
+		return nil, eicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eicb.builders))
+	nodes := make([]*ExternalIdentity, len(eicb.builders))
+	mutators := make([]Mutator, len(eicb.builders))
+	for i := range eicb.builders {
+		func(i int, root context.Context) {
+			builder := eicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExternalIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eicb *ExternalIdentityCreateBulk) SaveX(ctx context.Context) []*ExternalIdentity {
+	v, err := eicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eicb *ExternalIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := eicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eicb *ExternalIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := eicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExternalIdentity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExternalIdentityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (eicb *ExternalIdentityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExternalIdentityUpsertBulk {
+	eicb.conflict = opts
+	return &ExternalIdentityUpsertBulk{
+		create: eicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eicb *ExternalIdentityCreateBulk) OnConflictColumns(columns ...string) *ExternalIdentityUpsertBulk {
+	eicb.conflict = append(eicb.conflict, sql.ConflictColumns(columns...))
+	return &ExternalIdentityUpsertBulk{
+		create: eicb,
+	}
+}
+
+// ExternalIdentityUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExternalIdentity nodes.
+type ExternalIdentityUpsertBulk struct {
+	create *ExternalIdentityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExternalIdentityUpsertBulk) UpdateNewValues() *ExternalIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(externalidentity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExternalIdentity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExternalIdentityUpsertBulk) Ignore() *ExternalIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExternalIdentityUpsertBulk) DoNothing() *ExternalIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExternalIdentityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExternalIdentityUpsertBulk) Update(set func(*ExternalIdentityUpsert)) *ExternalIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExternalIdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExternalIdentityUpsertBulk) SetUpdatedAt(v time.Time) *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertBulk) UpdateUpdatedAt() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ExternalIdentityUpsertBulk) SetDeletedAt(v time.Time) *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertBulk) UpdateDeletedAt() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ExternalIdentityUpsertBulk) ClearDeletedAt() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ExternalIdentityUpsertBulk) SetUserID(v int) *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertBulk) UpdateUserID() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ExternalIdentityUpsertBulk) SetProvider(v string) *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertBulk) UpdateProvider() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *ExternalIdentityUpsertBulk) SetSubject(v string) *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ExternalIdentityUpsertBulk) UpdateSubject() *ExternalIdentityUpsertBulk {
+	return u.Update(func(s *ExternalIdentityUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *ExternalIdentityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExternalIdentityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExternalIdentityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExternalIdentityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
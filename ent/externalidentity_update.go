@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// ExternalIdentityUpdate is the builder for updating ExternalIdentity entities.
+type ExternalIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (eiu *ExternalIdentityUpdate) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdate {
+	eiu.mutation.Where(ps...)
+	return eiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eiu *ExternalIdentityUpdate) SetUpdatedAt(t time.Time) *ExternalIdentityUpdate {
+	eiu.mutation.SetUpdatedAt(t)
+	return eiu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (eiu *ExternalIdentityUpdate) SetDeletedAt(t time.Time) *ExternalIdentityUpdate {
+	eiu.mutation.SetDeletedAt(t)
+	return eiu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableDeletedAt(t *time.Time) *ExternalIdentityUpdate {
+	if t != nil {
+		eiu.SetDeletedAt(*t)
+	}
+	return eiu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (eiu *ExternalIdentityUpdate) ClearDeletedAt() *ExternalIdentityUpdate {
+	eiu.mutation.ClearDeletedAt()
+	return eiu
+}
+
+// SetUserID sets the "user_id" field.
+func (eiu *ExternalIdentityUpdate) SetUserID(i int) *ExternalIdentityUpdate {
+	eiu.mutation.SetUserID(i)
+	return eiu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableUserID(i *int) *ExternalIdentityUpdate {
+	if i != nil {
+		eiu.SetUserID(*i)
+	}
+	return eiu
+}
+
+// SetProvider sets the "provider" field.
+func (eiu *ExternalIdentityUpdate) SetProvider(s string) *ExternalIdentityUpdate {
+	eiu.mutation.SetProvider(s)
+	return eiu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableProvider(s *string) *ExternalIdentityUpdate {
+	if s != nil {
+		eiu.SetProvider(*s)
+	}
+	return eiu
+}
+
+// SetSubject sets the "subject" field.
+func (eiu *ExternalIdentityUpdate) SetSubject(s string) *ExternalIdentityUpdate {
+	eiu.mutation.SetSubject(s)
+	return eiu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (eiu *ExternalIdentityUpdate) SetNillableSubject(s *string) *ExternalIdentityUpdate {
+	if s != nil {
+		eiu.SetSubject(*s)
+	}
+	return eiu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (eiu *ExternalIdentityUpdate) SetUser(u *User) *ExternalIdentityUpdate {
+	return eiu.SetUserID(u.ID)
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (eiu *ExternalIdentityUpdate) Mutation() *ExternalIdentityMutation {
+	return eiu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (eiu *ExternalIdentityUpdate) ClearUser() *ExternalIdentityUpdate {
+	eiu.mutation.ClearUser()
+	return eiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eiu *ExternalIdentityUpdate) Save(ctx context.Context) (int, error) {
+	if err := eiu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, eiu.sqlSave, eiu.mutation, eiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiu *ExternalIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := eiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eiu *ExternalIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := eiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiu *ExternalIdentityUpdate) ExecX(ctx context.Context) {
+	if err := eiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eiu *ExternalIdentityUpdate) defaults() error {
+	if _, ok := eiu.mutation.UpdatedAt(); !ok {
+		if externalidentity.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized externalidentity.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := externalidentity.UpdateDefaultUpdatedAt()
+		eiu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiu *ExternalIdentityUpdate) check() error {
+	if _, ok := eiu.mutation.UserID(); eiu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (eiu *ExternalIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	if ps := eiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(externalidentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := eiu.mutation.DeletedAt(); ok {
+		_spec.SetField(externalidentity.FieldDeletedAt, field.TypeTime, value)
+	}
+	if eiu.mutation.DeletedAtCleared() {
+		_spec.ClearField(externalidentity.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := eiu.mutation.Provider(); ok {
+		_spec.SetField(externalidentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := eiu.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+	}
+	if eiu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eiu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eiu.mutation.done = true
+	return n, nil
+}
+
+// ExternalIdentityUpdateOne is the builder for updating a single ExternalIdentity entity.
+type ExternalIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eiuo *ExternalIdentityUpdateOne) SetUpdatedAt(t time.Time) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetUpdatedAt(t)
+	return eiuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (eiuo *ExternalIdentityUpdateOne) SetDeletedAt(t time.Time) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetDeletedAt(t)
+	return eiuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableDeletedAt(t *time.Time) *ExternalIdentityUpdateOne {
+	if t != nil {
+		eiuo.SetDeletedAt(*t)
+	}
+	return eiuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (eiuo *ExternalIdentityUpdateOne) ClearDeletedAt() *ExternalIdentityUpdateOne {
+	eiuo.mutation.ClearDeletedAt()
+	return eiuo
+}
+
+// SetUserID sets the "user_id" field.
+func (eiuo *ExternalIdentityUpdateOne) SetUserID(i int) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetUserID(i)
+	return eiuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableUserID(i *int) *ExternalIdentityUpdateOne {
+	if i != nil {
+		eiuo.SetUserID(*i)
+	}
+	return eiuo
+}
+
+// SetProvider sets the "provider" field.
+func (eiuo *ExternalIdentityUpdateOne) SetProvider(s string) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetProvider(s)
+	return eiuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableProvider(s *string) *ExternalIdentityUpdateOne {
+	if s != nil {
+		eiuo.SetProvider(*s)
+	}
+	return eiuo
+}
+
+// SetSubject sets the "subject" field.
+func (eiuo *ExternalIdentityUpdateOne) SetSubject(s string) *ExternalIdentityUpdateOne {
+	eiuo.mutation.SetSubject(s)
+	return eiuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (eiuo *ExternalIdentityUpdateOne) SetNillableSubject(s *string) *ExternalIdentityUpdateOne {
+	if s != nil {
+		eiuo.SetSubject(*s)
+	}
+	return eiuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (eiuo *ExternalIdentityUpdateOne) SetUser(u *User) *ExternalIdentityUpdateOne {
+	return eiuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (eiuo *ExternalIdentityUpdateOne) Mutation() *ExternalIdentityMutation {
+	return eiuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (eiuo *ExternalIdentityUpdateOne) ClearUser() *ExternalIdentityUpdateOne {
+	eiuo.mutation.ClearUser()
+	return eiuo
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (eiuo *ExternalIdentityUpdateOne) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdateOne {
+	eiuo.mutation.Where(ps...)
+	return eiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eiuo *ExternalIdentityUpdateOne) Select(field string, fields ...string) *ExternalIdentityUpdateOne {
+	eiuo.fields = append([]string{field}, fields...)
+	return eiuo
+}
+
+// Save executes the query and returns the updated ExternalIdentity entity.
+func (eiuo *ExternalIdentityUpdateOne) Save(ctx context.Context) (*ExternalIdentity, error) {
+	if err := eiuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, eiuo.sqlSave, eiuo.mutation, eiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiuo *ExternalIdentityUpdateOne) SaveX(ctx context.Context) *ExternalIdentity {
+	node, err := eiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eiuo *ExternalIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := eiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiuo *ExternalIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := eiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eiuo *ExternalIdentityUpdateOne) defaults() error {
+	if _, ok := eiuo.mutation.UpdatedAt(); !ok {
+		if externalidentity.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized externalidentity.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := externalidentity.UpdateDefaultUpdatedAt()
+		eiuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiuo *ExternalIdentityUpdateOne) check() error {
+	if _, ok := eiuo.mutation.UserID(); eiuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (eiuo *ExternalIdentityUpdateOne) sqlSave(ctx context.Context) (_node *ExternalIdentity, err error) {
+	if err := eiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt))
+	id, ok := eiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExternalIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalidentity.FieldID)
+		for _, f := range fields {
+			if !externalidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != externalidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(externalidentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := eiuo.mutation.DeletedAt(); ok {
+		_spec.SetField(externalidentity.FieldDeletedAt, field.TypeTime, value)
+	}
+	if eiuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(externalidentity.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := eiuo.mutation.Provider(); ok {
+		_spec.SetField(externalidentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := eiuo.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+	}
+	if eiuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eiuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExternalIdentity{config: eiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eiuo.mutation.done = true
+	return _node, nil
+}
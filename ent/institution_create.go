@@ -0,0 +1,952 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/institution"
+	"github.com/cloudreve/Cloudreve/v4/ent/major"
+)
+
+// InstitutionCreate is the builder for creating a Institution entity.
+type InstitutionCreate struct {
+	config
+	mutation *InstitutionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InstitutionCreate) SetCreatedAt(t time.Time) *InstitutionCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InstitutionCreate) SetNillableCreatedAt(t *time.Time) *InstitutionCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InstitutionCreate) SetUpdatedAt(t time.Time) *InstitutionCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InstitutionCreate) SetNillableUpdatedAt(t *time.Time) *InstitutionCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InstitutionCreate) SetDeletedAt(t time.Time) *InstitutionCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InstitutionCreate) SetNillableDeletedAt(t *time.Time) *InstitutionCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
+// SetName sets the "name" field.
+func (ic *InstitutionCreate) SetName(s string) *InstitutionCreate {
+	ic.mutation.SetName(s)
+	return ic
+}
+
+// SetAliases sets the "aliases" field.
+func (ic *InstitutionCreate) SetAliases(s []string) *InstitutionCreate {
+	ic.mutation.SetAliases(s)
+	return ic
+}
+
+// SetGroupID sets the "group_id" field.
+func (ic *InstitutionCreate) SetGroupID(i int) *InstitutionCreate {
+	ic.mutation.SetGroupID(i)
+	return ic
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (ic *InstitutionCreate) SetNillableGroupID(i *int) *InstitutionCreate {
+	if i != nil {
+		ic.SetGroupID(*i)
+	}
+	return ic
+}
+
+// SetExtraStorage sets the "extra_storage" field.
+func (ic *InstitutionCreate) SetExtraStorage(i int64) *InstitutionCreate {
+	ic.mutation.SetExtraStorage(i)
+	return ic
+}
+
+// SetNillableExtraStorage sets the "extra_storage" field if the given value is not nil.
+func (ic *InstitutionCreate) SetNillableExtraStorage(i *int64) *InstitutionCreate {
+	if i != nil {
+		ic.SetExtraStorage(*i)
+	}
+	return ic
+}
+
+// AddMajorIDs adds the "majors" edge to the Major entity by IDs.
+func (ic *InstitutionCreate) AddMajorIDs(ids ...int) *InstitutionCreate {
+	ic.mutation.AddMajorIDs(ids...)
+	return ic
+}
+
+// AddMajors adds the "majors" edges to the Major entity.
+func (ic *InstitutionCreate) AddMajors(m ...*Major) *InstitutionCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ic.AddMajorIDs(ids...)
+}
+
+// Mutation returns the InstitutionMutation object of the builder.
+func (ic *InstitutionCreate) Mutation() *InstitutionMutation {
+	return ic.mutation
+}
+
+// Save creates the Institution in the database.
+func (ic *InstitutionCreate) Save(ctx context.Context) (*Institution, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InstitutionCreate) SaveX(ctx context.Context) *Institution {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InstitutionCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InstitutionCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InstitutionCreate) defaults() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if institution.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized institution.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := institution.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if institution.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized institution.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := institution.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ic.mutation.ExtraStorage(); !ok {
+		v := institution.DefaultExtraStorage
+		ic.mutation.SetExtraStorage(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InstitutionCreate) check() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Institution.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Institution.updated_at"`)}
+	}
+	if _, ok := ic.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Institution.name"`)}
+	}
+	if v, ok := ic.mutation.Name(); ok {
+		if err := institution.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Institution.name": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ExtraStorage(); !ok {
+		return &ValidationError{Name: "extra_storage", err: errors.New(`ent: missing required field "Institution.extra_storage"`)}
+	}
+	return nil
+}
+
+func (ic *InstitutionCreate) sqlSave(ctx context.Context) (*Institution, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InstitutionCreate) createSpec() (*Institution, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Institution{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(institution.Table, sqlgraph.NewFieldSpec(institution.FieldID, field.TypeInt))
+	)
+
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(institution.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.SetField(institution.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(institution.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ic.mutation.Name(); ok {
+		_spec.SetField(institution.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ic.mutation.Aliases(); ok {
+		_spec.SetField(institution.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := ic.mutation.GroupID(); ok {
+		_spec.SetField(institution.FieldGroupID, field.TypeInt, value)
+		_node.GroupID = value
+	}
+	if value, ok := ic.mutation.ExtraStorage(); ok {
+		_spec.SetField(institution.FieldExtraStorage, field.TypeInt64, value)
+		_node.ExtraStorage = value
+	}
+	if nodes := ic.mutation.MajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   institution.MajorsTable,
+			Columns: []string{institution.MajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Institution.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InstitutionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InstitutionCreate) OnConflict(opts ...sql.ConflictOption) *InstitutionUpsertOne {
+	ic.conflict = opts
+	return &InstitutionUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InstitutionCreate) OnConflictColumns(columns ...string) *InstitutionUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InstitutionUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InstitutionUpsertOne is the builder for "upsert"-ing
+	//  one Institution node.
+	InstitutionUpsertOne struct {
+		create *InstitutionCreate
+	}
+
+	// InstitutionUpsert is the "OnConflict" setter.
+	InstitutionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InstitutionUpsert) SetUpdatedAt(v time.Time) *InstitutionUpsert {
+	u.Set(institution.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateUpdatedAt() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InstitutionUpsert) SetDeletedAt(v time.Time) *InstitutionUpsert {
+	u.Set(institution.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateDeletedAt() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InstitutionUpsert) ClearDeletedAt() *InstitutionUpsert {
+	u.SetNull(institution.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *InstitutionUpsert) SetName(v string) *InstitutionUpsert {
+	u.Set(institution.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateName() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldName)
+	return u
+}
+
+// SetAliases sets the "aliases" field.
+func (u *InstitutionUpsert) SetAliases(v []string) *InstitutionUpsert {
+	u.Set(institution.FieldAliases, v)
+	return u
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateAliases() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldAliases)
+	return u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *InstitutionUpsert) ClearAliases() *InstitutionUpsert {
+	u.SetNull(institution.FieldAliases)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InstitutionUpsert) SetGroupID(v int) *InstitutionUpsert {
+	u.Set(institution.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateGroupID() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldGroupID)
+	return u
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InstitutionUpsert) AddGroupID(v int) *InstitutionUpsert {
+	u.Add(institution.FieldGroupID, v)
+	return u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InstitutionUpsert) ClearGroupID() *InstitutionUpsert {
+	u.SetNull(institution.FieldGroupID)
+	return u
+}
+
+// SetExtraStorage sets the "extra_storage" field.
+func (u *InstitutionUpsert) SetExtraStorage(v int64) *InstitutionUpsert {
+	u.Set(institution.FieldExtraStorage, v)
+	return u
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *InstitutionUpsert) UpdateExtraStorage() *InstitutionUpsert {
+	u.SetExcluded(institution.FieldExtraStorage)
+	return u
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *InstitutionUpsert) AddExtraStorage(v int64) *InstitutionUpsert {
+	u.Add(institution.FieldExtraStorage, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InstitutionUpsertOne) UpdateNewValues() *InstitutionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(institution.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InstitutionUpsertOne) Ignore() *InstitutionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InstitutionUpsertOne) DoNothing() *InstitutionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InstitutionCreate.OnConflict
+// documentation for more info.
+func (u *InstitutionUpsertOne) Update(set func(*InstitutionUpsert)) *InstitutionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InstitutionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InstitutionUpsertOne) SetUpdatedAt(v time.Time) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateUpdatedAt() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InstitutionUpsertOne) SetDeletedAt(v time.Time) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateDeletedAt() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InstitutionUpsertOne) ClearDeletedAt() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *InstitutionUpsertOne) SetName(v string) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateName() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *InstitutionUpsertOne) SetAliases(v []string) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateAliases() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *InstitutionUpsertOne) ClearAliases() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearAliases()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InstitutionUpsertOne) SetGroupID(v int) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InstitutionUpsertOne) AddGroupID(v int) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateGroupID() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InstitutionUpsertOne) ClearGroupID() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetExtraStorage sets the "extra_storage" field.
+func (u *InstitutionUpsertOne) SetExtraStorage(v int64) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetExtraStorage(v)
+	})
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *InstitutionUpsertOne) AddExtraStorage(v int64) *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.AddExtraStorage(v)
+	})
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *InstitutionUpsertOne) UpdateExtraStorage() *InstitutionUpsertOne {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateExtraStorage()
+	})
+}
+
+// Exec executes the query.
+func (u *InstitutionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InstitutionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InstitutionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InstitutionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InstitutionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *InstitutionCreate) SetRawID(t int) *InstitutionCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// InstitutionCreateBulk is the builder for creating many Institution entities in bulk.
+type InstitutionCreateBulk struct {
+	config
+	err      error
+	builders []*InstitutionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Institution entities in the database.
+func (icb *InstitutionCreateBulk) Save(ctx context.Context) ([]*Institution, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Institution, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InstitutionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InstitutionCreateBulk) SaveX(ctx context.Context) []*Institution {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InstitutionCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InstitutionCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Institution.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InstitutionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InstitutionCreateBulk) OnConflict(opts ...sql.ConflictOption) *InstitutionUpsertBulk {
+	icb.conflict = opts
+	return &InstitutionUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InstitutionCreateBulk) OnConflictColumns(columns ...string) *InstitutionUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InstitutionUpsertBulk{
+		create: icb,
+	}
+}
+
+// InstitutionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Institution nodes.
+type InstitutionUpsertBulk struct {
+	create *InstitutionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InstitutionUpsertBulk) UpdateNewValues() *InstitutionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(institution.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Institution.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InstitutionUpsertBulk) Ignore() *InstitutionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InstitutionUpsertBulk) DoNothing() *InstitutionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InstitutionCreateBulk.OnConflict
+// documentation for more info.
+func (u *InstitutionUpsertBulk) Update(set func(*InstitutionUpsert)) *InstitutionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InstitutionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InstitutionUpsertBulk) SetUpdatedAt(v time.Time) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateUpdatedAt() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InstitutionUpsertBulk) SetDeletedAt(v time.Time) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateDeletedAt() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InstitutionUpsertBulk) ClearDeletedAt() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *InstitutionUpsertBulk) SetName(v string) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateName() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *InstitutionUpsertBulk) SetAliases(v []string) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateAliases() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *InstitutionUpsertBulk) ClearAliases() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearAliases()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InstitutionUpsertBulk) SetGroupID(v int) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InstitutionUpsertBulk) AddGroupID(v int) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateGroupID() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InstitutionUpsertBulk) ClearGroupID() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetExtraStorage sets the "extra_storage" field.
+func (u *InstitutionUpsertBulk) SetExtraStorage(v int64) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.SetExtraStorage(v)
+	})
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *InstitutionUpsertBulk) AddExtraStorage(v int64) *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.AddExtraStorage(v)
+	})
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *InstitutionUpsertBulk) UpdateExtraStorage() *InstitutionUpsertBulk {
+	return u.Update(func(s *InstitutionUpsert) {
+		s.UpdateExtraStorage()
+	})
+}
+
+// Exec executes the query.
+func (u *InstitutionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InstitutionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InstitutionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InstitutionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// LoginSession is the model entity for the LoginSession schema.
+type LoginSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// RootTokenID holds the value of the "root_token_id" field.
+	RootTokenID string `json:"root_token_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	Revoked bool `json:"revoked,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoginSessionQuery when eager-loading is set.
+	Edges        LoginSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoginSessionEdges holds the relations/edges for other nodes in the graph.
+type LoginSessionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoginSessionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginsession.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case loginsession.FieldID, loginsession.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case loginsession.FieldRootTokenID, loginsession.FieldIP, loginsession.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case loginsession.FieldCreatedAt, loginsession.FieldUpdatedAt, loginsession.FieldDeletedAt, loginsession.FieldLastUsedAt, loginsession.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginSession fields.
+func (ls *LoginSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ls.ID = int(value.Int64)
+		case loginsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ls.CreatedAt = value.Time
+			}
+		case loginsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ls.UpdatedAt = value.Time
+			}
+		case loginsession.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ls.DeletedAt = new(time.Time)
+				*ls.DeletedAt = value.Time
+			}
+		case loginsession.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ls.UserID = int(value.Int64)
+			}
+		case loginsession.FieldRootTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root_token_id", values[i])
+			} else if value.Valid {
+				ls.RootTokenID = value.String
+			}
+		case loginsession.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ls.IP = value.String
+			}
+		case loginsession.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ls.UserAgent = value.String
+			}
+		case loginsession.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				ls.LastUsedAt = value.Time
+			}
+		case loginsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ls.ExpiresAt = value.Time
+			}
+		case loginsession.FieldRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				ls.Revoked = value.Bool
+			}
+		default:
+			ls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginSession.
+// This includes values selected through modifiers, order, etc.
+func (ls *LoginSession) Value(name string) (ent.Value, error) {
+	return ls.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LoginSession entity.
+func (ls *LoginSession) QueryUser() *UserQuery {
+	return NewLoginSessionClient(ls.config).QueryUser(ls)
+}
+
+// Update returns a builder for updating this LoginSession.
+// Note that you need to call LoginSession.Unwrap() before calling this method if this LoginSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LoginSession) Update() *LoginSessionUpdateOne {
+	return NewLoginSessionClient(ls.config).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LoginSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LoginSession) Unwrap() *LoginSession {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginSession is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LoginSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ls.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ls.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ls.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ls.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("root_token_id=")
+	builder.WriteString(ls.RootTokenID)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(ls.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(ls.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("last_used_at=")
+	builder.WriteString(ls.LastUsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ls.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revoked=")
+	builder.WriteString(fmt.Sprintf("%v", ls.Revoked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *LoginSession) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// LoginSessions is a parsable slice of LoginSession.
+type LoginSessions []*LoginSession
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package major
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldName, v))
+}
+
+// InstitutionID applies equality check predicate on the "institution_id" field. It's identical to InstitutionIDEQ.
+func InstitutionID(v int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldInstitutionID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ExtraStorage applies equality check predicate on the "extra_storage" field. It's identical to ExtraStorageEQ.
+func ExtraStorage(v int64) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldExtraStorage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Major {
+	return predicate.Major(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Major {
+	return predicate.Major(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Major {
+	return predicate.Major(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Major {
+	return predicate.Major(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Major {
+	return predicate.Major(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Major {
+	return predicate.Major(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Major {
+	return predicate.Major(sql.FieldContainsFold(FieldName, v))
+}
+
+// AliasesIsNil applies the IsNil predicate on the "aliases" field.
+func AliasesIsNil() predicate.Major {
+	return predicate.Major(sql.FieldIsNull(FieldAliases))
+}
+
+// AliasesNotNil applies the NotNil predicate on the "aliases" field.
+func AliasesNotNil() predicate.Major {
+	return predicate.Major(sql.FieldNotNull(FieldAliases))
+}
+
+// InstitutionIDEQ applies the EQ predicate on the "institution_id" field.
+func InstitutionIDEQ(v int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldInstitutionID, v))
+}
+
+// InstitutionIDNEQ applies the NEQ predicate on the "institution_id" field.
+func InstitutionIDNEQ(v int) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldInstitutionID, v))
+}
+
+// InstitutionIDIn applies the In predicate on the "institution_id" field.
+func InstitutionIDIn(vs ...int) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldInstitutionID, vs...))
+}
+
+// InstitutionIDNotIn applies the NotIn predicate on the "institution_id" field.
+func InstitutionIDNotIn(vs ...int) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldInstitutionID, vs...))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v int) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v int) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v int) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v int) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.Major {
+	return predicate.Major(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.Major {
+	return predicate.Major(sql.FieldNotNull(FieldGroupID))
+}
+
+// ExtraStorageEQ applies the EQ predicate on the "extra_storage" field.
+func ExtraStorageEQ(v int64) predicate.Major {
+	return predicate.Major(sql.FieldEQ(FieldExtraStorage, v))
+}
+
+// ExtraStorageNEQ applies the NEQ predicate on the "extra_storage" field.
+func ExtraStorageNEQ(v int64) predicate.Major {
+	return predicate.Major(sql.FieldNEQ(FieldExtraStorage, v))
+}
+
+// ExtraStorageIn applies the In predicate on the "extra_storage" field.
+func ExtraStorageIn(vs ...int64) predicate.Major {
+	return predicate.Major(sql.FieldIn(FieldExtraStorage, vs...))
+}
+
+// ExtraStorageNotIn applies the NotIn predicate on the "extra_storage" field.
+func ExtraStorageNotIn(vs ...int64) predicate.Major {
+	return predicate.Major(sql.FieldNotIn(FieldExtraStorage, vs...))
+}
+
+// ExtraStorageGT applies the GT predicate on the "extra_storage" field.
+func ExtraStorageGT(v int64) predicate.Major {
+	return predicate.Major(sql.FieldGT(FieldExtraStorage, v))
+}
+
+// ExtraStorageGTE applies the GTE predicate on the "extra_storage" field.
+func ExtraStorageGTE(v int64) predicate.Major {
+	return predicate.Major(sql.FieldGTE(FieldExtraStorage, v))
+}
+
+// ExtraStorageLT applies the LT predicate on the "extra_storage" field.
+func ExtraStorageLT(v int64) predicate.Major {
+	return predicate.Major(sql.FieldLT(FieldExtraStorage, v))
+}
+
+// ExtraStorageLTE applies the LTE predicate on the "extra_storage" field.
+func ExtraStorageLTE(v int64) predicate.Major {
+	return predicate.Major(sql.FieldLTE(FieldExtraStorage, v))
+}
+
+// HasInstitution applies the HasEdge predicate on the "institution" edge.
+func HasInstitution() predicate.Major {
+	return predicate.Major(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InstitutionTable, InstitutionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstitutionWith applies the HasEdge predicate on the "institution" edge with a given conditions (other predicates).
+func HasInstitutionWith(preds ...predicate.Institution) predicate.Major {
+	return predicate.Major(func(s *sql.Selector) {
+		step := newInstitutionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Major) predicate.Major {
+	return predicate.Major(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Major) predicate.Major {
+	return predicate.Major(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Major) predicate.Major {
+	return predicate.Major(sql.NotPredicates(p))
+}
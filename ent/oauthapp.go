@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthapp"
+)
+
+// OAuthApp is the model entity for the OAuthApp schema.
+type OAuthApp struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// SecretHash holds the value of the "secret_hash" field.
+	SecretHash string `json:"-"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// Homepage holds the value of the "homepage" field.
+	Homepage string `json:"homepage,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthAppQuery when eager-loading is set.
+	Edges        OAuthAppEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OAuthAppEdges holds the relations/edges for other nodes in the graph.
+type OAuthAppEdges struct {
+	// Grants holds the value of the grants edge.
+	Grants []*OAuthGrant `json:"grants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GrantsOrErr returns the Grants value or an error if the edge
+// was not loaded in eager-loading.
+func (e OAuthAppEdges) GrantsOrErr() ([]*OAuthGrant, error) {
+	if e.loadedTypes[0] {
+		return e.Grants, nil
+	}
+	return nil, &NotLoadedError{edge: "grants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthApp) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthapp.FieldRedirectUris, oauthapp.FieldScopes:
+			values[i] = new([]byte)
+		case oauthapp.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case oauthapp.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oauthapp.FieldName, oauthapp.FieldClientID, oauthapp.FieldSecretHash, oauthapp.FieldHomepage, oauthapp.FieldDescription:
+			values[i] = new(sql.NullString)
+		case oauthapp.FieldCreatedAt, oauthapp.FieldUpdatedAt, oauthapp.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthApp fields.
+func (oa *OAuthApp) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthapp.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oa.ID = int(value.Int64)
+		case oauthapp.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oa.CreatedAt = value.Time
+			}
+		case oauthapp.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oa.UpdatedAt = value.Time
+			}
+		case oauthapp.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				oa.DeletedAt = new(time.Time)
+				*oa.DeletedAt = value.Time
+			}
+		case oauthapp.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				oa.Name = value.String
+			}
+		case oauthapp.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oa.ClientID = value.String
+			}
+		case oauthapp.FieldSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_hash", values[i])
+			} else if value.Valid {
+				oa.SecretHash = value.String
+			}
+		case oauthapp.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oa.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oauthapp.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oa.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthapp.FieldHomepage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field homepage", values[i])
+			} else if value.Valid {
+				oa.Homepage = value.String
+			}
+		case oauthapp.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				oa.Description = value.String
+			}
+		case oauthapp.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				oa.Enabled = value.Bool
+			}
+		default:
+			oa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthApp.
+// This includes values selected through modifiers, order, etc.
+func (oa *OAuthApp) Value(name string) (ent.Value, error) {
+	return oa.selectValues.Get(name)
+}
+
+// QueryGrants queries the "grants" edge of the OAuthApp entity.
+func (oa *OAuthApp) QueryGrants() *OAuthGrantQuery {
+	return NewOAuthAppClient(oa.config).QueryGrants(oa)
+}
+
+// Update returns a builder for updating this OAuthApp.
+// Note that you need to call OAuthApp.Unwrap() before calling this method if this OAuthApp
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oa *OAuthApp) Update() *OAuthAppUpdateOne {
+	return NewOAuthAppClient(oa.config).UpdateOne(oa)
+}
+
+// Unwrap unwraps the OAuthApp entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oa *OAuthApp) Unwrap() *OAuthApp {
+	_tx, ok := oa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthApp is not a transactional entity")
+	}
+	oa.config.driver = _tx.drv
+	return oa
+}
+
+// String implements the fmt.Stringer.
+func (oa *OAuthApp) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthApp(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(oa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(oa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := oa.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(oa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(oa.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", oa.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oa.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("homepage=")
+	builder.WriteString(oa.Homepage)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(oa.Description)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", oa.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetGrants manually set the edge as loaded state.
+func (e *OAuthApp) SetGrants(v []*OAuthGrant) {
+	e.Edges.Grants = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// OAuthApps is a parsable slice of OAuthApp.
+type OAuthApps []*OAuthApp
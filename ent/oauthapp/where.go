@@ -0,0 +1,634 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthapp
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldName, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldClientID, v))
+}
+
+// SecretHash applies equality check predicate on the "secret_hash" field. It's identical to SecretHashEQ.
+func SecretHash(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldSecretHash, v))
+}
+
+// Homepage applies equality check predicate on the "homepage" field. It's identical to HomepageEQ.
+func Homepage(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldHomepage, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldDescription, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContainsFold(FieldName, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// SecretHashEQ applies the EQ predicate on the "secret_hash" field.
+func SecretHashEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldSecretHash, v))
+}
+
+// SecretHashNEQ applies the NEQ predicate on the "secret_hash" field.
+func SecretHashNEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldSecretHash, v))
+}
+
+// SecretHashIn applies the In predicate on the "secret_hash" field.
+func SecretHashIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldSecretHash, vs...))
+}
+
+// SecretHashNotIn applies the NotIn predicate on the "secret_hash" field.
+func SecretHashNotIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldSecretHash, vs...))
+}
+
+// SecretHashGT applies the GT predicate on the "secret_hash" field.
+func SecretHashGT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldSecretHash, v))
+}
+
+// SecretHashGTE applies the GTE predicate on the "secret_hash" field.
+func SecretHashGTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldSecretHash, v))
+}
+
+// SecretHashLT applies the LT predicate on the "secret_hash" field.
+func SecretHashLT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldSecretHash, v))
+}
+
+// SecretHashLTE applies the LTE predicate on the "secret_hash" field.
+func SecretHashLTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldSecretHash, v))
+}
+
+// SecretHashContains applies the Contains predicate on the "secret_hash" field.
+func SecretHashContains(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContains(FieldSecretHash, v))
+}
+
+// SecretHashHasPrefix applies the HasPrefix predicate on the "secret_hash" field.
+func SecretHashHasPrefix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasPrefix(FieldSecretHash, v))
+}
+
+// SecretHashHasSuffix applies the HasSuffix predicate on the "secret_hash" field.
+func SecretHashHasSuffix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasSuffix(FieldSecretHash, v))
+}
+
+// SecretHashIsNil applies the IsNil predicate on the "secret_hash" field.
+func SecretHashIsNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIsNull(FieldSecretHash))
+}
+
+// SecretHashNotNil applies the NotNil predicate on the "secret_hash" field.
+func SecretHashNotNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotNull(FieldSecretHash))
+}
+
+// SecretHashEqualFold applies the EqualFold predicate on the "secret_hash" field.
+func SecretHashEqualFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEqualFold(FieldSecretHash, v))
+}
+
+// SecretHashContainsFold applies the ContainsFold predicate on the "secret_hash" field.
+func SecretHashContainsFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContainsFold(FieldSecretHash, v))
+}
+
+// HomepageEQ applies the EQ predicate on the "homepage" field.
+func HomepageEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldHomepage, v))
+}
+
+// HomepageNEQ applies the NEQ predicate on the "homepage" field.
+func HomepageNEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldHomepage, v))
+}
+
+// HomepageIn applies the In predicate on the "homepage" field.
+func HomepageIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldHomepage, vs...))
+}
+
+// HomepageNotIn applies the NotIn predicate on the "homepage" field.
+func HomepageNotIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldHomepage, vs...))
+}
+
+// HomepageGT applies the GT predicate on the "homepage" field.
+func HomepageGT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldHomepage, v))
+}
+
+// HomepageGTE applies the GTE predicate on the "homepage" field.
+func HomepageGTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldHomepage, v))
+}
+
+// HomepageLT applies the LT predicate on the "homepage" field.
+func HomepageLT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldHomepage, v))
+}
+
+// HomepageLTE applies the LTE predicate on the "homepage" field.
+func HomepageLTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldHomepage, v))
+}
+
+// HomepageContains applies the Contains predicate on the "homepage" field.
+func HomepageContains(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContains(FieldHomepage, v))
+}
+
+// HomepageHasPrefix applies the HasPrefix predicate on the "homepage" field.
+func HomepageHasPrefix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasPrefix(FieldHomepage, v))
+}
+
+// HomepageHasSuffix applies the HasSuffix predicate on the "homepage" field.
+func HomepageHasSuffix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasSuffix(FieldHomepage, v))
+}
+
+// HomepageIsNil applies the IsNil predicate on the "homepage" field.
+func HomepageIsNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIsNull(FieldHomepage))
+}
+
+// HomepageNotNil applies the NotNil predicate on the "homepage" field.
+func HomepageNotNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotNull(FieldHomepage))
+}
+
+// HomepageEqualFold applies the EqualFold predicate on the "homepage" field.
+func HomepageEqualFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEqualFold(FieldHomepage, v))
+}
+
+// HomepageContainsFold applies the ContainsFold predicate on the "homepage" field.
+func HomepageContainsFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContainsFold(FieldHomepage, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// HasGrants applies the HasEdge predicate on the "grants" edge.
+func HasGrants() predicate.OAuthApp {
+	return predicate.OAuthApp(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGrantsWith applies the HasEdge predicate on the "grants" edge with a given conditions (other predicates).
+func HasGrantsWith(preds ...predicate.OAuthGrant) predicate.OAuthApp {
+	return predicate.OAuthApp(func(s *sql.Selector) {
+		step := newGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthApp) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthApp) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthApp) predicate.OAuthApp {
+	return predicate.OAuthApp(sql.NotPredicates(p))
+}
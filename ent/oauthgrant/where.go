@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthgrant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAppID, v))
+}
+
+// AccessTokenHash applies equality check predicate on the "access_token_hash" field. It's identical to AccessTokenHashEQ.
+func AccessTokenHash(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAccessTokenHash, v))
+}
+
+// AccessExpiresAt applies equality check predicate on the "access_expires_at" field. It's identical to AccessExpiresAtEQ.
+func AccessExpiresAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAccessExpiresAt, v))
+}
+
+// RefreshTokenHash applies equality check predicate on the "refresh_token_hash" field. It's identical to RefreshTokenHashEQ.
+func RefreshTokenHash(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshExpiresAt applies equality check predicate on the "refresh_expires_at" field. It's identical to RefreshExpiresAtEQ.
+func RefreshExpiresAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldRefreshExpiresAt, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...int) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AccessTokenHashEQ applies the EQ predicate on the "access_token_hash" field.
+func AccessTokenHashEQ(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashNEQ applies the NEQ predicate on the "access_token_hash" field.
+func AccessTokenHashNEQ(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashIn applies the In predicate on the "access_token_hash" field.
+func AccessTokenHashIn(vs ...string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldAccessTokenHash, vs...))
+}
+
+// AccessTokenHashNotIn applies the NotIn predicate on the "access_token_hash" field.
+func AccessTokenHashNotIn(vs ...string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldAccessTokenHash, vs...))
+}
+
+// AccessTokenHashGT applies the GT predicate on the "access_token_hash" field.
+func AccessTokenHashGT(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashGTE applies the GTE predicate on the "access_token_hash" field.
+func AccessTokenHashGTE(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashLT applies the LT predicate on the "access_token_hash" field.
+func AccessTokenHashLT(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashLTE applies the LTE predicate on the "access_token_hash" field.
+func AccessTokenHashLTE(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashContains applies the Contains predicate on the "access_token_hash" field.
+func AccessTokenHashContains(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldContains(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashHasPrefix applies the HasPrefix predicate on the "access_token_hash" field.
+func AccessTokenHashHasPrefix(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldHasPrefix(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashHasSuffix applies the HasSuffix predicate on the "access_token_hash" field.
+func AccessTokenHashHasSuffix(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldHasSuffix(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashEqualFold applies the EqualFold predicate on the "access_token_hash" field.
+func AccessTokenHashEqualFold(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEqualFold(FieldAccessTokenHash, v))
+}
+
+// AccessTokenHashContainsFold applies the ContainsFold predicate on the "access_token_hash" field.
+func AccessTokenHashContainsFold(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldContainsFold(FieldAccessTokenHash, v))
+}
+
+// AccessExpiresAtEQ applies the EQ predicate on the "access_expires_at" field.
+func AccessExpiresAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldAccessExpiresAt, v))
+}
+
+// AccessExpiresAtNEQ applies the NEQ predicate on the "access_expires_at" field.
+func AccessExpiresAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldAccessExpiresAt, v))
+}
+
+// AccessExpiresAtIn applies the In predicate on the "access_expires_at" field.
+func AccessExpiresAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldAccessExpiresAt, vs...))
+}
+
+// AccessExpiresAtNotIn applies the NotIn predicate on the "access_expires_at" field.
+func AccessExpiresAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldAccessExpiresAt, vs...))
+}
+
+// AccessExpiresAtGT applies the GT predicate on the "access_expires_at" field.
+func AccessExpiresAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldAccessExpiresAt, v))
+}
+
+// AccessExpiresAtGTE applies the GTE predicate on the "access_expires_at" field.
+func AccessExpiresAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldAccessExpiresAt, v))
+}
+
+// AccessExpiresAtLT applies the LT predicate on the "access_expires_at" field.
+func AccessExpiresAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldAccessExpiresAt, v))
+}
+
+// AccessExpiresAtLTE applies the LTE predicate on the "access_expires_at" field.
+func AccessExpiresAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldAccessExpiresAt, v))
+}
+
+// RefreshTokenHashEQ applies the EQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEQ(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashNEQ applies the NEQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNEQ(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashIn applies the In predicate on the "refresh_token_hash" field.
+func RefreshTokenHashIn(vs ...string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashNotIn applies the NotIn predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNotIn(vs ...string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashGT applies the GT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGT(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashGTE applies the GTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGTE(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLT applies the LT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLT(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLTE applies the LTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLTE(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContains applies the Contains predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContains(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldContains(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasPrefix applies the HasPrefix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasPrefix(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldHasPrefix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasSuffix applies the HasSuffix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasSuffix(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldHasSuffix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashEqualFold applies the EqualFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEqualFold(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEqualFold(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContainsFold applies the ContainsFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContainsFold(v string) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldContainsFold(FieldRefreshTokenHash, v))
+}
+
+// RefreshExpiresAtEQ applies the EQ predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldRefreshExpiresAt, v))
+}
+
+// RefreshExpiresAtNEQ applies the NEQ predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldRefreshExpiresAt, v))
+}
+
+// RefreshExpiresAtIn applies the In predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldRefreshExpiresAt, vs...))
+}
+
+// RefreshExpiresAtNotIn applies the NotIn predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldRefreshExpiresAt, vs...))
+}
+
+// RefreshExpiresAtGT applies the GT predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldRefreshExpiresAt, v))
+}
+
+// RefreshExpiresAtGTE applies the GTE predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldRefreshExpiresAt, v))
+}
+
+// RefreshExpiresAtLT applies the LT predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldRefreshExpiresAt, v))
+}
+
+// RefreshExpiresAtLTE applies the LTE predicate on the "refresh_expires_at" field.
+func RefreshExpiresAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldRefreshExpiresAt, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.OAuthGrant {
+	return predicate.OAuthGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.OAuthGrant {
+	return predicate.OAuthGrant(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApp applies the HasEdge predicate on the "app" edge.
+func HasApp() predicate.OAuthGrant {
+	return predicate.OAuthGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppTable, AppColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppWith applies the HasEdge predicate on the "app" edge with a given conditions (other predicates).
+func HasAppWith(preds ...predicate.OAuthApp) predicate.OAuthGrant {
+	return predicate.OAuthGrant(func(s *sql.Selector) {
+		step := newAppStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthGrant) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthGrant) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthGrant) predicate.OAuthGrant {
+	return predicate.OAuthGrant(sql.NotPredicates(p))
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// PersonalAccessToken is the model entity for the PersonalAccessToken schema.
+type PersonalAccessToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// TokenHint holds the value of the "token_hint" field.
+	TokenHint string `json:"token_hint,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PersonalAccessTokenQuery when eager-loading is set.
+	Edges        PersonalAccessTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PersonalAccessTokenEdges holds the relations/edges for other nodes in the graph.
+type PersonalAccessTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PersonalAccessTokenEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PersonalAccessToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldScopes:
+			values[i] = new([]byte)
+		case personalaccesstoken.FieldID, personalaccesstoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case personalaccesstoken.FieldName, personalaccesstoken.FieldTokenHash, personalaccesstoken.FieldTokenHint, personalaccesstoken.FieldLastUsedIP:
+			values[i] = new(sql.NullString)
+		case personalaccesstoken.FieldCreatedAt, personalaccesstoken.FieldUpdatedAt, personalaccesstoken.FieldDeletedAt, personalaccesstoken.FieldExpiresAt, personalaccesstoken.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PersonalAccessToken fields.
+func (pat *PersonalAccessToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pat.ID = int(value.Int64)
+		case personalaccesstoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pat.CreatedAt = value.Time
+			}
+		case personalaccesstoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pat.UpdatedAt = value.Time
+			}
+		case personalaccesstoken.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pat.DeletedAt = new(time.Time)
+				*pat.DeletedAt = value.Time
+			}
+		case personalaccesstoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pat.UserID = int(value.Int64)
+			}
+		case personalaccesstoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pat.Name = value.String
+			}
+		case personalaccesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				pat.TokenHash = value.String
+			}
+		case personalaccesstoken.FieldTokenHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hint", values[i])
+			} else if value.Valid {
+				pat.TokenHint = value.String
+			}
+		case personalaccesstoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pat.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case personalaccesstoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pat.ExpiresAt = new(time.Time)
+				*pat.ExpiresAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				pat.LastUsedAt = new(time.Time)
+				*pat.LastUsedAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				pat.LastUsedIP = value.String
+			}
+		default:
+			pat.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PersonalAccessToken.
+// This includes values selected through modifiers, order, etc.
+func (pat *PersonalAccessToken) Value(name string) (ent.Value, error) {
+	return pat.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PersonalAccessToken entity.
+func (pat *PersonalAccessToken) QueryUser() *UserQuery {
+	return NewPersonalAccessTokenClient(pat.config).QueryUser(pat)
+}
+
+// Update returns a builder for updating this PersonalAccessToken.
+// Note that you need to call PersonalAccessToken.Unwrap() before calling this method if this PersonalAccessToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pat *PersonalAccessToken) Update() *PersonalAccessTokenUpdateOne {
+	return NewPersonalAccessTokenClient(pat.config).UpdateOne(pat)
+}
+
+// Unwrap unwraps the PersonalAccessToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pat *PersonalAccessToken) Unwrap() *PersonalAccessToken {
+	_tx, ok := pat.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PersonalAccessToken is not a transactional entity")
+	}
+	pat.config.driver = _tx.drv
+	return pat
+}
+
+// String implements the fmt.Stringer.
+func (pat *PersonalAccessToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("PersonalAccessToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pat.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pat.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pat.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pat.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pat.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pat.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_hint=")
+	builder.WriteString(pat.TokenHint)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", pat.Scopes))
+	builder.WriteString(", ")
+	if v := pat.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pat.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(pat.LastUsedIP)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *PersonalAccessToken) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// PersonalAccessTokens is a parsable slice of PersonalAccessToken.
+type PersonalAccessTokens []*PersonalAccessToken
@@ -5,14 +5,25 @@ package runtime
 import (
 	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/institution"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/major"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthapp"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
@@ -26,6 +37,89 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	assignmentMixin := schema.Assignment{}.Mixin()
+	assignmentMixinHooks0 := assignmentMixin[0].Hooks()
+	assignment.Hooks[0] = assignmentMixinHooks0[0]
+	assignmentMixinInters0 := assignmentMixin[0].Interceptors()
+	assignment.Interceptors[0] = assignmentMixinInters0[0]
+	assignmentMixinFields0 := assignmentMixin[0].Fields()
+	_ = assignmentMixinFields0
+	assignmentFields := schema.Assignment{}.Fields()
+	_ = assignmentFields
+	// assignmentDescCreatedAt is the schema descriptor for created_at field.
+	assignmentDescCreatedAt := assignmentMixinFields0[0].Descriptor()
+	// assignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	assignment.DefaultCreatedAt = assignmentDescCreatedAt.Default.(func() time.Time)
+	// assignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	assignmentDescUpdatedAt := assignmentMixinFields0[1].Descriptor()
+	// assignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	assignment.DefaultUpdatedAt = assignmentDescUpdatedAt.Default.(func() time.Time)
+	// assignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	assignment.UpdateDefaultUpdatedAt = assignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// assignmentDescName is the schema descriptor for name field.
+	assignmentDescName := assignmentFields[0].Descriptor()
+	// assignment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	assignment.NameValidator = assignmentDescName.Validators[0].(func(string) error)
+	// assignmentDescSettings is the schema descriptor for settings field.
+	assignmentDescSettings := assignmentFields[6].Descriptor()
+	// assignment.DefaultSettings holds the default value on creation for the settings field.
+	assignment.DefaultSettings = assignmentDescSettings.Default.(*types.AssignmentSetting)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	courseMixin := schema.Course{}.Mixin()
+	courseMixinHooks0 := courseMixin[0].Hooks()
+	course.Hooks[0] = courseMixinHooks0[0]
+	courseMixinInters0 := courseMixin[0].Interceptors()
+	course.Interceptors[0] = courseMixinInters0[0]
+	courseMixinFields0 := courseMixin[0].Fields()
+	_ = courseMixinFields0
+	courseFields := schema.Course{}.Fields()
+	_ = courseFields
+	// courseDescCreatedAt is the schema descriptor for created_at field.
+	courseDescCreatedAt := courseMixinFields0[0].Descriptor()
+	// course.DefaultCreatedAt holds the default value on creation for the created_at field.
+	course.DefaultCreatedAt = courseDescCreatedAt.Default.(func() time.Time)
+	// courseDescUpdatedAt is the schema descriptor for updated_at field.
+	courseDescUpdatedAt := courseMixinFields0[1].Descriptor()
+	// course.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	course.DefaultUpdatedAt = courseDescUpdatedAt.Default.(func() time.Time)
+	// course.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	course.UpdateDefaultUpdatedAt = courseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// courseDescName is the schema descriptor for name field.
+	courseDescName := courseFields[0].Descriptor()
+	// course.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	course.NameValidator = courseDescName.Validators[0].(func(string) error)
+	// courseDescCode is the schema descriptor for code field.
+	courseDescCode := courseFields[1].Descriptor()
+	// course.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	course.CodeValidator = courseDescCode.Validators[0].(func(string) error)
+	// courseDescMaxStorage is the schema descriptor for max_storage field.
+	courseDescMaxStorage := courseFields[3].Descriptor()
+	// course.DefaultMaxStorage holds the default value on creation for the max_storage field.
+	course.DefaultMaxStorage = courseDescMaxStorage.Default.(int64)
+	coursememberMixin := schema.CourseMember{}.Mixin()
+	coursememberMixinHooks0 := coursememberMixin[0].Hooks()
+	coursemember.Hooks[0] = coursememberMixinHooks0[0]
+	coursememberMixinInters0 := coursememberMixin[0].Interceptors()
+	coursemember.Interceptors[0] = coursememberMixinInters0[0]
+	coursememberMixinFields0 := coursememberMixin[0].Fields()
+	_ = coursememberMixinFields0
+	coursememberFields := schema.CourseMember{}.Fields()
+	_ = coursememberFields
+	// coursememberDescCreatedAt is the schema descriptor for created_at field.
+	coursememberDescCreatedAt := coursememberMixinFields0[0].Descriptor()
+	// coursemember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coursemember.DefaultCreatedAt = coursememberDescCreatedAt.Default.(func() time.Time)
+	// coursememberDescUpdatedAt is the schema descriptor for updated_at field.
+	coursememberDescUpdatedAt := coursememberMixinFields0[1].Descriptor()
+	// coursemember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coursemember.DefaultUpdatedAt = coursememberDescUpdatedAt.Default.(func() time.Time)
+	// coursemember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coursemember.UpdateDefaultUpdatedAt = coursememberDescUpdatedAt.UpdateDefault.(func() time.Time)
 	davaccountMixin := schema.DavAccount{}.Mixin()
 	davaccountMixinHooks0 := davaccountMixin[0].Hooks()
 	davaccount.Hooks[0] = davaccountMixinHooks0[0]
@@ -87,6 +181,25 @@ func init() {
 	entityDescReferenceCount := entityFields[3].Descriptor()
 	// entity.DefaultReferenceCount holds the default value on creation for the reference_count field.
 	entity.DefaultReferenceCount = entityDescReferenceCount.Default.(int)
+	externalidentityMixin := schema.ExternalIdentity{}.Mixin()
+	externalidentityMixinHooks0 := externalidentityMixin[0].Hooks()
+	externalidentity.Hooks[0] = externalidentityMixinHooks0[0]
+	externalidentityMixinInters0 := externalidentityMixin[0].Interceptors()
+	externalidentity.Interceptors[0] = externalidentityMixinInters0[0]
+	externalidentityMixinFields0 := externalidentityMixin[0].Fields()
+	_ = externalidentityMixinFields0
+	externalidentityFields := schema.ExternalIdentity{}.Fields()
+	_ = externalidentityFields
+	// externalidentityDescCreatedAt is the schema descriptor for created_at field.
+	externalidentityDescCreatedAt := externalidentityMixinFields0[0].Descriptor()
+	// externalidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	externalidentity.DefaultCreatedAt = externalidentityDescCreatedAt.Default.(func() time.Time)
+	// externalidentityDescUpdatedAt is the schema descriptor for updated_at field.
+	externalidentityDescUpdatedAt := externalidentityMixinFields0[1].Descriptor()
+	// externalidentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	externalidentity.DefaultUpdatedAt = externalidentityDescUpdatedAt.Default.(func() time.Time)
+	// externalidentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	externalidentity.UpdateDefaultUpdatedAt = externalidentityDescUpdatedAt.UpdateDefault.(func() time.Time)
 	fileHooks := schema.File{}.Hooks()
 	file.Hooks[0] = fileHooks[0]
 	fileFields := schema.File{}.Fields()
@@ -130,6 +243,83 @@ func init() {
 	groupDescSettings := groupFields[4].Descriptor()
 	// group.DefaultSettings holds the default value on creation for the settings field.
 	group.DefaultSettings = groupDescSettings.Default.(*types.GroupSetting)
+	institutionMixin := schema.Institution{}.Mixin()
+	institutionMixinHooks0 := institutionMixin[0].Hooks()
+	institution.Hooks[0] = institutionMixinHooks0[0]
+	institutionMixinInters0 := institutionMixin[0].Interceptors()
+	institution.Interceptors[0] = institutionMixinInters0[0]
+	institutionMixinFields0 := institutionMixin[0].Fields()
+	_ = institutionMixinFields0
+	institutionFields := schema.Institution{}.Fields()
+	_ = institutionFields
+	// institutionDescCreatedAt is the schema descriptor for created_at field.
+	institutionDescCreatedAt := institutionMixinFields0[0].Descriptor()
+	// institution.DefaultCreatedAt holds the default value on creation for the created_at field.
+	institution.DefaultCreatedAt = institutionDescCreatedAt.Default.(func() time.Time)
+	// institutionDescUpdatedAt is the schema descriptor for updated_at field.
+	institutionDescUpdatedAt := institutionMixinFields0[1].Descriptor()
+	// institution.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	institution.DefaultUpdatedAt = institutionDescUpdatedAt.Default.(func() time.Time)
+	// institution.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	institution.UpdateDefaultUpdatedAt = institutionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// institutionDescName is the schema descriptor for name field.
+	institutionDescName := institutionFields[0].Descriptor()
+	// institution.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	institution.NameValidator = institutionDescName.Validators[0].(func(string) error)
+	// institutionDescExtraStorage is the schema descriptor for extra_storage field.
+	institutionDescExtraStorage := institutionFields[3].Descriptor()
+	// institution.DefaultExtraStorage holds the default value on creation for the extra_storage field.
+	institution.DefaultExtraStorage = institutionDescExtraStorage.Default.(int64)
+	loginsessionMixin := schema.LoginSession{}.Mixin()
+	loginsessionMixinHooks0 := loginsessionMixin[0].Hooks()
+	loginsession.Hooks[0] = loginsessionMixinHooks0[0]
+	loginsessionMixinInters0 := loginsessionMixin[0].Interceptors()
+	loginsession.Interceptors[0] = loginsessionMixinInters0[0]
+	loginsessionMixinFields0 := loginsessionMixin[0].Fields()
+	_ = loginsessionMixinFields0
+	loginsessionFields := schema.LoginSession{}.Fields()
+	_ = loginsessionFields
+	// loginsessionDescCreatedAt is the schema descriptor for created_at field.
+	loginsessionDescCreatedAt := loginsessionMixinFields0[0].Descriptor()
+	// loginsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginsession.DefaultCreatedAt = loginsessionDescCreatedAt.Default.(func() time.Time)
+	// loginsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	loginsessionDescUpdatedAt := loginsessionMixinFields0[1].Descriptor()
+	// loginsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginsession.DefaultUpdatedAt = loginsessionDescUpdatedAt.Default.(func() time.Time)
+	// loginsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginsession.UpdateDefaultUpdatedAt = loginsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginsessionDescRevoked is the schema descriptor for revoked field.
+	loginsessionDescRevoked := loginsessionFields[6].Descriptor()
+	// loginsession.DefaultRevoked holds the default value on creation for the revoked field.
+	loginsession.DefaultRevoked = loginsessionDescRevoked.Default.(bool)
+	majorMixin := schema.Major{}.Mixin()
+	majorMixinHooks0 := majorMixin[0].Hooks()
+	major.Hooks[0] = majorMixinHooks0[0]
+	majorMixinInters0 := majorMixin[0].Interceptors()
+	major.Interceptors[0] = majorMixinInters0[0]
+	majorMixinFields0 := majorMixin[0].Fields()
+	_ = majorMixinFields0
+	majorFields := schema.Major{}.Fields()
+	_ = majorFields
+	// majorDescCreatedAt is the schema descriptor for created_at field.
+	majorDescCreatedAt := majorMixinFields0[0].Descriptor()
+	// major.DefaultCreatedAt holds the default value on creation for the created_at field.
+	major.DefaultCreatedAt = majorDescCreatedAt.Default.(func() time.Time)
+	// majorDescUpdatedAt is the schema descriptor for updated_at field.
+	majorDescUpdatedAt := majorMixinFields0[1].Descriptor()
+	// major.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	major.DefaultUpdatedAt = majorDescUpdatedAt.Default.(func() time.Time)
+	// major.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	major.UpdateDefaultUpdatedAt = majorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// majorDescName is the schema descriptor for name field.
+	majorDescName := majorFields[0].Descriptor()
+	// major.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	major.NameValidator = majorDescName.Validators[0].(func(string) error)
+	// majorDescExtraStorage is the schema descriptor for extra_storage field.
+	majorDescExtraStorage := majorFields[4].Descriptor()
+	// major.DefaultExtraStorage holds the default value on creation for the extra_storage field.
+	major.DefaultExtraStorage = majorDescExtraStorage.Default.(int64)
 	metadataMixin := schema.Metadata{}.Mixin()
 	metadataMixinHooks0 := metadataMixin[0].Hooks()
 	metadata.Hooks[0] = metadataMixinHooks0[0]
@@ -180,6 +370,48 @@ func init() {
 	nodeDescWeight := nodeFields[7].Descriptor()
 	// node.DefaultWeight holds the default value on creation for the weight field.
 	node.DefaultWeight = nodeDescWeight.Default.(int)
+	oauthappMixin := schema.OAuthApp{}.Mixin()
+	oauthappMixinHooks0 := oauthappMixin[0].Hooks()
+	oauthapp.Hooks[0] = oauthappMixinHooks0[0]
+	oauthappMixinInters0 := oauthappMixin[0].Interceptors()
+	oauthapp.Interceptors[0] = oauthappMixinInters0[0]
+	oauthappMixinFields0 := oauthappMixin[0].Fields()
+	_ = oauthappMixinFields0
+	oauthappFields := schema.OAuthApp{}.Fields()
+	_ = oauthappFields
+	// oauthappDescCreatedAt is the schema descriptor for created_at field.
+	oauthappDescCreatedAt := oauthappMixinFields0[0].Descriptor()
+	// oauthapp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthapp.DefaultCreatedAt = oauthappDescCreatedAt.Default.(func() time.Time)
+	// oauthappDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthappDescUpdatedAt := oauthappMixinFields0[1].Descriptor()
+	// oauthapp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthapp.DefaultUpdatedAt = oauthappDescUpdatedAt.Default.(func() time.Time)
+	// oauthapp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthapp.UpdateDefaultUpdatedAt = oauthappDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthappDescEnabled is the schema descriptor for enabled field.
+	oauthappDescEnabled := oauthappFields[7].Descriptor()
+	// oauthapp.DefaultEnabled holds the default value on creation for the enabled field.
+	oauthapp.DefaultEnabled = oauthappDescEnabled.Default.(bool)
+	oauthgrantMixin := schema.OAuthGrant{}.Mixin()
+	oauthgrantMixinHooks0 := oauthgrantMixin[0].Hooks()
+	oauthgrant.Hooks[0] = oauthgrantMixinHooks0[0]
+	oauthgrantMixinInters0 := oauthgrantMixin[0].Interceptors()
+	oauthgrant.Interceptors[0] = oauthgrantMixinInters0[0]
+	oauthgrantMixinFields0 := oauthgrantMixin[0].Fields()
+	_ = oauthgrantMixinFields0
+	oauthgrantFields := schema.OAuthGrant{}.Fields()
+	_ = oauthgrantFields
+	// oauthgrantDescCreatedAt is the schema descriptor for created_at field.
+	oauthgrantDescCreatedAt := oauthgrantMixinFields0[0].Descriptor()
+	// oauthgrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthgrant.DefaultCreatedAt = oauthgrantDescCreatedAt.Default.(func() time.Time)
+	// oauthgrantDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthgrantDescUpdatedAt := oauthgrantMixinFields0[1].Descriptor()
+	// oauthgrant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthgrant.DefaultUpdatedAt = oauthgrantDescUpdatedAt.Default.(func() time.Time)
+	// oauthgrant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthgrant.UpdateDefaultUpdatedAt = oauthgrantDescUpdatedAt.UpdateDefault.(func() time.Time)
 	passkeyMixin := schema.Passkey{}.Mixin()
 	passkeyMixinHooks0 := passkeyMixin[0].Hooks()
 	passkey.Hooks[0] = passkeyMixinHooks0[0]
@@ -199,6 +431,25 @@ func init() {
 	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
 	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstokenMixinHooks0 := personalaccesstokenMixin[0].Hooks()
+	personalaccesstoken.Hooks[0] = personalaccesstokenMixinHooks0[0]
+	personalaccesstokenMixinInters0 := personalaccesstokenMixin[0].Interceptors()
+	personalaccesstoken.Interceptors[0] = personalaccesstokenMixinInters0[0]
+	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
+	_ = personalaccesstokenMixinFields0
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenMixinFields0[0].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	personalaccesstokenDescUpdatedAt := personalaccesstokenMixinFields0[1].Descriptor()
+	// personalaccesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	personalaccesstoken.DefaultUpdatedAt = personalaccesstokenDescUpdatedAt.Default.(func() time.Time)
+	// personalaccesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	personalaccesstoken.UpdateDefaultUpdatedAt = personalaccesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
@@ -310,21 +561,40 @@ func init() {
 	userDescEmail := userFields[0].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[1].Descriptor()
+	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
 	// userDescNick is the schema descriptor for nick field.
-	userDescNick := userFields[1].Descriptor()
+	userDescNick := userFields[2].Descriptor()
 	// user.NickValidator is a validator for the "nick" field. It is called by the builders before save.
 	user.NickValidator = userDescNick.Validators[0].(func(string) error)
+	// userDescUniversity is the schema descriptor for university field.
+	userDescUniversity := userFields[6].Descriptor()
+	// user.UniversityValidator is a validator for the "university" field. It is called by the builders before save.
+	user.UniversityValidator = userDescUniversity.Validators[0].(func(string) error)
+	// userDescMajor is the schema descriptor for major field.
+	userDescMajor := userFields[7].Descriptor()
+	// user.MajorValidator is a validator for the "major" field. It is called by the builders before save.
+	user.MajorValidator = userDescMajor.Validators[0].(func(string) error)
+	// userDescStudentID is the schema descriptor for student_id field.
+	userDescStudentID := userFields[8].Descriptor()
+	// user.StudentIDValidator is a validator for the "student_id" field. It is called by the builders before save.
+	user.StudentIDValidator = userDescStudentID.Validators[0].(func(string) error)
 	// userDescStorage is the schema descriptor for storage field.
-	userDescStorage := userFields[4].Descriptor()
+	userDescStorage := userFields[10].Descriptor()
 	// user.DefaultStorage holds the default value on creation for the storage field.
 	user.DefaultStorage = userDescStorage.Default.(int64)
+	// userDescExtraStorage is the schema descriptor for extra_storage field.
+	userDescExtraStorage := userFields[11].Descriptor()
+	// user.DefaultExtraStorage holds the default value on creation for the extra_storage field.
+	user.DefaultExtraStorage = userDescExtraStorage.Default.(int64)
 	// userDescSettings is the schema descriptor for settings field.
-	userDescSettings := userFields[7].Descriptor()
+	userDescSettings := userFields[14].Descriptor()
 	// user.DefaultSettings holds the default value on creation for the settings field.
 	user.DefaultSettings = userDescSettings.Default.(*types.UserSetting)
 }
 
 const (
-	Version = "v0.13.0"                                         // Version of ent codegen.
-	Sum     = "h1:DclxWczaCpyiKn6ZWVcJjq1zIKtJ11iNKy+08lNYsJE=" // Sum of ent codegen.
+	Version = "v0.13.0" // Version of ent codegen.
 )
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Assignment holds the schema definition for the Assignment entity.
+// 作业提交箱：课程空间中的一个目录，每位学生只能在截止时间前向自己的子目录提交文件。
+type Assignment struct {
+	ent.Schema
+}
+
+// Fields of the Assignment.
+func (Assignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(200),
+		field.Int("course_id"),
+		field.Int("folder_id").
+			Unique(),
+		field.Time("deadline").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Enum("late_policy").
+			Values("reject", "allow", "until").
+			Default("reject"),
+		field.Time("late_deadline").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.JSON("settings", &types.AssignmentSetting{}).
+			Default(&types.AssignmentSetting{}).
+			Optional(),
+	}
+}
+
+// Edges of the Assignment.
+func (Assignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("assignments").
+			Field("course_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Assignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
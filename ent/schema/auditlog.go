@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// 审计日志只追加不修改，因此不使用软删除
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.String("action").
+			Immutable(),
+		// 执行操作的用户，匿名请求（如登录失败）时为空
+		field.Int("user_id").
+			Optional().
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Immutable(),
+		field.Text("user_agent").
+			Optional().
+			Immutable(),
+		// 操作对象，如文件 URI、分享 ID、设置项名称
+		field.Text("target").
+			Optional().
+			Immutable(),
+		field.JSON("props", map[string]string{}).
+			Optional().
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("action", "created_at"),
+	}
+}
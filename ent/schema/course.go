@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Course holds the schema definition for the Course entity.
+// 课程空间：文件归属于课程的系统账号（account），容量按课程配额计算。
+type Course struct {
+	ent.Schema
+}
+
+// Fields of the Course.
+func (Course) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(200),
+		field.String("code").
+			MaxLen(100).
+			Unique(),
+		field.Text("description").
+			Optional(),
+		field.Int64("max_storage").
+			Default(0),
+		field.Int("account_id").
+			Unique(),
+	}
+}
+
+// Edges of the Course.
+func (Course) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", User.Type).
+			Ref("course").
+			Field("account_id").
+			Unique().
+			Required(),
+		edge.To("members", CourseMember.Type),
+		edge.To("assignments", Assignment.Type),
+	}
+}
+
+func (Course) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CourseMember holds the schema definition for the CourseMember entity.
+type CourseMember struct {
+	ent.Schema
+}
+
+// Fields of the CourseMember.
+func (CourseMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("course_id"),
+		field.Int("user_id"),
+		field.Enum("role").
+			Values("teacher", "ta", "student").
+			Default("student"),
+	}
+}
+
+// Edges of the CourseMember.
+func (CourseMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("members").
+			Field("course_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("course_memberships").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CourseMember.
+func (CourseMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("course_id", "user_id").
+			Unique(),
+	}
+}
+
+func (CourseMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExternalIdentity holds the schema definition for the ExternalIdentity entity.
+type ExternalIdentity struct {
+	ent.Schema
+}
+
+// Fields of the ExternalIdentity.
+func (ExternalIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// 设置中的身份提供方 ID
+		field.String("provider"),
+		// 身份提供方中的用户唯一标识
+		field.String("subject"),
+	}
+}
+
+// Edges of the ExternalIdentity.
+func (ExternalIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("external_identities").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ExternalIdentity.
+func (ExternalIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").
+			Unique(),
+		index.Fields("user_id", "provider").
+			Unique(),
+	}
+}
+
+func (ExternalIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
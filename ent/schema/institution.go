@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Institution holds the schema definition for the Institution entity.
+// 院校目录：用户的院校字段保存规范名称，别名用于匹配用户输入。
+type Institution struct {
+	ent.Schema
+}
+
+// Fields of the Institution.
+func (Institution) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(200).
+			Unique(),
+		field.Strings("aliases").
+			Optional(),
+		// 该院校用户默认所属用户组，0 表示不映射
+		field.Int("group_id").
+			Optional(),
+		// 该院校用户在用户组容量之外额外获得的容量
+		field.Int64("extra_storage").
+			Default(0),
+	}
+}
+
+// Edges of the Institution.
+func (Institution) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("majors", Major.Type),
+	}
+}
+
+func (Institution) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
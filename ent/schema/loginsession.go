@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginSession holds the schema definition for the LoginSession entity.
+// 每个刷新令牌族（root token）对应一条登录会话
+type LoginSession struct {
+	ent.Schema
+}
+
+// Fields of the LoginSession.
+func (LoginSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("root_token_id").
+			Unique(),
+		field.String("ip").
+			Optional(),
+		field.Text("user_agent").
+			Optional(),
+		field.Time("last_used_at").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		// 刷新令牌的过期时间，每次刷新后顺延
+		field.Time("expires_at").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Bool("revoked").
+			Default(false),
+	}
+}
+
+// Edges of the LoginSession.
+func (LoginSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("login_sessions").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LoginSession.
+func (LoginSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "expires_at"),
+	}
+}
+
+func (LoginSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
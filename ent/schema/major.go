@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Major holds the schema definition for the Major entity.
+// 专业目录，归属于某个院校。
+type Major struct {
+	ent.Schema
+}
+
+// Fields of the Major.
+func (Major) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(200),
+		field.Strings("aliases").
+			Optional(),
+		field.Int("institution_id"),
+		// 专业映射优先于院校映射，0 表示沿用院校映射
+		field.Int("group_id").
+			Optional(),
+		field.Int64("extra_storage").
+			Default(0),
+	}
+}
+
+// Edges of the Major.
+func (Major) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("institution", Institution.Type).
+			Ref("majors").
+			Field("institution_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Major.
+func (Major) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("institution_id", "name").
+			Unique(),
+	}
+}
+
+func (Major) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
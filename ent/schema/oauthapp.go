@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthApp holds the schema definition for the OAuthApp entity.
+// 由管理员注册的第三方应用（OAuth2 客户端）
+type OAuthApp struct {
+	ent.Schema
+}
+
+// Fields of the OAuthApp.
+func (OAuthApp) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		// 对外公开的 client_id
+		field.String("client_id").
+			Unique(),
+		// client_secret 的 SHA-256 摘要，为空时为公开客户端，必须使用 PKCE
+		field.String("secret_hash").
+			Optional().
+			Sensitive(),
+		field.JSON("redirect_uris", []string{}),
+		// 应用可申请的权限范围
+		field.JSON("scopes", []string{}),
+		field.String("homepage").
+			Optional(),
+		field.Text("description").
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+// Edges of the OAuthApp.
+func (OAuthApp) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("grants", OAuthGrant.Type),
+	}
+}
+
+func (OAuthApp) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
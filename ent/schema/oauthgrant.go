@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthGrant holds the schema definition for the OAuthGrant entity.
+// 用户授权第三方应用后签发的一组访问令牌与刷新令牌
+type OAuthGrant struct {
+	ent.Schema
+}
+
+// Fields of the OAuthGrant.
+func (OAuthGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("app_id"),
+		field.JSON("scopes", []string{}),
+		field.String("access_token_hash").
+			Unique().
+			Sensitive(),
+		field.Time("access_expires_at").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.String("refresh_token_hash").
+			Unique().
+			Sensitive(),
+		field.Time("refresh_expires_at").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the OAuthGrant.
+func (OAuthGrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_grants").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("app", OAuthApp.Type).
+			Ref("grants").
+			Field("app_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OAuthGrant.
+func (OAuthGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "app_id"),
+		index.Fields("refresh_expires_at"),
+	}
+}
+
+func (OAuthGrant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
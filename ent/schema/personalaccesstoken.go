@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		// 令牌的 SHA-256 摘要，明文仅在创建时返回一次
+		field.String("token_hash").
+			Unique().
+			Sensitive(),
+		// 令牌末尾几位，用于在列表中辨认
+		field.String("token_hint"),
+		field.JSON("scopes", []string{}),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.String("last_used_ip").
+			Optional(),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("personal_access_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PersonalAccessToken.
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (PersonalAccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
@@ -27,17 +28,30 @@ func (User) Fields() []ent.Field {
 		field.String("password").
 			Optional().
 			Sensitive(),
+		// 历史密码摘要，最近的在前，用于禁止重复使用近期密码
+		field.JSON("password_history", []string{}).
+			Optional().
+			Sensitive(),
+		field.Time("password_changed_at").
+			Optional().
+			Nillable(),
 		field.String("university").
 			MaxLen(200).
 			Optional(),
 		field.String("major").
 			MaxLen(200).
 			Optional(),
+		field.String("student_id").
+			MaxLen(64).
+			Optional(),
 		field.Enum("status").
 			Values("active", "inactive", "manual_banned", "sys_banned").
 			Default("active"),
 		field.Int64("storage").
 			Default(0),
+		// 由院校、专业映射授予的额外容量
+		field.Int64("extra_storage").
+			Default(0),
 		field.String("two_factor_secret").
 			Sensitive().
 			Optional(),
@@ -63,6 +77,21 @@ func (User) Edges() []ent.Edge {
 		edge.To("passkey", Passkey.Type),
 		edge.To("tasks", Task.Type),
 		edge.To("entities", Entity.Type),
+		edge.To("course", Course.Type).
+			Unique(),
+		edge.To("course_memberships", CourseMember.Type),
+		edge.To("external_identities", ExternalIdentity.Type),
+		edge.To("login_sessions", LoginSession.Type),
+		edge.To("personal_access_tokens", PersonalAccessToken.Type),
+		edge.To("oauth_grants", OAuthGrant.Type),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// 学号仅在同一院校内唯一
+		index.Fields("university", "student_id").
+			Unique(),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -28,14 +29,28 @@ type User struct {
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
 	// Nick holds the value of the "nick" field.
 	Nick string `json:"nick,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
+	// PasswordHistory holds the value of the "password_history" field.
+	PasswordHistory []string `json:"-"`
+	// PasswordChangedAt holds the value of the "password_changed_at" field.
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+	// University holds the value of the "university" field.
+	University string `json:"university,omitempty"`
+	// Major holds the value of the "major" field.
+	Major string `json:"major,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID string `json:"student_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status user.Status `json:"status,omitempty"`
 	// Storage holds the value of the "storage" field.
 	Storage int64 `json:"storage,omitempty"`
+	// ExtraStorage holds the value of the "extra_storage" field.
+	ExtraStorage int64 `json:"extra_storage,omitempty"`
 	// TwoFactorSecret holds the value of the "two_factor_secret" field.
 	TwoFactorSecret string `json:"-"`
 	// Avatar holds the value of the "avatar" field.
@@ -66,9 +81,21 @@ type UserEdges struct {
 	Tasks []*Task `json:"tasks,omitempty"`
 	// Entities holds the value of the entities edge.
 	Entities []*Entity `json:"entities,omitempty"`
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// CourseMemberships holds the value of the course_memberships edge.
+	CourseMemberships []*CourseMember `json:"course_memberships,omitempty"`
+	// ExternalIdentities holds the value of the external_identities edge.
+	ExternalIdentities []*ExternalIdentity `json:"external_identities,omitempty"`
+	// LoginSessions holds the value of the login_sessions edge.
+	LoginSessions []*LoginSession `json:"login_sessions,omitempty"`
+	// PersonalAccessTokens holds the value of the personal_access_tokens edge.
+	PersonalAccessTokens []*PersonalAccessToken `json:"personal_access_tokens,omitempty"`
+	// OauthGrants holds the value of the oauth_grants edge.
+	OauthGrants []*OAuthGrant `json:"oauth_grants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [13]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -138,18 +165,76 @@ func (e UserEdges) EntitiesOrErr() ([]*Entity, error) {
 	return nil, &NotLoadedError{edge: "entities"}
 }
 
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) CourseOrErr() (*Course, error) {
+	if e.loadedTypes[7] {
+		if e.Course == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: course.Label}
+		}
+		return e.Course, nil
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// CourseMembershipsOrErr returns the CourseMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CourseMembershipsOrErr() ([]*CourseMember, error) {
+	if e.loadedTypes[8] {
+		return e.CourseMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "course_memberships"}
+}
+
+// ExternalIdentitiesOrErr returns the ExternalIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ExternalIdentitiesOrErr() ([]*ExternalIdentity, error) {
+	if e.loadedTypes[9] {
+		return e.ExternalIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "external_identities"}
+}
+
+// LoginSessionsOrErr returns the LoginSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginSessionsOrErr() ([]*LoginSession, error) {
+	if e.loadedTypes[10] {
+		return e.LoginSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "login_sessions"}
+}
+
+// PersonalAccessTokensOrErr returns the PersonalAccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PersonalAccessTokensOrErr() ([]*PersonalAccessToken, error) {
+	if e.loadedTypes[11] {
+		return e.PersonalAccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "personal_access_tokens"}
+}
+
+// OauthGrantsOrErr returns the OauthGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthGrantsOrErr() ([]*OAuthGrant, error) {
+	if e.loadedTypes[12] {
+		return e.OauthGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_grants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldSettings:
+		case user.FieldPasswordHistory, user.FieldSettings:
 			values[i] = new([]byte)
-		case user.FieldID, user.FieldStorage, user.FieldGroupUsers:
+		case user.FieldID, user.FieldStorage, user.FieldExtraStorage, user.FieldGroupUsers:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldNick, user.FieldPassword, user.FieldStatus, user.FieldTwoFactorSecret, user.FieldAvatar:
+		case user.FieldEmail, user.FieldPhone, user.FieldNick, user.FieldPassword, user.FieldUniversity, user.FieldMajor, user.FieldStudentID, user.FieldStatus, user.FieldTwoFactorSecret, user.FieldAvatar:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldPasswordChangedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -197,6 +282,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				u.Phone = value.String
+			}
 		case user.FieldNick:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field nick", values[i])
@@ -209,6 +300,39 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Password = value.String
 			}
+		case user.FieldPasswordHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.PasswordHistory); err != nil {
+					return fmt.Errorf("unmarshal field password_history: %w", err)
+				}
+			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				u.PasswordChangedAt = new(time.Time)
+				*u.PasswordChangedAt = value.Time
+			}
+		case user.FieldUniversity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field university", values[i])
+			} else if value.Valid {
+				u.University = value.String
+			}
+		case user.FieldMajor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field major", values[i])
+			} else if value.Valid {
+				u.Major = value.String
+			}
+		case user.FieldStudentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				u.StudentID = value.String
+			}
 		case user.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -221,6 +345,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Storage = value.Int64
 			}
+		case user.FieldExtraStorage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_storage", values[i])
+			} else if value.Valid {
+				u.ExtraStorage = value.Int64
+			}
 		case user.FieldTwoFactorSecret:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field two_factor_secret", values[i])
@@ -295,6 +425,36 @@ func (u *User) QueryEntities() *EntityQuery {
 	return NewUserClient(u.config).QueryEntities(u)
 }
 
+// QueryCourse queries the "course" edge of the User entity.
+func (u *User) QueryCourse() *CourseQuery {
+	return NewUserClient(u.config).QueryCourse(u)
+}
+
+// QueryCourseMemberships queries the "course_memberships" edge of the User entity.
+func (u *User) QueryCourseMemberships() *CourseMemberQuery {
+	return NewUserClient(u.config).QueryCourseMemberships(u)
+}
+
+// QueryExternalIdentities queries the "external_identities" edge of the User entity.
+func (u *User) QueryExternalIdentities() *ExternalIdentityQuery {
+	return NewUserClient(u.config).QueryExternalIdentities(u)
+}
+
+// QueryLoginSessions queries the "login_sessions" edge of the User entity.
+func (u *User) QueryLoginSessions() *LoginSessionQuery {
+	return NewUserClient(u.config).QueryLoginSessions(u)
+}
+
+// QueryPersonalAccessTokens queries the "personal_access_tokens" edge of the User entity.
+func (u *User) QueryPersonalAccessTokens() *PersonalAccessTokenQuery {
+	return NewUserClient(u.config).QueryPersonalAccessTokens(u)
+}
+
+// QueryOauthGrants queries the "oauth_grants" edge of the User entity.
+func (u *User) QueryOauthGrants() *OAuthGrantQuery {
+	return NewUserClient(u.config).QueryOauthGrants(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -332,17 +492,39 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(u.Phone)
+	builder.WriteString(", ")
 	builder.WriteString("nick=")
 	builder.WriteString(u.Nick)
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("password_history=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("university=")
+	builder.WriteString(u.University)
+	builder.WriteString(", ")
+	builder.WriteString("major=")
+	builder.WriteString(u.Major)
+	builder.WriteString(", ")
+	builder.WriteString("student_id=")
+	builder.WriteString(u.StudentID)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", u.Status))
 	builder.WriteString(", ")
 	builder.WriteString("storage=")
 	builder.WriteString(fmt.Sprintf("%v", u.Storage))
 	builder.WriteString(", ")
+	builder.WriteString("extra_storage=")
+	builder.WriteString(fmt.Sprintf("%v", u.ExtraStorage))
+	builder.WriteString(", ")
 	builder.WriteString("two_factor_secret=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("avatar=")
@@ -399,5 +581,41 @@ func (e *User) SetEntities(v []*Entity) {
 	e.Edges.loadedTypes[6] = true
 }
 
+// SetCourse manually set the edge as loaded state.
+func (e *User) SetCourse(v *Course) {
+	e.Edges.Course = v
+	e.Edges.loadedTypes[7] = true
+}
+
+// SetCourseMemberships manually set the edge as loaded state.
+func (e *User) SetCourseMemberships(v []*CourseMember) {
+	e.Edges.CourseMemberships = v
+	e.Edges.loadedTypes[8] = true
+}
+
+// SetExternalIdentities manually set the edge as loaded state.
+func (e *User) SetExternalIdentities(v []*ExternalIdentity) {
+	e.Edges.ExternalIdentities = v
+	e.Edges.loadedTypes[9] = true
+}
+
+// SetLoginSessions manually set the edge as loaded state.
+func (e *User) SetLoginSessions(v []*LoginSession) {
+	e.Edges.LoginSessions = v
+	e.Edges.loadedTypes[10] = true
+}
+
+// SetPersonalAccessTokens manually set the edge as loaded state.
+func (e *User) SetPersonalAccessTokens(v []*PersonalAccessToken) {
+	e.Edges.PersonalAccessTokens = v
+	e.Edges.loadedTypes[11] = true
+}
+
+// SetOauthGrants manually set the edge as loaded state.
+func (e *User) SetOauthGrants(v []*OAuthGrant) {
+	e.Edges.OauthGrants = v
+	e.Edges.loadedTypes[12] = true
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -25,14 +25,28 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
 	// FieldNick holds the string denoting the nick field in the database.
 	FieldNick = "nick"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldPasswordHistory holds the string denoting the password_history field in the database.
+	FieldPasswordHistory = "password_history"
+	// FieldPasswordChangedAt holds the string denoting the password_changed_at field in the database.
+	FieldPasswordChangedAt = "password_changed_at"
+	// FieldUniversity holds the string denoting the university field in the database.
+	FieldUniversity = "university"
+	// FieldMajor holds the string denoting the major field in the database.
+	FieldMajor = "major"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldStorage holds the string denoting the storage field in the database.
 	FieldStorage = "storage"
+	// FieldExtraStorage holds the string denoting the extra_storage field in the database.
+	FieldExtraStorage = "extra_storage"
 	// FieldTwoFactorSecret holds the string denoting the two_factor_secret field in the database.
 	FieldTwoFactorSecret = "two_factor_secret"
 	// FieldAvatar holds the string denoting the avatar field in the database.
@@ -55,6 +69,18 @@ const (
 	EdgeTasks = "tasks"
 	// EdgeEntities holds the string denoting the entities edge name in mutations.
 	EdgeEntities = "entities"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// EdgeCourseMemberships holds the string denoting the course_memberships edge name in mutations.
+	EdgeCourseMemberships = "course_memberships"
+	// EdgeExternalIdentities holds the string denoting the external_identities edge name in mutations.
+	EdgeExternalIdentities = "external_identities"
+	// EdgeLoginSessions holds the string denoting the login_sessions edge name in mutations.
+	EdgeLoginSessions = "login_sessions"
+	// EdgePersonalAccessTokens holds the string denoting the personal_access_tokens edge name in mutations.
+	EdgePersonalAccessTokens = "personal_access_tokens"
+	// EdgeOauthGrants holds the string denoting the oauth_grants edge name in mutations.
+	EdgeOauthGrants = "oauth_grants"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// GroupTable is the table that holds the group relation/edge.
@@ -106,6 +132,48 @@ const (
 	EntitiesInverseTable = "entities"
 	// EntitiesColumn is the table column denoting the entities relation/edge.
 	EntitiesColumn = "created_by"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "courses"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "account_id"
+	// CourseMembershipsTable is the table that holds the course_memberships relation/edge.
+	CourseMembershipsTable = "course_members"
+	// CourseMembershipsInverseTable is the table name for the CourseMember entity.
+	// It exists in this package in order to avoid circular dependency with the "coursemember" package.
+	CourseMembershipsInverseTable = "course_members"
+	// CourseMembershipsColumn is the table column denoting the course_memberships relation/edge.
+	CourseMembershipsColumn = "user_id"
+	// ExternalIdentitiesTable is the table that holds the external_identities relation/edge.
+	ExternalIdentitiesTable = "external_identities"
+	// ExternalIdentitiesInverseTable is the table name for the ExternalIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "externalidentity" package.
+	ExternalIdentitiesInverseTable = "external_identities"
+	// ExternalIdentitiesColumn is the table column denoting the external_identities relation/edge.
+	ExternalIdentitiesColumn = "user_id"
+	// LoginSessionsTable is the table that holds the login_sessions relation/edge.
+	LoginSessionsTable = "login_sessions"
+	// LoginSessionsInverseTable is the table name for the LoginSession entity.
+	// It exists in this package in order to avoid circular dependency with the "loginsession" package.
+	LoginSessionsInverseTable = "login_sessions"
+	// LoginSessionsColumn is the table column denoting the login_sessions relation/edge.
+	LoginSessionsColumn = "user_id"
+	// PersonalAccessTokensTable is the table that holds the personal_access_tokens relation/edge.
+	PersonalAccessTokensTable = "personal_access_tokens"
+	// PersonalAccessTokensInverseTable is the table name for the PersonalAccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "personalaccesstoken" package.
+	PersonalAccessTokensInverseTable = "personal_access_tokens"
+	// PersonalAccessTokensColumn is the table column denoting the personal_access_tokens relation/edge.
+	PersonalAccessTokensColumn = "user_id"
+	// OauthGrantsTable is the table that holds the oauth_grants relation/edge.
+	OauthGrantsTable = "oauth_grants"
+	// OauthGrantsInverseTable is the table name for the OAuthGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthgrant" package.
+	OauthGrantsInverseTable = "oauth_grants"
+	// OauthGrantsColumn is the table column denoting the oauth_grants relation/edge.
+	OauthGrantsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -115,10 +183,17 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldDeletedAt,
 	FieldEmail,
+	FieldPhone,
 	FieldNick,
 	FieldPassword,
+	FieldPasswordHistory,
+	FieldPasswordChangedAt,
+	FieldUniversity,
+	FieldMajor,
+	FieldStudentID,
 	FieldStatus,
 	FieldStorage,
+	FieldExtraStorage,
 	FieldTwoFactorSecret,
 	FieldAvatar,
 	FieldSettings,
@@ -151,10 +226,20 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	PhoneValidator func(string) error
 	// NickValidator is a validator for the "nick" field. It is called by the builders before save.
 	NickValidator func(string) error
+	// UniversityValidator is a validator for the "university" field. It is called by the builders before save.
+	UniversityValidator func(string) error
+	// MajorValidator is a validator for the "major" field. It is called by the builders before save.
+	MajorValidator func(string) error
+	// StudentIDValidator is a validator for the "student_id" field. It is called by the builders before save.
+	StudentIDValidator func(string) error
 	// DefaultStorage holds the default value on creation for the "storage" field.
 	DefaultStorage int64
+	// DefaultExtraStorage holds the default value on creation for the "extra_storage" field.
+	DefaultExtraStorage int64
 	// DefaultSettings holds the default value on creation for the "settings" field.
 	DefaultSettings *types.UserSetting
 )
@@ -215,6 +300,11 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
 // ByNick orders the results by the nick field.
 func ByNick(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNick, opts...).ToFunc()
@@ -225,6 +315,26 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByPasswordChangedAt orders the results by the password_changed_at field.
+func ByPasswordChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordChangedAt, opts...).ToFunc()
+}
+
+// ByUniversity orders the results by the university field.
+func ByUniversity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUniversity, opts...).ToFunc()
+}
+
+// ByMajor orders the results by the major field.
+func ByMajor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMajor, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -235,6 +345,11 @@ func ByStorage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorage, opts...).ToFunc()
 }
 
+// ByExtraStorage orders the results by the extra_storage field.
+func ByExtraStorage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtraStorage, opts...).ToFunc()
+}
+
 // ByTwoFactorSecret orders the results by the two_factor_secret field.
 func ByTwoFactorSecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTwoFactorSecret, opts...).ToFunc()
@@ -340,6 +455,83 @@ func ByEntities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEntitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCourseMembershipsCount orders the results by course_memberships count.
+func ByCourseMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCourseMembershipsStep(), opts...)
+	}
+}
+
+// ByCourseMemberships orders the results by course_memberships terms.
+func ByCourseMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExternalIdentitiesCount orders the results by external_identities count.
+func ByExternalIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExternalIdentitiesStep(), opts...)
+	}
+}
+
+// ByExternalIdentities orders the results by external_identities terms.
+func ByExternalIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExternalIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoginSessionsCount orders the results by login_sessions count.
+func ByLoginSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginSessionsStep(), opts...)
+	}
+}
+
+// ByLoginSessions orders the results by login_sessions terms.
+func ByLoginSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPersonalAccessTokensCount orders the results by personal_access_tokens count.
+func ByPersonalAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPersonalAccessTokensStep(), opts...)
+	}
+}
+
+// ByPersonalAccessTokens orders the results by personal_access_tokens terms.
+func ByPersonalAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonalAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthGrantsCount orders the results by oauth_grants count.
+func ByOauthGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthGrantsStep(), opts...)
+	}
+}
+
+// ByOauthGrants orders the results by oauth_grants terms.
+func ByOauthGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -389,3 +581,45 @@ func newEntitiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EntitiesTable, EntitiesColumn),
 	)
 }
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, CourseTable, CourseColumn),
+	)
+}
+func newCourseMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CourseMembershipsTable, CourseMembershipsColumn),
+	)
+}
+func newExternalIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExternalIdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExternalIdentitiesTable, ExternalIdentitiesColumn),
+	)
+}
+func newLoginSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoginSessionsTable, LoginSessionsColumn),
+	)
+}
+func newPersonalAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonalAccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PersonalAccessTokensTable, PersonalAccessTokensColumn),
+	)
+}
+func newOauthGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthGrantsTable, OauthGrantsColumn),
+	)
+}
@@ -75,6 +75,11 @@ func Email(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
 }
 
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhone, v))
+}
+
 // Nick applies equality check predicate on the "nick" field. It's identical to NickEQ.
 func Nick(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNick, v))
@@ -85,11 +90,36 @@ func Password(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
+// PasswordChangedAt applies equality check predicate on the "password_changed_at" field. It's identical to PasswordChangedAtEQ.
+func PasswordChangedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
+// University applies equality check predicate on the "university" field. It's identical to UniversityEQ.
+func University(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUniversity, v))
+}
+
+// Major applies equality check predicate on the "major" field. It's identical to MajorEQ.
+func Major(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMajor, v))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStudentID, v))
+}
+
 // Storage applies equality check predicate on the "storage" field. It's identical to StorageEQ.
 func Storage(v int64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStorage, v))
 }
 
+// ExtraStorage applies equality check predicate on the "extra_storage" field. It's identical to ExtraStorageEQ.
+func ExtraStorage(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldExtraStorage, v))
+}
+
 // TwoFactorSecret applies equality check predicate on the "two_factor_secret" field. It's identical to TwoFactorSecretEQ.
 func TwoFactorSecret(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTwoFactorSecret, v))
@@ -290,6 +320,16 @@ func EmailHasSuffix(v string) predicate.User {
 	return predicate.User(sql.FieldHasSuffix(FieldEmail, v))
 }
 
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmail))
+}
+
 // EmailEqualFold applies the EqualFold predicate on the "email" field.
 func EmailEqualFold(v string) predicate.User {
 	return predicate.User(sql.FieldEqualFold(FieldEmail, v))
@@ -300,6 +340,81 @@ func EmailContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPhone, v))
+}
+
 // NickEQ applies the EQ predicate on the "nick" field.
 func NickEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNick, v))
@@ -440,6 +555,291 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// PasswordHistoryIsNil applies the IsNil predicate on the "password_history" field.
+func PasswordHistoryIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPasswordHistory))
+}
+
+// PasswordHistoryNotNil applies the NotNil predicate on the "password_history" field.
+func PasswordHistoryNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPasswordHistory))
+}
+
+// PasswordChangedAtEQ applies the EQ predicate on the "password_changed_at" field.
+func PasswordChangedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtNEQ applies the NEQ predicate on the "password_changed_at" field.
+func PasswordChangedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIn applies the In predicate on the "password_changed_at" field.
+func PasswordChangedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtNotIn applies the NotIn predicate on the "password_changed_at" field.
+func PasswordChangedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtGT applies the GT predicate on the "password_changed_at" field.
+func PasswordChangedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtGTE applies the GTE predicate on the "password_changed_at" field.
+func PasswordChangedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLT applies the LT predicate on the "password_changed_at" field.
+func PasswordChangedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLTE applies the LTE predicate on the "password_changed_at" field.
+func PasswordChangedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIsNil applies the IsNil predicate on the "password_changed_at" field.
+func PasswordChangedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPasswordChangedAt))
+}
+
+// PasswordChangedAtNotNil applies the NotNil predicate on the "password_changed_at" field.
+func PasswordChangedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPasswordChangedAt))
+}
+
+// UniversityEQ applies the EQ predicate on the "university" field.
+func UniversityEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUniversity, v))
+}
+
+// UniversityNEQ applies the NEQ predicate on the "university" field.
+func UniversityNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUniversity, v))
+}
+
+// UniversityIn applies the In predicate on the "university" field.
+func UniversityIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUniversity, vs...))
+}
+
+// UniversityNotIn applies the NotIn predicate on the "university" field.
+func UniversityNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUniversity, vs...))
+}
+
+// UniversityGT applies the GT predicate on the "university" field.
+func UniversityGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUniversity, v))
+}
+
+// UniversityGTE applies the GTE predicate on the "university" field.
+func UniversityGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUniversity, v))
+}
+
+// UniversityLT applies the LT predicate on the "university" field.
+func UniversityLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUniversity, v))
+}
+
+// UniversityLTE applies the LTE predicate on the "university" field.
+func UniversityLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUniversity, v))
+}
+
+// UniversityContains applies the Contains predicate on the "university" field.
+func UniversityContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldUniversity, v))
+}
+
+// UniversityHasPrefix applies the HasPrefix predicate on the "university" field.
+func UniversityHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldUniversity, v))
+}
+
+// UniversityHasSuffix applies the HasSuffix predicate on the "university" field.
+func UniversityHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldUniversity, v))
+}
+
+// UniversityIsNil applies the IsNil predicate on the "university" field.
+func UniversityIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUniversity))
+}
+
+// UniversityNotNil applies the NotNil predicate on the "university" field.
+func UniversityNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUniversity))
+}
+
+// UniversityEqualFold applies the EqualFold predicate on the "university" field.
+func UniversityEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldUniversity, v))
+}
+
+// UniversityContainsFold applies the ContainsFold predicate on the "university" field.
+func UniversityContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldUniversity, v))
+}
+
+// MajorEQ applies the EQ predicate on the "major" field.
+func MajorEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMajor, v))
+}
+
+// MajorNEQ applies the NEQ predicate on the "major" field.
+func MajorNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMajor, v))
+}
+
+// MajorIn applies the In predicate on the "major" field.
+func MajorIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMajor, vs...))
+}
+
+// MajorNotIn applies the NotIn predicate on the "major" field.
+func MajorNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMajor, vs...))
+}
+
+// MajorGT applies the GT predicate on the "major" field.
+func MajorGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMajor, v))
+}
+
+// MajorGTE applies the GTE predicate on the "major" field.
+func MajorGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMajor, v))
+}
+
+// MajorLT applies the LT predicate on the "major" field.
+func MajorLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMajor, v))
+}
+
+// MajorLTE applies the LTE predicate on the "major" field.
+func MajorLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMajor, v))
+}
+
+// MajorContains applies the Contains predicate on the "major" field.
+func MajorContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldMajor, v))
+}
+
+// MajorHasPrefix applies the HasPrefix predicate on the "major" field.
+func MajorHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldMajor, v))
+}
+
+// MajorHasSuffix applies the HasSuffix predicate on the "major" field.
+func MajorHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldMajor, v))
+}
+
+// MajorIsNil applies the IsNil predicate on the "major" field.
+func MajorIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMajor))
+}
+
+// MajorNotNil applies the NotNil predicate on the "major" field.
+func MajorNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMajor))
+}
+
+// MajorEqualFold applies the EqualFold predicate on the "major" field.
+func MajorEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldMajor, v))
+}
+
+// MajorContainsFold applies the ContainsFold predicate on the "major" field.
+func MajorContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldMajor, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDGT applies the GT predicate on the "student_id" field.
+func StudentIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStudentID, v))
+}
+
+// StudentIDGTE applies the GTE predicate on the "student_id" field.
+func StudentIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStudentID, v))
+}
+
+// StudentIDLT applies the LT predicate on the "student_id" field.
+func StudentIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStudentID, v))
+}
+
+// StudentIDLTE applies the LTE predicate on the "student_id" field.
+func StudentIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStudentID, v))
+}
+
+// StudentIDContains applies the Contains predicate on the "student_id" field.
+func StudentIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldStudentID, v))
+}
+
+// StudentIDHasPrefix applies the HasPrefix predicate on the "student_id" field.
+func StudentIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldStudentID, v))
+}
+
+// StudentIDHasSuffix applies the HasSuffix predicate on the "student_id" field.
+func StudentIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldStudentID, v))
+}
+
+// StudentIDIsNil applies the IsNil predicate on the "student_id" field.
+func StudentIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStudentID))
+}
+
+// StudentIDNotNil applies the NotNil predicate on the "student_id" field.
+func StudentIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStudentID))
+}
+
+// StudentIDEqualFold applies the EqualFold predicate on the "student_id" field.
+func StudentIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldStudentID, v))
+}
+
+// StudentIDContainsFold applies the ContainsFold predicate on the "student_id" field.
+func StudentIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldStudentID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
@@ -500,6 +900,46 @@ func StorageLTE(v int64) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldStorage, v))
 }
 
+// ExtraStorageEQ applies the EQ predicate on the "extra_storage" field.
+func ExtraStorageEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldExtraStorage, v))
+}
+
+// ExtraStorageNEQ applies the NEQ predicate on the "extra_storage" field.
+func ExtraStorageNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldExtraStorage, v))
+}
+
+// ExtraStorageIn applies the In predicate on the "extra_storage" field.
+func ExtraStorageIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldExtraStorage, vs...))
+}
+
+// ExtraStorageNotIn applies the NotIn predicate on the "extra_storage" field.
+func ExtraStorageNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldExtraStorage, vs...))
+}
+
+// ExtraStorageGT applies the GT predicate on the "extra_storage" field.
+func ExtraStorageGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldExtraStorage, v))
+}
+
+// ExtraStorageGTE applies the GTE predicate on the "extra_storage" field.
+func ExtraStorageGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldExtraStorage, v))
+}
+
+// ExtraStorageLT applies the LT predicate on the "extra_storage" field.
+func ExtraStorageLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldExtraStorage, v))
+}
+
+// ExtraStorageLTE applies the LTE predicate on the "extra_storage" field.
+func ExtraStorageLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldExtraStorage, v))
+}
+
 // TwoFactorSecretEQ applies the EQ predicate on the "two_factor_secret" field.
 func TwoFactorSecretEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTwoFactorSecret, v))
@@ -841,6 +1281,144 @@ func HasEntitiesWith(preds ...predicate.Entity) predicate.User {
 	})
 }
 
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCourseMemberships applies the HasEdge predicate on the "course_memberships" edge.
+func HasCourseMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CourseMembershipsTable, CourseMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseMembershipsWith applies the HasEdge predicate on the "course_memberships" edge with a given conditions (other predicates).
+func HasCourseMembershipsWith(preds ...predicate.CourseMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCourseMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExternalIdentities applies the HasEdge predicate on the "external_identities" edge.
+func HasExternalIdentities() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExternalIdentitiesTable, ExternalIdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExternalIdentitiesWith applies the HasEdge predicate on the "external_identities" edge with a given conditions (other predicates).
+func HasExternalIdentitiesWith(preds ...predicate.ExternalIdentity) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newExternalIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoginSessions applies the HasEdge predicate on the "login_sessions" edge.
+func HasLoginSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoginSessionsTable, LoginSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoginSessionsWith applies the HasEdge predicate on the "login_sessions" edge with a given conditions (other predicates).
+func HasLoginSessionsWith(preds ...predicate.LoginSession) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLoginSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPersonalAccessTokens applies the HasEdge predicate on the "personal_access_tokens" edge.
+func HasPersonalAccessTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PersonalAccessTokensTable, PersonalAccessTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonalAccessTokensWith applies the HasEdge predicate on the "personal_access_tokens" edge with a given conditions (other predicates).
+func HasPersonalAccessTokensWith(preds ...predicate.PersonalAccessToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPersonalAccessTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthGrants applies the HasEdge predicate on the "oauth_grants" edge.
+func HasOauthGrants() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthGrantsTable, OauthGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthGrantsWith applies the HasEdge predicate on the "oauth_grants" edge with a given conditions (other predicates).
+func HasOauthGrantsWith(preds ...predicate.OAuthGrant) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOauthGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
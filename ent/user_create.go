@@ -11,11 +11,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -78,6 +84,28 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmail(*s)
+	}
+	return uc
+}
+
+// SetPhone sets the "phone" field.
+func (uc *UserCreate) SetPhone(s string) *UserCreate {
+	uc.mutation.SetPhone(s)
+	return uc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhone(*s)
+	}
+	return uc
+}
+
 // SetNick sets the "nick" field.
 func (uc *UserCreate) SetNick(s string) *UserCreate {
 	uc.mutation.SetNick(s)
@@ -98,6 +126,68 @@ func (uc *UserCreate) SetNillablePassword(s *string) *UserCreate {
 	return uc
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (uc *UserCreate) SetPasswordHistory(s []string) *UserCreate {
+	uc.mutation.SetPasswordHistory(s)
+	return uc
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uc *UserCreate) SetPasswordChangedAt(t time.Time) *UserCreate {
+	uc.mutation.SetPasswordChangedAt(t)
+	return uc
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePasswordChangedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPasswordChangedAt(*t)
+	}
+	return uc
+}
+
+// SetUniversity sets the "university" field.
+func (uc *UserCreate) SetUniversity(s string) *UserCreate {
+	uc.mutation.SetUniversity(s)
+	return uc
+}
+
+// SetNillableUniversity sets the "university" field if the given value is not nil.
+func (uc *UserCreate) SetNillableUniversity(s *string) *UserCreate {
+	if s != nil {
+		uc.SetUniversity(*s)
+	}
+	return uc
+}
+
+// SetMajor sets the "major" field.
+func (uc *UserCreate) SetMajor(s string) *UserCreate {
+	uc.mutation.SetMajor(s)
+	return uc
+}
+
+// SetNillableMajor sets the "major" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMajor(s *string) *UserCreate {
+	if s != nil {
+		uc.SetMajor(*s)
+	}
+	return uc
+}
+
+// SetStudentID sets the "student_id" field.
+func (uc *UserCreate) SetStudentID(s string) *UserCreate {
+	uc.mutation.SetStudentID(s)
+	return uc
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStudentID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetStudentID(*s)
+	}
+	return uc
+}
+
 // SetStatus sets the "status" field.
 func (uc *UserCreate) SetStatus(u user.Status) *UserCreate {
 	uc.mutation.SetStatus(u)
@@ -126,6 +216,20 @@ func (uc *UserCreate) SetNillableStorage(i *int64) *UserCreate {
 	return uc
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (uc *UserCreate) SetExtraStorage(i int64) *UserCreate {
+	uc.mutation.SetExtraStorage(i)
+	return uc
+}
+
+// SetNillableExtraStorage sets the "extra_storage" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExtraStorage(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetExtraStorage(*i)
+	}
+	return uc
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (uc *UserCreate) SetTwoFactorSecret(s string) *UserCreate {
 	uc.mutation.SetTwoFactorSecret(s)
@@ -267,6 +371,100 @@ func (uc *UserCreate) AddEntities(e ...*Entity) *UserCreate {
 	return uc.AddEntityIDs(ids...)
 }
 
+// SetCourseID sets the "course" edge to the Course entity by ID.
+func (uc *UserCreate) SetCourseID(id int) *UserCreate {
+	uc.mutation.SetCourseID(id)
+	return uc
+}
+
+// SetNillableCourseID sets the "course" edge to the Course entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableCourseID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetCourseID(*id)
+	}
+	return uc
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (uc *UserCreate) SetCourse(c *Course) *UserCreate {
+	return uc.SetCourseID(c.ID)
+}
+
+// AddCourseMembershipIDs adds the "course_memberships" edge to the CourseMember entity by IDs.
+func (uc *UserCreate) AddCourseMembershipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddCourseMembershipIDs(ids...)
+	return uc
+}
+
+// AddCourseMemberships adds the "course_memberships" edges to the CourseMember entity.
+func (uc *UserCreate) AddCourseMemberships(c ...*CourseMember) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCourseMembershipIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uc *UserCreate) AddExternalIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddExternalIdentityIDs(ids...)
+	return uc
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uc *UserCreate) AddExternalIdentities(e ...*ExternalIdentity) *UserCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddExternalIdentityIDs(ids...)
+}
+
+// AddLoginSessionIDs adds the "login_sessions" edge to the LoginSession entity by IDs.
+func (uc *UserCreate) AddLoginSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddLoginSessionIDs(ids...)
+	return uc
+}
+
+// AddLoginSessions adds the "login_sessions" edges to the LoginSession entity.
+func (uc *UserCreate) AddLoginSessions(l ...*LoginSession) *UserCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddLoginSessionIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uc *UserCreate) AddPersonalAccessTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uc *UserCreate) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddOauthGrantIDs adds the "oauth_grants" edge to the OAuthGrant entity by IDs.
+func (uc *UserCreate) AddOauthGrantIDs(ids ...int) *UserCreate {
+	uc.mutation.AddOauthGrantIDs(ids...)
+	return uc
+}
+
+// AddOauthGrants adds the "oauth_grants" edges to the OAuthGrant entity.
+func (uc *UserCreate) AddOauthGrants(o ...*OAuthGrant) *UserCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOauthGrantIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -326,6 +524,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultStorage
 		uc.mutation.SetStorage(v)
 	}
+	if _, ok := uc.mutation.ExtraStorage(); !ok {
+		v := user.DefaultExtraStorage
+		uc.mutation.SetExtraStorage(v)
+	}
 	if _, ok := uc.mutation.Settings(); !ok {
 		v := user.DefaultSettings
 		uc.mutation.SetSettings(v)
@@ -341,14 +543,16 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
-	if _, ok := uc.mutation.Email(); !ok {
-		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "User.email"`)}
-	}
 	if v, ok := uc.mutation.Email(); ok {
 		if err := user.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if v, ok := uc.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.Nick(); !ok {
 		return &ValidationError{Name: "nick", err: errors.New(`ent: missing required field "User.nick"`)}
 	}
@@ -357,6 +561,21 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
 		}
 	}
+	if v, ok := uc.mutation.University(); ok {
+		if err := user.UniversityValidator(v); err != nil {
+			return &ValidationError{Name: "university", err: fmt.Errorf(`ent: validator failed for field "User.university": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.Major(); ok {
+		if err := user.MajorValidator(v); err != nil {
+			return &ValidationError{Name: "major", err: fmt.Errorf(`ent: validator failed for field "User.major": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.StudentID(); ok {
+		if err := user.StudentIDValidator(v); err != nil {
+			return &ValidationError{Name: "student_id", err: fmt.Errorf(`ent: validator failed for field "User.student_id": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
@@ -368,6 +587,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Storage(); !ok {
 		return &ValidationError{Name: "storage", err: errors.New(`ent: missing required field "User.storage"`)}
 	}
+	if _, ok := uc.mutation.ExtraStorage(); !ok {
+		return &ValidationError{Name: "extra_storage", err: errors.New(`ent: missing required field "User.extra_storage"`)}
+	}
 	if _, ok := uc.mutation.GroupUsers(); !ok {
 		return &ValidationError{Name: "group_users", err: errors.New(`ent: missing required field "User.group_users"`)}
 	}
@@ -424,6 +646,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
 	if value, ok := uc.mutation.Nick(); ok {
 		_spec.SetField(user.FieldNick, field.TypeString, value)
 		_node.Nick = value
@@ -432,6 +658,26 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := uc.mutation.PasswordHistory(); ok {
+		_spec.SetField(user.FieldPasswordHistory, field.TypeJSON, value)
+		_node.PasswordHistory = value
+	}
+	if value, ok := uc.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
+	if value, ok := uc.mutation.University(); ok {
+		_spec.SetField(user.FieldUniversity, field.TypeString, value)
+		_node.University = value
+	}
+	if value, ok := uc.mutation.Major(); ok {
+		_spec.SetField(user.FieldMajor, field.TypeString, value)
+		_node.Major = value
+	}
+	if value, ok := uc.mutation.StudentID(); ok {
+		_spec.SetField(user.FieldStudentID, field.TypeString, value)
+		_node.StudentID = value
+	}
 	if value, ok := uc.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -440,6 +686,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldStorage, field.TypeInt64, value)
 		_node.Storage = value
 	}
+	if value, ok := uc.mutation.ExtraStorage(); ok {
+		_spec.SetField(user.FieldExtraStorage, field.TypeInt64, value)
+		_node.ExtraStorage = value
+	}
 	if value, ok := uc.mutation.TwoFactorSecret(); ok {
 		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
 		_node.TwoFactorSecret = value
@@ -565,6 +815,102 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CourseTable,
+			Columns: []string{user.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CourseMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.LoginSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OauthGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -659,6 +1005,30 @@ func (u *UserUpsert) UpdateEmail() *UserUpsert {
 	return u
 }
 
+// ClearEmail clears the value of the "email" field.
+func (u *UserUpsert) ClearEmail() *UserUpsert {
+	u.SetNull(user.FieldEmail)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsert) SetPhone(v string) *UserUpsert {
+	u.Set(user.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePhone() *UserUpsert {
+	u.SetExcluded(user.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsert) ClearPhone() *UserUpsert {
+	u.SetNull(user.FieldPhone)
+	return u
+}
+
 // SetNick sets the "nick" field.
 func (u *UserUpsert) SetNick(v string) *UserUpsert {
 	u.Set(user.FieldNick, v)
@@ -689,6 +1059,96 @@ func (u *UserUpsert) ClearPassword() *UserUpsert {
 	return u
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (u *UserUpsert) SetPasswordHistory(v []string) *UserUpsert {
+	u.Set(user.FieldPasswordHistory, v)
+	return u
+}
+
+// UpdatePasswordHistory sets the "password_history" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePasswordHistory() *UserUpsert {
+	u.SetExcluded(user.FieldPasswordHistory)
+	return u
+}
+
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (u *UserUpsert) ClearPasswordHistory() *UserUpsert {
+	u.SetNull(user.FieldPasswordHistory)
+	return u
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserUpsert) SetPasswordChangedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldPasswordChangedAt, v)
+	return u
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePasswordChangedAt() *UserUpsert {
+	u.SetExcluded(user.FieldPasswordChangedAt)
+	return u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserUpsert) ClearPasswordChangedAt() *UserUpsert {
+	u.SetNull(user.FieldPasswordChangedAt)
+	return u
+}
+
+// SetUniversity sets the "university" field.
+func (u *UserUpsert) SetUniversity(v string) *UserUpsert {
+	u.Set(user.FieldUniversity, v)
+	return u
+}
+
+// UpdateUniversity sets the "university" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUniversity() *UserUpsert {
+	u.SetExcluded(user.FieldUniversity)
+	return u
+}
+
+// ClearUniversity clears the value of the "university" field.
+func (u *UserUpsert) ClearUniversity() *UserUpsert {
+	u.SetNull(user.FieldUniversity)
+	return u
+}
+
+// SetMajor sets the "major" field.
+func (u *UserUpsert) SetMajor(v string) *UserUpsert {
+	u.Set(user.FieldMajor, v)
+	return u
+}
+
+// UpdateMajor sets the "major" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMajor() *UserUpsert {
+	u.SetExcluded(user.FieldMajor)
+	return u
+}
+
+// ClearMajor clears the value of the "major" field.
+func (u *UserUpsert) ClearMajor() *UserUpsert {
+	u.SetNull(user.FieldMajor)
+	return u
+}
+
+// SetStudentID sets the "student_id" field.
+func (u *UserUpsert) SetStudentID(v string) *UserUpsert {
+	u.Set(user.FieldStudentID, v)
+	return u
+}
+
+// UpdateStudentID sets the "student_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStudentID() *UserUpsert {
+	u.SetExcluded(user.FieldStudentID)
+	return u
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (u *UserUpsert) ClearStudentID() *UserUpsert {
+	u.SetNull(user.FieldStudentID)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsert) SetStatus(v user.Status) *UserUpsert {
 	u.Set(user.FieldStatus, v)
@@ -719,6 +1179,24 @@ func (u *UserUpsert) AddStorage(v int64) *UserUpsert {
 	return u
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (u *UserUpsert) SetExtraStorage(v int64) *UserUpsert {
+	u.Set(user.FieldExtraStorage, v)
+	return u
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExtraStorage() *UserUpsert {
+	u.SetExcluded(user.FieldExtraStorage)
+	return u
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *UserUpsert) AddExtraStorage(v int64) *UserUpsert {
+	u.Add(user.FieldExtraStorage, v)
+	return u
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsert) SetTwoFactorSecret(v string) *UserUpsert {
 	u.Set(user.FieldTwoFactorSecret, v)
@@ -879,6 +1357,34 @@ func (u *UserUpsertOne) UpdateEmail() *UserUpsertOne {
 	})
 }
 
+// ClearEmail clears the value of the "email" field.
+func (u *UserUpsertOne) ClearEmail() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsertOne) SetPhone(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePhone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsertOne) ClearPhone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhone()
+	})
+}
+
 // SetNick sets the "nick" field.
 func (u *UserUpsertOne) SetNick(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -914,6 +1420,111 @@ func (u *UserUpsertOne) ClearPassword() *UserUpsertOne {
 	})
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (u *UserUpsertOne) SetPasswordHistory(v []string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHistory(v)
+	})
+}
+
+// UpdatePasswordHistory sets the "password_history" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePasswordHistory() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHistory()
+	})
+}
+
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (u *UserUpsertOne) ClearPasswordHistory() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordHistory()
+	})
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserUpsertOne) SetPasswordChangedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordChangedAt(v)
+	})
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePasswordChangedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordChangedAt()
+	})
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserUpsertOne) ClearPasswordChangedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordChangedAt()
+	})
+}
+
+// SetUniversity sets the "university" field.
+func (u *UserUpsertOne) SetUniversity(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUniversity(v)
+	})
+}
+
+// UpdateUniversity sets the "university" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUniversity() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUniversity()
+	})
+}
+
+// ClearUniversity clears the value of the "university" field.
+func (u *UserUpsertOne) ClearUniversity() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUniversity()
+	})
+}
+
+// SetMajor sets the "major" field.
+func (u *UserUpsertOne) SetMajor(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMajor(v)
+	})
+}
+
+// UpdateMajor sets the "major" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMajor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMajor()
+	})
+}
+
+// ClearMajor clears the value of the "major" field.
+func (u *UserUpsertOne) ClearMajor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMajor()
+	})
+}
+
+// SetStudentID sets the "student_id" field.
+func (u *UserUpsertOne) SetStudentID(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStudentID(v)
+	})
+}
+
+// UpdateStudentID sets the "student_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStudentID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStudentID()
+	})
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (u *UserUpsertOne) ClearStudentID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearStudentID()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsertOne) SetStatus(v user.Status) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -949,6 +1560,27 @@ func (u *UserUpsertOne) UpdateStorage() *UserUpsertOne {
 	})
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (u *UserUpsertOne) SetExtraStorage(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExtraStorage(v)
+	})
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *UserUpsertOne) AddExtraStorage(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExtraStorage(v)
+	})
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExtraStorage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExtraStorage()
+	})
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsertOne) SetTwoFactorSecret(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1291,6 +1923,34 @@ func (u *UserUpsertBulk) UpdateEmail() *UserUpsertBulk {
 	})
 }
 
+// ClearEmail clears the value of the "email" field.
+func (u *UserUpsertBulk) ClearEmail() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsertBulk) SetPhone(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePhone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsertBulk) ClearPhone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhone()
+	})
+}
+
 // SetNick sets the "nick" field.
 func (u *UserUpsertBulk) SetNick(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1326,6 +1986,111 @@ func (u *UserUpsertBulk) ClearPassword() *UserUpsertBulk {
 	})
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (u *UserUpsertBulk) SetPasswordHistory(v []string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHistory(v)
+	})
+}
+
+// UpdatePasswordHistory sets the "password_history" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePasswordHistory() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHistory()
+	})
+}
+
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (u *UserUpsertBulk) ClearPasswordHistory() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordHistory()
+	})
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserUpsertBulk) SetPasswordChangedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordChangedAt(v)
+	})
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePasswordChangedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordChangedAt()
+	})
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserUpsertBulk) ClearPasswordChangedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordChangedAt()
+	})
+}
+
+// SetUniversity sets the "university" field.
+func (u *UserUpsertBulk) SetUniversity(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUniversity(v)
+	})
+}
+
+// UpdateUniversity sets the "university" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUniversity() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUniversity()
+	})
+}
+
+// ClearUniversity clears the value of the "university" field.
+func (u *UserUpsertBulk) ClearUniversity() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUniversity()
+	})
+}
+
+// SetMajor sets the "major" field.
+func (u *UserUpsertBulk) SetMajor(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMajor(v)
+	})
+}
+
+// UpdateMajor sets the "major" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMajor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMajor()
+	})
+}
+
+// ClearMajor clears the value of the "major" field.
+func (u *UserUpsertBulk) ClearMajor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMajor()
+	})
+}
+
+// SetStudentID sets the "student_id" field.
+func (u *UserUpsertBulk) SetStudentID(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStudentID(v)
+	})
+}
+
+// UpdateStudentID sets the "student_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStudentID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStudentID()
+	})
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (u *UserUpsertBulk) ClearStudentID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearStudentID()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsertBulk) SetStatus(v user.Status) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1361,6 +2126,27 @@ func (u *UserUpsertBulk) UpdateStorage() *UserUpsertBulk {
 	})
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (u *UserUpsertBulk) SetExtraStorage(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExtraStorage(v)
+	})
+}
+
+// AddExtraStorage adds v to the "extra_storage" field.
+func (u *UserUpsertBulk) AddExtraStorage(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExtraStorage(v)
+	})
+}
+
+// UpdateExtraStorage sets the "extra_storage" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExtraStorage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExtraStorage()
+	})
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsertBulk) SetTwoFactorSecret(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
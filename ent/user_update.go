@@ -10,12 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
@@ -76,6 +83,32 @@ func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
 	return uu
 }
 
+// ClearEmail clears the value of the "email" field.
+func (uu *UserUpdate) ClearEmail() *UserUpdate {
+	uu.mutation.ClearEmail()
+	return uu
+}
+
+// SetPhone sets the "phone" field.
+func (uu *UserUpdate) SetPhone(s string) *UserUpdate {
+	uu.mutation.SetPhone(s)
+	return uu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPhone(*s)
+	}
+	return uu
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (uu *UserUpdate) ClearPhone() *UserUpdate {
+	uu.mutation.ClearPhone()
+	return uu
+}
+
 // SetNick sets the "nick" field.
 func (uu *UserUpdate) SetNick(s string) *UserUpdate {
 	uu.mutation.SetNick(s)
@@ -110,6 +143,104 @@ func (uu *UserUpdate) ClearPassword() *UserUpdate {
 	return uu
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (uu *UserUpdate) SetPasswordHistory(s []string) *UserUpdate {
+	uu.mutation.SetPasswordHistory(s)
+	return uu
+}
+
+// AppendPasswordHistory appends s to the "password_history" field.
+func (uu *UserUpdate) AppendPasswordHistory(s []string) *UserUpdate {
+	uu.mutation.AppendPasswordHistory(s)
+	return uu
+}
+
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (uu *UserUpdate) ClearPasswordHistory() *UserUpdate {
+	uu.mutation.ClearPasswordHistory()
+	return uu
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uu *UserUpdate) SetPasswordChangedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPasswordChangedAt(t)
+	return uu
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePasswordChangedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPasswordChangedAt(*t)
+	}
+	return uu
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uu *UserUpdate) ClearPasswordChangedAt() *UserUpdate {
+	uu.mutation.ClearPasswordChangedAt()
+	return uu
+}
+
+// SetUniversity sets the "university" field.
+func (uu *UserUpdate) SetUniversity(s string) *UserUpdate {
+	uu.mutation.SetUniversity(s)
+	return uu
+}
+
+// SetNillableUniversity sets the "university" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableUniversity(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetUniversity(*s)
+	}
+	return uu
+}
+
+// ClearUniversity clears the value of the "university" field.
+func (uu *UserUpdate) ClearUniversity() *UserUpdate {
+	uu.mutation.ClearUniversity()
+	return uu
+}
+
+// SetMajor sets the "major" field.
+func (uu *UserUpdate) SetMajor(s string) *UserUpdate {
+	uu.mutation.SetMajor(s)
+	return uu
+}
+
+// SetNillableMajor sets the "major" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMajor(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetMajor(*s)
+	}
+	return uu
+}
+
+// ClearMajor clears the value of the "major" field.
+func (uu *UserUpdate) ClearMajor() *UserUpdate {
+	uu.mutation.ClearMajor()
+	return uu
+}
+
+// SetStudentID sets the "student_id" field.
+func (uu *UserUpdate) SetStudentID(s string) *UserUpdate {
+	uu.mutation.SetStudentID(s)
+	return uu
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStudentID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetStudentID(*s)
+	}
+	return uu
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (uu *UserUpdate) ClearStudentID() *UserUpdate {
+	uu.mutation.ClearStudentID()
+	return uu
+}
+
 // SetStatus sets the "status" field.
 func (uu *UserUpdate) SetStatus(u user.Status) *UserUpdate {
 	uu.mutation.SetStatus(u)
@@ -145,6 +276,27 @@ func (uu *UserUpdate) AddStorage(i int64) *UserUpdate {
 	return uu
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (uu *UserUpdate) SetExtraStorage(i int64) *UserUpdate {
+	uu.mutation.ResetExtraStorage()
+	uu.mutation.SetExtraStorage(i)
+	return uu
+}
+
+// SetNillableExtraStorage sets the "extra_storage" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableExtraStorage(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetExtraStorage(*i)
+	}
+	return uu
+}
+
+// AddExtraStorage adds i to the "extra_storage" field.
+func (uu *UserUpdate) AddExtraStorage(i int64) *UserUpdate {
+	uu.mutation.AddExtraStorage(i)
+	return uu
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (uu *UserUpdate) SetTwoFactorSecret(s string) *UserUpdate {
 	uu.mutation.SetTwoFactorSecret(s)
@@ -312,6 +464,100 @@ func (uu *UserUpdate) AddEntities(e ...*Entity) *UserUpdate {
 	return uu.AddEntityIDs(ids...)
 }
 
+// SetCourseID sets the "course" edge to the Course entity by ID.
+func (uu *UserUpdate) SetCourseID(id int) *UserUpdate {
+	uu.mutation.SetCourseID(id)
+	return uu
+}
+
+// SetNillableCourseID sets the "course" edge to the Course entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableCourseID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetCourseID(*id)
+	}
+	return uu
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (uu *UserUpdate) SetCourse(c *Course) *UserUpdate {
+	return uu.SetCourseID(c.ID)
+}
+
+// AddCourseMembershipIDs adds the "course_memberships" edge to the CourseMember entity by IDs.
+func (uu *UserUpdate) AddCourseMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddCourseMembershipIDs(ids...)
+	return uu
+}
+
+// AddCourseMemberships adds the "course_memberships" edges to the CourseMember entity.
+func (uu *UserUpdate) AddCourseMemberships(c ...*CourseMember) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCourseMembershipIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uu *UserUpdate) AddExternalIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddExternalIdentityIDs(ids...)
+	return uu
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uu *UserUpdate) AddExternalIdentities(e ...*ExternalIdentity) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddExternalIdentityIDs(ids...)
+}
+
+// AddLoginSessionIDs adds the "login_sessions" edge to the LoginSession entity by IDs.
+func (uu *UserUpdate) AddLoginSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddLoginSessionIDs(ids...)
+	return uu
+}
+
+// AddLoginSessions adds the "login_sessions" edges to the LoginSession entity.
+func (uu *UserUpdate) AddLoginSessions(l ...*LoginSession) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddLoginSessionIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uu *UserUpdate) AddPersonalAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uu
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddOauthGrantIDs adds the "oauth_grants" edge to the OAuthGrant entity by IDs.
+func (uu *UserUpdate) AddOauthGrantIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddOauthGrantIDs(ids...)
+	return uu
+}
+
+// AddOauthGrants adds the "oauth_grants" edges to the OAuthGrant entity.
+func (uu *UserUpdate) AddOauthGrants(o ...*OAuthGrant) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddOauthGrantIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -449,6 +695,117 @@ func (uu *UserUpdate) RemoveEntities(e ...*Entity) *UserUpdate {
 	return uu.RemoveEntityIDs(ids...)
 }
 
+// ClearCourse clears the "course" edge to the Course entity.
+func (uu *UserUpdate) ClearCourse() *UserUpdate {
+	uu.mutation.ClearCourse()
+	return uu
+}
+
+// ClearCourseMemberships clears all "course_memberships" edges to the CourseMember entity.
+func (uu *UserUpdate) ClearCourseMemberships() *UserUpdate {
+	uu.mutation.ClearCourseMemberships()
+	return uu
+}
+
+// RemoveCourseMembershipIDs removes the "course_memberships" edge to CourseMember entities by IDs.
+func (uu *UserUpdate) RemoveCourseMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveCourseMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveCourseMemberships removes "course_memberships" edges to CourseMember entities.
+func (uu *UserUpdate) RemoveCourseMemberships(c ...*CourseMember) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCourseMembershipIDs(ids...)
+}
+
+// ClearExternalIdentities clears all "external_identities" edges to the ExternalIdentity entity.
+func (uu *UserUpdate) ClearExternalIdentities() *UserUpdate {
+	uu.mutation.ClearExternalIdentities()
+	return uu
+}
+
+// RemoveExternalIdentityIDs removes the "external_identities" edge to ExternalIdentity entities by IDs.
+func (uu *UserUpdate) RemoveExternalIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveExternalIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveExternalIdentities removes "external_identities" edges to ExternalIdentity entities.
+func (uu *UserUpdate) RemoveExternalIdentities(e ...*ExternalIdentity) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.RemoveExternalIdentityIDs(ids...)
+}
+
+// ClearLoginSessions clears all "login_sessions" edges to the LoginSession entity.
+func (uu *UserUpdate) ClearLoginSessions() *UserUpdate {
+	uu.mutation.ClearLoginSessions()
+	return uu
+}
+
+// RemoveLoginSessionIDs removes the "login_sessions" edge to LoginSession entities by IDs.
+func (uu *UserUpdate) RemoveLoginSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveLoginSessionIDs(ids...)
+	return uu
+}
+
+// RemoveLoginSessions removes "login_sessions" edges to LoginSession entities.
+func (uu *UserUpdate) RemoveLoginSessions(l ...*LoginSession) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.RemoveLoginSessionIDs(ids...)
+}
+
+// ClearPersonalAccessTokens clears all "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) ClearPersonalAccessTokens() *UserUpdate {
+	uu.mutation.ClearPersonalAccessTokens()
+	return uu
+}
+
+// RemovePersonalAccessTokenIDs removes the "personal_access_tokens" edge to PersonalAccessToken entities by IDs.
+func (uu *UserUpdate) RemovePersonalAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePersonalAccessTokenIDs(ids...)
+	return uu
+}
+
+// RemovePersonalAccessTokens removes "personal_access_tokens" edges to PersonalAccessToken entities.
+func (uu *UserUpdate) RemovePersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePersonalAccessTokenIDs(ids...)
+}
+
+// ClearOauthGrants clears all "oauth_grants" edges to the OAuthGrant entity.
+func (uu *UserUpdate) ClearOauthGrants() *UserUpdate {
+	uu.mutation.ClearOauthGrants()
+	return uu
+}
+
+// RemoveOauthGrantIDs removes the "oauth_grants" edge to OAuthGrant entities by IDs.
+func (uu *UserUpdate) RemoveOauthGrantIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveOauthGrantIDs(ids...)
+	return uu
+}
+
+// RemoveOauthGrants removes "oauth_grants" edges to OAuthGrant entities.
+func (uu *UserUpdate) RemoveOauthGrants(o ...*OAuthGrant) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveOauthGrantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -498,11 +855,31 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
 	if v, ok := uu.mutation.Nick(); ok {
 		if err := user.NickValidator(v); err != nil {
 			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.University(); ok {
+		if err := user.UniversityValidator(v); err != nil {
+			return &ValidationError{Name: "university", err: fmt.Errorf(`ent: validator failed for field "User.university": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Major(); ok {
+		if err := user.MajorValidator(v); err != nil {
+			return &ValidationError{Name: "major", err: fmt.Errorf(`ent: validator failed for field "User.major": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.StudentID(); ok {
+		if err := user.StudentIDValidator(v); err != nil {
+			return &ValidationError{Name: "student_id", err: fmt.Errorf(`ent: validator failed for field "User.student_id": %w`, err)}
+		}
+	}
 	if v, ok := uu.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
@@ -538,6 +915,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if uu.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uu.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if uu.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
 	if value, ok := uu.mutation.Nick(); ok {
 		_spec.SetField(user.FieldNick, field.TypeString, value)
 	}
@@ -547,6 +933,41 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := uu.mutation.PasswordHistory(); ok {
+		_spec.SetField(user.FieldPasswordHistory, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedPasswordHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldPasswordHistory, value)
+		})
+	}
+	if uu.mutation.PasswordHistoryCleared() {
+		_spec.ClearField(user.FieldPasswordHistory, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uu.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.University(); ok {
+		_spec.SetField(user.FieldUniversity, field.TypeString, value)
+	}
+	if uu.mutation.UniversityCleared() {
+		_spec.ClearField(user.FieldUniversity, field.TypeString)
+	}
+	if value, ok := uu.mutation.Major(); ok {
+		_spec.SetField(user.FieldMajor, field.TypeString, value)
+	}
+	if uu.mutation.MajorCleared() {
+		_spec.ClearField(user.FieldMajor, field.TypeString)
+	}
+	if value, ok := uu.mutation.StudentID(); ok {
+		_spec.SetField(user.FieldStudentID, field.TypeString, value)
+	}
+	if uu.mutation.StudentIDCleared() {
+		_spec.ClearField(user.FieldStudentID, field.TypeString)
+	}
 	if value, ok := uu.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
@@ -556,6 +977,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedStorage(); ok {
 		_spec.AddField(user.FieldStorage, field.TypeInt64, value)
 	}
+	if value, ok := uu.mutation.ExtraStorage(); ok {
+		_spec.SetField(user.FieldExtraStorage, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedExtraStorage(); ok {
+		_spec.AddField(user.FieldExtraStorage, field.TypeInt64, value)
+	}
 	if value, ok := uu.mutation.TwoFactorSecret(); ok {
 		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
 	}
@@ -873,48 +1300,302 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CourseTable,
+			Columns: []string{user.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetDeletedAt(t)
-	return uuo
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetDeletedAt(*t)
+	if nodes := uu.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CourseTable,
+			Columns: []string{user.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	if uu.mutation.CourseMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCourseMembershipsIDs(); len(nodes) > 0 && !uu.mutation.CourseMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CourseMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedExternalIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.LoginSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedLoginSessionsIDs(); len(nodes) > 0 && !uu.mutation.LoginSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.LoginSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPersonalAccessTokensIDs(); len(nodes) > 0 && !uu.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OauthGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOauthGrantsIDs(); len(nodes) > 0 && !uu.mutation.OauthGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OauthGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
 	uuo.mutation.ClearDeletedAt()
 	return uuo
 }
@@ -933,6 +1614,32 @@ func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// ClearEmail clears the value of the "email" field.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// SetPhone sets the "phone" field.
+func (uuo *UserUpdateOne) SetPhone(s string) *UserUpdateOne {
+	uuo.mutation.SetPhone(s)
+	return uuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhone(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhone(*s)
+	}
+	return uuo
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (uuo *UserUpdateOne) ClearPhone() *UserUpdateOne {
+	uuo.mutation.ClearPhone()
+	return uuo
+}
+
 // SetNick sets the "nick" field.
 func (uuo *UserUpdateOne) SetNick(s string) *UserUpdateOne {
 	uuo.mutation.SetNick(s)
@@ -967,6 +1674,104 @@ func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
 	return uuo
 }
 
+// SetPasswordHistory sets the "password_history" field.
+func (uuo *UserUpdateOne) SetPasswordHistory(s []string) *UserUpdateOne {
+	uuo.mutation.SetPasswordHistory(s)
+	return uuo
+}
+
+// AppendPasswordHistory appends s to the "password_history" field.
+func (uuo *UserUpdateOne) AppendPasswordHistory(s []string) *UserUpdateOne {
+	uuo.mutation.AppendPasswordHistory(s)
+	return uuo
+}
+
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (uuo *UserUpdateOne) ClearPasswordHistory() *UserUpdateOne {
+	uuo.mutation.ClearPasswordHistory()
+	return uuo
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uuo *UserUpdateOne) SetPasswordChangedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPasswordChangedAt(t)
+	return uuo
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordChangedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPasswordChangedAt(*t)
+	}
+	return uuo
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uuo *UserUpdateOne) ClearPasswordChangedAt() *UserUpdateOne {
+	uuo.mutation.ClearPasswordChangedAt()
+	return uuo
+}
+
+// SetUniversity sets the "university" field.
+func (uuo *UserUpdateOne) SetUniversity(s string) *UserUpdateOne {
+	uuo.mutation.SetUniversity(s)
+	return uuo
+}
+
+// SetNillableUniversity sets the "university" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUniversity(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUniversity(*s)
+	}
+	return uuo
+}
+
+// ClearUniversity clears the value of the "university" field.
+func (uuo *UserUpdateOne) ClearUniversity() *UserUpdateOne {
+	uuo.mutation.ClearUniversity()
+	return uuo
+}
+
+// SetMajor sets the "major" field.
+func (uuo *UserUpdateOne) SetMajor(s string) *UserUpdateOne {
+	uuo.mutation.SetMajor(s)
+	return uuo
+}
+
+// SetNillableMajor sets the "major" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMajor(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetMajor(*s)
+	}
+	return uuo
+}
+
+// ClearMajor clears the value of the "major" field.
+func (uuo *UserUpdateOne) ClearMajor() *UserUpdateOne {
+	uuo.mutation.ClearMajor()
+	return uuo
+}
+
+// SetStudentID sets the "student_id" field.
+func (uuo *UserUpdateOne) SetStudentID(s string) *UserUpdateOne {
+	uuo.mutation.SetStudentID(s)
+	return uuo
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStudentID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetStudentID(*s)
+	}
+	return uuo
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (uuo *UserUpdateOne) ClearStudentID() *UserUpdateOne {
+	uuo.mutation.ClearStudentID()
+	return uuo
+}
+
 // SetStatus sets the "status" field.
 func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
 	uuo.mutation.SetStatus(u)
@@ -1002,6 +1807,27 @@ func (uuo *UserUpdateOne) AddStorage(i int64) *UserUpdateOne {
 	return uuo
 }
 
+// SetExtraStorage sets the "extra_storage" field.
+func (uuo *UserUpdateOne) SetExtraStorage(i int64) *UserUpdateOne {
+	uuo.mutation.ResetExtraStorage()
+	uuo.mutation.SetExtraStorage(i)
+	return uuo
+}
+
+// SetNillableExtraStorage sets the "extra_storage" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableExtraStorage(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetExtraStorage(*i)
+	}
+	return uuo
+}
+
+// AddExtraStorage adds i to the "extra_storage" field.
+func (uuo *UserUpdateOne) AddExtraStorage(i int64) *UserUpdateOne {
+	uuo.mutation.AddExtraStorage(i)
+	return uuo
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (uuo *UserUpdateOne) SetTwoFactorSecret(s string) *UserUpdateOne {
 	uuo.mutation.SetTwoFactorSecret(s)
@@ -1169,6 +1995,100 @@ func (uuo *UserUpdateOne) AddEntities(e ...*Entity) *UserUpdateOne {
 	return uuo.AddEntityIDs(ids...)
 }
 
+// SetCourseID sets the "course" edge to the Course entity by ID.
+func (uuo *UserUpdateOne) SetCourseID(id int) *UserUpdateOne {
+	uuo.mutation.SetCourseID(id)
+	return uuo
+}
+
+// SetNillableCourseID sets the "course" edge to the Course entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCourseID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetCourseID(*id)
+	}
+	return uuo
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (uuo *UserUpdateOne) SetCourse(c *Course) *UserUpdateOne {
+	return uuo.SetCourseID(c.ID)
+}
+
+// AddCourseMembershipIDs adds the "course_memberships" edge to the CourseMember entity by IDs.
+func (uuo *UserUpdateOne) AddCourseMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddCourseMembershipIDs(ids...)
+	return uuo
+}
+
+// AddCourseMemberships adds the "course_memberships" edges to the CourseMember entity.
+func (uuo *UserUpdateOne) AddCourseMemberships(c ...*CourseMember) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCourseMembershipIDs(ids...)
+}
+
+// AddExternalIdentityIDs adds the "external_identities" edge to the ExternalIdentity entity by IDs.
+func (uuo *UserUpdateOne) AddExternalIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddExternalIdentityIDs(ids...)
+	return uuo
+}
+
+// AddExternalIdentities adds the "external_identities" edges to the ExternalIdentity entity.
+func (uuo *UserUpdateOne) AddExternalIdentities(e ...*ExternalIdentity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddExternalIdentityIDs(ids...)
+}
+
+// AddLoginSessionIDs adds the "login_sessions" edge to the LoginSession entity by IDs.
+func (uuo *UserUpdateOne) AddLoginSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddLoginSessionIDs(ids...)
+	return uuo
+}
+
+// AddLoginSessions adds the "login_sessions" edges to the LoginSession entity.
+func (uuo *UserUpdateOne) AddLoginSessions(l ...*LoginSession) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddLoginSessionIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uuo *UserUpdateOne) AddPersonalAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uuo
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddOauthGrantIDs adds the "oauth_grants" edge to the OAuthGrant entity by IDs.
+func (uuo *UserUpdateOne) AddOauthGrantIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOauthGrantIDs(ids...)
+	return uuo
+}
+
+// AddOauthGrants adds the "oauth_grants" edges to the OAuthGrant entity.
+func (uuo *UserUpdateOne) AddOauthGrants(o ...*OAuthGrant) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOauthGrantIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -1234,76 +2154,187 @@ func (uuo *UserUpdateOne) RemoveShareIDs(ids ...int) *UserUpdateOne {
 	return uuo
 }
 
-// RemoveShares removes "shares" edges to Share entities.
-func (uuo *UserUpdateOne) RemoveShares(s ...*Share) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveShares removes "shares" edges to Share entities.
+func (uuo *UserUpdateOne) RemoveShares(s ...*Share) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveShareIDs(ids...)
+}
+
+// ClearPasskey clears all "passkey" edges to the Passkey entity.
+func (uuo *UserUpdateOne) ClearPasskey() *UserUpdateOne {
+	uuo.mutation.ClearPasskey()
+	return uuo
+}
+
+// RemovePasskeyIDs removes the "passkey" edge to Passkey entities by IDs.
+func (uuo *UserUpdateOne) RemovePasskeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePasskeyIDs(ids...)
+	return uuo
+}
+
+// RemovePasskey removes "passkey" edges to Passkey entities.
+func (uuo *UserUpdateOne) RemovePasskey(p ...*Passkey) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePasskeyIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (uuo *UserUpdateOne) ClearTasks() *UserUpdateOne {
+	uuo.mutation.ClearTasks()
+	return uuo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (uuo *UserUpdateOne) RemoveTaskIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveTaskIDs(ids...)
+	return uuo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (uuo *UserUpdateOne) RemoveTasks(t ...*Task) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTaskIDs(ids...)
+}
+
+// ClearEntities clears all "entities" edges to the Entity entity.
+func (uuo *UserUpdateOne) ClearEntities() *UserUpdateOne {
+	uuo.mutation.ClearEntities()
+	return uuo
+}
+
+// RemoveEntityIDs removes the "entities" edge to Entity entities by IDs.
+func (uuo *UserUpdateOne) RemoveEntityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEntityIDs(ids...)
+	return uuo
+}
+
+// RemoveEntities removes "entities" edges to Entity entities.
+func (uuo *UserUpdateOne) RemoveEntities(e ...*Entity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEntityIDs(ids...)
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (uuo *UserUpdateOne) ClearCourse() *UserUpdateOne {
+	uuo.mutation.ClearCourse()
+	return uuo
+}
+
+// ClearCourseMemberships clears all "course_memberships" edges to the CourseMember entity.
+func (uuo *UserUpdateOne) ClearCourseMemberships() *UserUpdateOne {
+	uuo.mutation.ClearCourseMemberships()
+	return uuo
+}
+
+// RemoveCourseMembershipIDs removes the "course_memberships" edge to CourseMember entities by IDs.
+func (uuo *UserUpdateOne) RemoveCourseMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCourseMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveCourseMemberships removes "course_memberships" edges to CourseMember entities.
+func (uuo *UserUpdateOne) RemoveCourseMemberships(c ...*CourseMember) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCourseMembershipIDs(ids...)
+}
+
+// ClearExternalIdentities clears all "external_identities" edges to the ExternalIdentity entity.
+func (uuo *UserUpdateOne) ClearExternalIdentities() *UserUpdateOne {
+	uuo.mutation.ClearExternalIdentities()
+	return uuo
+}
+
+// RemoveExternalIdentityIDs removes the "external_identities" edge to ExternalIdentity entities by IDs.
+func (uuo *UserUpdateOne) RemoveExternalIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveExternalIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveExternalIdentities removes "external_identities" edges to ExternalIdentity entities.
+func (uuo *UserUpdateOne) RemoveExternalIdentities(e ...*ExternalIdentity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	return uuo.RemoveShareIDs(ids...)
+	return uuo.RemoveExternalIdentityIDs(ids...)
 }
 
-// ClearPasskey clears all "passkey" edges to the Passkey entity.
-func (uuo *UserUpdateOne) ClearPasskey() *UserUpdateOne {
-	uuo.mutation.ClearPasskey()
+// ClearLoginSessions clears all "login_sessions" edges to the LoginSession entity.
+func (uuo *UserUpdateOne) ClearLoginSessions() *UserUpdateOne {
+	uuo.mutation.ClearLoginSessions()
 	return uuo
 }
 
-// RemovePasskeyIDs removes the "passkey" edge to Passkey entities by IDs.
-func (uuo *UserUpdateOne) RemovePasskeyIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemovePasskeyIDs(ids...)
+// RemoveLoginSessionIDs removes the "login_sessions" edge to LoginSession entities by IDs.
+func (uuo *UserUpdateOne) RemoveLoginSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveLoginSessionIDs(ids...)
 	return uuo
 }
 
-// RemovePasskey removes "passkey" edges to Passkey entities.
-func (uuo *UserUpdateOne) RemovePasskey(p ...*Passkey) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// RemoveLoginSessions removes "login_sessions" edges to LoginSession entities.
+func (uuo *UserUpdateOne) RemoveLoginSessions(l ...*LoginSession) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	return uuo.RemovePasskeyIDs(ids...)
+	return uuo.RemoveLoginSessionIDs(ids...)
 }
 
-// ClearTasks clears all "tasks" edges to the Task entity.
-func (uuo *UserUpdateOne) ClearTasks() *UserUpdateOne {
-	uuo.mutation.ClearTasks()
+// ClearPersonalAccessTokens clears all "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) ClearPersonalAccessTokens() *UserUpdateOne {
+	uuo.mutation.ClearPersonalAccessTokens()
 	return uuo
 }
 
-// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
-func (uuo *UserUpdateOne) RemoveTaskIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveTaskIDs(ids...)
+// RemovePersonalAccessTokenIDs removes the "personal_access_tokens" edge to PersonalAccessToken entities by IDs.
+func (uuo *UserUpdateOne) RemovePersonalAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePersonalAccessTokenIDs(ids...)
 	return uuo
 }
 
-// RemoveTasks removes "tasks" edges to Task entities.
-func (uuo *UserUpdateOne) RemoveTasks(t ...*Task) *UserUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+// RemovePersonalAccessTokens removes "personal_access_tokens" edges to PersonalAccessToken entities.
+func (uuo *UserUpdateOne) RemovePersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return uuo.RemoveTaskIDs(ids...)
+	return uuo.RemovePersonalAccessTokenIDs(ids...)
 }
 
-// ClearEntities clears all "entities" edges to the Entity entity.
-func (uuo *UserUpdateOne) ClearEntities() *UserUpdateOne {
-	uuo.mutation.ClearEntities()
+// ClearOauthGrants clears all "oauth_grants" edges to the OAuthGrant entity.
+func (uuo *UserUpdateOne) ClearOauthGrants() *UserUpdateOne {
+	uuo.mutation.ClearOauthGrants()
 	return uuo
 }
 
-// RemoveEntityIDs removes the "entities" edge to Entity entities by IDs.
-func (uuo *UserUpdateOne) RemoveEntityIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveEntityIDs(ids...)
+// RemoveOauthGrantIDs removes the "oauth_grants" edge to OAuthGrant entities by IDs.
+func (uuo *UserUpdateOne) RemoveOauthGrantIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOauthGrantIDs(ids...)
 	return uuo
 }
 
-// RemoveEntities removes "entities" edges to Entity entities.
-func (uuo *UserUpdateOne) RemoveEntities(e ...*Entity) *UserUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// RemoveOauthGrants removes "oauth_grants" edges to OAuthGrant entities.
+func (uuo *UserUpdateOne) RemoveOauthGrants(o ...*OAuthGrant) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return uuo.RemoveEntityIDs(ids...)
+	return uuo.RemoveOauthGrantIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -1368,11 +2399,31 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
 	if v, ok := uuo.mutation.Nick(); ok {
 		if err := user.NickValidator(v); err != nil {
 			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.University(); ok {
+		if err := user.UniversityValidator(v); err != nil {
+			return &ValidationError{Name: "university", err: fmt.Errorf(`ent: validator failed for field "User.university": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Major(); ok {
+		if err := user.MajorValidator(v); err != nil {
+			return &ValidationError{Name: "major", err: fmt.Errorf(`ent: validator failed for field "User.major": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.StudentID(); ok {
+		if err := user.StudentIDValidator(v); err != nil {
+			return &ValidationError{Name: "student_id", err: fmt.Errorf(`ent: validator failed for field "User.student_id": %w`, err)}
+		}
+	}
 	if v, ok := uuo.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
@@ -1425,6 +2476,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if uuo.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if uuo.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Nick(); ok {
 		_spec.SetField(user.FieldNick, field.TypeString, value)
 	}
@@ -1434,6 +2494,41 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := uuo.mutation.PasswordHistory(); ok {
+		_spec.SetField(user.FieldPasswordHistory, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedPasswordHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldPasswordHistory, value)
+		})
+	}
+	if uuo.mutation.PasswordHistoryCleared() {
+		_spec.ClearField(user.FieldPasswordHistory, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.University(); ok {
+		_spec.SetField(user.FieldUniversity, field.TypeString, value)
+	}
+	if uuo.mutation.UniversityCleared() {
+		_spec.ClearField(user.FieldUniversity, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Major(); ok {
+		_spec.SetField(user.FieldMajor, field.TypeString, value)
+	}
+	if uuo.mutation.MajorCleared() {
+		_spec.ClearField(user.FieldMajor, field.TypeString)
+	}
+	if value, ok := uuo.mutation.StudentID(); ok {
+		_spec.SetField(user.FieldStudentID, field.TypeString, value)
+	}
+	if uuo.mutation.StudentIDCleared() {
+		_spec.ClearField(user.FieldStudentID, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
@@ -1443,6 +2538,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.AddedStorage(); ok {
 		_spec.AddField(user.FieldStorage, field.TypeInt64, value)
 	}
+	if value, ok := uuo.mutation.ExtraStorage(); ok {
+		_spec.SetField(user.FieldExtraStorage, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedExtraStorage(); ok {
+		_spec.AddField(user.FieldExtraStorage, field.TypeInt64, value)
+	}
 	if value, ok := uuo.mutation.TwoFactorSecret(); ok {
 		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
 	}
@@ -1760,6 +2861,260 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CourseTable,
+			Columns: []string{user.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CourseTable,
+			Columns: []string{user.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.CourseMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCourseMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.CourseMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CourseMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CourseMembershipsTable,
+			Columns: []string{user.CourseMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coursemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedExternalIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.ExternalIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ExternalIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExternalIdentitiesTable,
+			Columns: []string{user.ExternalIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.LoginSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedLoginSessionsIDs(); len(nodes) > 0 && !uuo.mutation.LoginSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.LoginSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginSessionsTable,
+			Columns: []string{user.LoginSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPersonalAccessTokensIDs(); len(nodes) > 0 && !uuo.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OauthGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOauthGrantsIDs(); len(nodes) > 0 && !uuo.mutation.OauthGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OauthGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthGrantsTable,
+			Columns: []string{user.OauthGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthgrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
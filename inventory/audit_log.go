@@ -0,0 +1,128 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+)
+
+type (
+	AuditLogClient interface {
+		TxOperator
+		// Create creates a new audit log entry.
+		Create(ctx context.Context, args *AuditLogArgs) (*ent.AuditLog, error)
+		// List lists audit logs with pagination and filters.
+		List(ctx context.Context, args *ListAuditLogParameters) (*ListAuditLogResult, error)
+		// DeleteBefore deletes audit logs created before given time, returns number of deleted entries.
+		DeleteBefore(ctx context.Context, t time.Time) (int, error)
+	}
+
+	AuditLogArgs struct {
+		Action    string
+		UserID    int
+		IP        string
+		UserAgent string
+		Target    string
+		Props     map[string]string
+	}
+
+	ListAuditLogParameters struct {
+		*PaginationArgs
+		Action string
+		UserID int
+		IP     string
+		Target string
+		// Start and End limit the creation time of logs, ignored if nil.
+		Start *time.Time
+		End   *time.Time
+	}
+
+	ListAuditLogResult struct {
+		*PaginationResults
+		Logs []*ent.AuditLog
+	}
+)
+
+func NewAuditLogClient(client *ent.Client) AuditLogClient {
+	return &auditLogClient{client: client}
+}
+
+type auditLogClient struct {
+	client *ent.Client
+}
+
+func (c *auditLogClient) SetClient(newClient *ent.Client) TxOperator {
+	return &auditLogClient{client: newClient}
+}
+
+func (c *auditLogClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *auditLogClient) Create(ctx context.Context, args *AuditLogArgs) (*ent.AuditLog, error) {
+	stm := c.client.AuditLog.Create().
+		SetAction(args.Action).
+		SetIP(args.IP).
+		SetUserAgent(args.UserAgent).
+		SetTarget(args.Target)
+	if args.UserID != 0 {
+		stm.SetUserID(args.UserID)
+	}
+	if len(args.Props) > 0 {
+		stm.SetProps(args.Props)
+	}
+
+	return stm.Save(ctx)
+}
+
+func (c *auditLogClient) List(ctx context.Context, args *ListAuditLogParameters) (*ListAuditLogResult, error) {
+	query := c.client.AuditLog.Query()
+	if args.Action != "" {
+		query = query.Where(auditlog.Action(args.Action))
+	}
+	if args.UserID != 0 {
+		query = query.Where(auditlog.UserID(args.UserID))
+	}
+	if args.IP != "" {
+		query = query.Where(auditlog.IP(args.IP))
+	}
+	if args.Target != "" {
+		query = query.Where(auditlog.TargetContainsFold(args.Target))
+	}
+	if args.Start != nil {
+		query = query.Where(auditlog.CreatedAtGTE(*args.Start))
+	}
+	if args.End != nil {
+		query = query.Where(auditlog.CreatedAtLT(*args.End))
+	}
+
+	// IDs grow with creation time, ordering by ID uses the primary key
+	query.Order(auditlog.ByID(getOrderTerm(args.Order)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := query.Limit(args.PageSize).Offset(args.Page * args.PageSize).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAuditLogResult{
+		PaginationResults: &PaginationResults{
+			TotalItems: total,
+			Page:       args.Page,
+			PageSize:   args.PageSize,
+		},
+		Logs: logs,
+	}, nil
+}
+
+func (c *auditLogClient) DeleteBefore(ctx context.Context, t time.Time) (int, error) {
+	return c.client.AuditLog.Delete().
+		Where(auditlog.CreatedAtLT(t)).
+		Exec(ctx)
+}
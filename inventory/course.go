@@ -0,0 +1,303 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/course"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+type (
+	// LoadCourseAccount 查询课程时同时加载课程系统账号及其用户组
+	LoadCourseAccount struct{}
+	// LoadCourseMemberUser 查询课程成员时同时加载成员用户
+	LoadCourseMemberUser struct{}
+	// LoadCourseMemberCourse 查询课程成员时同时加载所属课程
+	LoadCourseMemberCourse struct{}
+)
+
+type (
+	CourseClient interface {
+		TxOperator
+		// Create creates a new course bound to the given backing account.
+		Create(ctx context.Context, args *CreateCourseParameters) (*ent.Course, error)
+		// Update updates basic info and quota of a course.
+		Update(ctx context.Context, id int, args *CreateCourseParameters) (*ent.Course, error)
+		// GetByID returns the course with given id.
+		GetByID(ctx context.Context, id int) (*ent.Course, error)
+		// GetByCode returns the course with given code.
+		GetByCode(ctx context.Context, code string) (*ent.Course, error)
+		// GetByAccountID returns the course backed by given account.
+		GetByAccountID(ctx context.Context, accountID int) (*ent.Course, error)
+		// List returns courses with pagination.
+		List(ctx context.Context, args *ListCourseParameters) (*ListCourseResult, error)
+		// Delete deletes the course with all its memberships and assignments. Backing account is not deleted.
+		Delete(ctx context.Context, id int) error
+		// GetMember returns the membership of given user in given course.
+		GetMember(ctx context.Context, courseID, uid int) (*ent.CourseMember, error)
+		// ListMembers returns all members of the course.
+		ListMembers(ctx context.Context, courseID int) ([]*ent.CourseMember, error)
+		// ListByUser returns all memberships of given user.
+		ListByUser(ctx context.Context, uid int) ([]*ent.CourseMember, error)
+		// SetMember adds a user to the course, or updates the role if already a member.
+		SetMember(ctx context.Context, courseID, uid int, role coursemember.Role) (*ent.CourseMember, error)
+		// RemoveMember removes a user from the course.
+		RemoveMember(ctx context.Context, courseID, uid int) error
+		// CreateAssignment creates a new assignment drop-box.
+		CreateAssignment(ctx context.Context, args *AssignmentParameters) (*ent.Assignment, error)
+		// UpdateAssignment updates deadline and rules of an assignment.
+		UpdateAssignment(ctx context.Context, id int, args *AssignmentParameters) (*ent.Assignment, error)
+		// GetAssignment returns the assignment with given id.
+		GetAssignment(ctx context.Context, id int) (*ent.Assignment, error)
+		// ListAssignments returns all assignments of the course.
+		ListAssignments(ctx context.Context, courseID int) ([]*ent.Assignment, error)
+		// DeleteAssignment deletes the assignment. Drop-box folder and submissions are kept.
+		DeleteAssignment(ctx context.Context, id int) error
+	}
+
+	CreateCourseParameters struct {
+		Name        string
+		Code        string
+		Description string
+		MaxStorage  int64
+		AccountID   int
+	}
+
+	AssignmentParameters struct {
+		Name         string
+		CourseID     int
+		FolderID     int
+		Deadline     time.Time
+		LatePolicy   assignment.LatePolicy
+		LateDeadline *time.Time
+		Settings     *types.AssignmentSetting
+	}
+
+	ListCourseParameters struct {
+		*PaginationArgs
+		Name string
+		Code string
+	}
+
+	ListCourseResult struct {
+		*PaginationResults
+		Courses []*ent.Course
+	}
+)
+
+func NewCourseClient(client *ent.Client) CourseClient {
+	return &courseClient{client: client}
+}
+
+type courseClient struct {
+	client *ent.Client
+}
+
+func (c *courseClient) SetClient(newClient *ent.Client) TxOperator {
+	return &courseClient{client: newClient}
+}
+
+func (c *courseClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *courseClient) Create(ctx context.Context, args *CreateCourseParameters) (*ent.Course, error) {
+	return c.client.Course.Create().
+		SetName(args.Name).
+		SetCode(args.Code).
+		SetDescription(args.Description).
+		SetMaxStorage(args.MaxStorage).
+		SetAccountID(args.AccountID).
+		Save(ctx)
+}
+
+func (c *courseClient) Update(ctx context.Context, id int, args *CreateCourseParameters) (*ent.Course, error) {
+	return c.client.Course.UpdateOneID(id).
+		SetName(args.Name).
+		SetCode(args.Code).
+		SetDescription(args.Description).
+		SetMaxStorage(args.MaxStorage).
+		Save(ctx)
+}
+
+func (c *courseClient) GetByID(ctx context.Context, id int) (*ent.Course, error) {
+	return withCourseEagerLoading(ctx, c.client.Course.Query().Where(course.ID(id))).First(ctx)
+}
+
+func (c *courseClient) GetByCode(ctx context.Context, code string) (*ent.Course, error) {
+	return withCourseEagerLoading(ctx, c.client.Course.Query().Where(course.Code(code))).First(ctx)
+}
+
+func (c *courseClient) GetByAccountID(ctx context.Context, accountID int) (*ent.Course, error) {
+	return withCourseEagerLoading(ctx, c.client.Course.Query().Where(course.AccountID(accountID))).First(ctx)
+}
+
+func (c *courseClient) List(ctx context.Context, args *ListCourseParameters) (*ListCourseResult, error) {
+	query := c.client.Course.Query()
+	if args.Name != "" {
+		query = query.Where(course.NameContainsFold(args.Name))
+	}
+	if args.Code != "" {
+		query = query.Where(course.CodeContainsFold(args.Code))
+	}
+	query.Order(getCourseOrderOption(args)...)
+
+	// Count total items
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := withCourseEagerLoading(ctx, query).Limit(args.PageSize).Offset(args.Page * args.PageSize).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListCourseResult{
+		PaginationResults: &PaginationResults{
+			TotalItems: total,
+			Page:       args.Page,
+			PageSize:   args.PageSize,
+		},
+		Courses: courses,
+	}, nil
+}
+
+func (c *courseClient) Delete(ctx context.Context, id int) error {
+	if _, err := c.client.CourseMember.Delete().Where(coursemember.CourseID(id)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete course members: %w", err)
+	}
+
+	if _, err := c.client.Assignment.Delete().Where(assignment.CourseID(id)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete course assignments: %w", err)
+	}
+
+	return c.client.Course.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *courseClient) GetMember(ctx context.Context, courseID, uid int) (*ent.CourseMember, error) {
+	return withCourseMemberEagerLoading(ctx, c.client.CourseMember.Query().
+		Where(coursemember.CourseID(courseID), coursemember.UserID(uid))).
+		First(ctx)
+}
+
+func (c *courseClient) ListMembers(ctx context.Context, courseID int) ([]*ent.CourseMember, error) {
+	return withCourseMemberEagerLoading(ctx, c.client.CourseMember.Query().
+		Where(coursemember.CourseID(courseID)).
+		Order(coursemember.ByRole(), coursemember.ByID())).
+		All(ctx)
+}
+
+func (c *courseClient) ListByUser(ctx context.Context, uid int) ([]*ent.CourseMember, error) {
+	return withCourseMemberEagerLoading(ctx, c.client.CourseMember.Query().
+		Where(coursemember.UserID(uid)).
+		Order(coursemember.ByID())).
+		All(ctx)
+}
+
+func (c *courseClient) SetMember(ctx context.Context, courseID, uid int, role coursemember.Role) (*ent.CourseMember, error) {
+	id, err := c.client.CourseMember.Create().
+		SetCourseID(courseID).
+		SetUserID(uid).
+		SetRole(role).
+		OnConflictColumns(coursemember.FieldCourseID, coursemember.FieldUserID).
+		UpdateRole().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert course member: %w", err)
+	}
+
+	return c.client.CourseMember.Get(ctx, id)
+}
+
+func (c *courseClient) RemoveMember(ctx context.Context, courseID, uid int) error {
+	_, err := c.client.CourseMember.Delete().
+		Where(coursemember.CourseID(courseID), coursemember.UserID(uid)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *courseClient) CreateAssignment(ctx context.Context, args *AssignmentParameters) (*ent.Assignment, error) {
+	return c.client.Assignment.Create().
+		SetName(args.Name).
+		SetCourseID(args.CourseID).
+		SetFolderID(args.FolderID).
+		SetDeadline(args.Deadline).
+		SetLatePolicy(args.LatePolicy).
+		SetNillableLateDeadline(args.LateDeadline).
+		SetSettings(args.Settings).
+		Save(ctx)
+}
+
+func (c *courseClient) UpdateAssignment(ctx context.Context, id int, args *AssignmentParameters) (*ent.Assignment, error) {
+	stm := c.client.Assignment.UpdateOneID(id).
+		SetName(args.Name).
+		SetDeadline(args.Deadline).
+		SetLatePolicy(args.LatePolicy).
+		SetSettings(args.Settings)
+	if args.LateDeadline != nil {
+		stm.SetLateDeadline(*args.LateDeadline)
+	} else {
+		stm.ClearLateDeadline()
+	}
+
+	return stm.Save(ctx)
+}
+
+func (c *courseClient) GetAssignment(ctx context.Context, id int) (*ent.Assignment, error) {
+	return c.client.Assignment.Get(ctx, id)
+}
+
+func (c *courseClient) ListAssignments(ctx context.Context, courseID int) ([]*ent.Assignment, error) {
+	return c.client.Assignment.Query().
+		Where(assignment.CourseID(courseID)).
+		Order(assignment.ByDeadline(), assignment.ByID()).
+		All(ctx)
+}
+
+func (c *courseClient) DeleteAssignment(ctx context.Context, id int) error {
+	return c.client.Assignment.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func getCourseOrderOption(args *ListCourseParameters) []course.OrderOption {
+	orderTerm := getOrderTerm(args.Order)
+	switch args.OrderBy {
+	case course.FieldName:
+		return []course.OrderOption{course.ByName(orderTerm), course.ByID(orderTerm)}
+	case course.FieldCode:
+		return []course.OrderOption{course.ByCode(orderTerm), course.ByID(orderTerm)}
+	case course.FieldMaxStorage:
+		return []course.OrderOption{course.ByMaxStorage(orderTerm), course.ByID(orderTerm)}
+	case course.FieldUpdatedAt:
+		return []course.OrderOption{course.ByUpdatedAt(orderTerm), course.ByID(orderTerm)}
+	default:
+		return []course.OrderOption{course.ByID(orderTerm)}
+	}
+}
+
+func withCourseEagerLoading(ctx context.Context, q *ent.CourseQuery) *ent.CourseQuery {
+	if v, ok := ctx.Value(LoadCourseAccount{}).(bool); ok && v {
+		q.WithAccount(func(uq *ent.UserQuery) {
+			withUserEagerLoading(context.WithValue(ctx, LoadUserGroup{}, true), uq)
+		})
+	}
+	return q
+}
+
+func withCourseMemberEagerLoading(ctx context.Context, q *ent.CourseMemberQuery) *ent.CourseMemberQuery {
+	if v, ok := ctx.Value(LoadCourseMemberUser{}).(bool); ok && v {
+		q.WithUser()
+	}
+	if v, ok := ctx.Value(LoadCourseMemberCourse{}).(bool); ok && v {
+		q.WithCourse(func(cq *ent.CourseQuery) {
+			withCourseEagerLoading(ctx, cq)
+		})
+	}
+	return q
+}
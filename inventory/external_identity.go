@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+)
+
+type (
+	ExternalIdentityClient interface {
+		TxOperator
+		// GetBySubject returns the identity linked to given subject of an identity provider.
+		GetBySubject(ctx context.Context, provider, subject string) (*ent.ExternalIdentity, error)
+		// ListByUser lists identities linked to given user.
+		ListByUser(ctx context.Context, uid int) ([]*ent.ExternalIdentity, error)
+		// Link links a subject of an identity provider to given user.
+		Link(ctx context.Context, uid int, provider, subject string) (*ent.ExternalIdentity, error)
+		// Unlink removes the identity of given provider linked to given user.
+		Unlink(ctx context.Context, uid int, provider string) error
+	}
+)
+
+func NewExternalIdentityClient(client *ent.Client) ExternalIdentityClient {
+	return &externalIdentityClient{client: client}
+}
+
+type externalIdentityClient struct {
+	client *ent.Client
+}
+
+func (c *externalIdentityClient) SetClient(newClient *ent.Client) TxOperator {
+	return &externalIdentityClient{client: newClient}
+}
+
+func (c *externalIdentityClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *externalIdentityClient) GetBySubject(ctx context.Context, provider, subject string) (*ent.ExternalIdentity, error) {
+	return c.client.ExternalIdentity.Query().
+		Where(externalidentity.Provider(provider), externalidentity.Subject(subject)).
+		First(ctx)
+}
+
+func (c *externalIdentityClient) ListByUser(ctx context.Context, uid int) ([]*ent.ExternalIdentity, error) {
+	return c.client.ExternalIdentity.Query().
+		Where(externalidentity.UserID(uid)).
+		Order(ent.Asc(externalidentity.FieldID)).
+		All(ctx)
+}
+
+func (c *externalIdentityClient) Link(ctx context.Context, uid int, provider, subject string) (*ent.ExternalIdentity, error) {
+	return c.client.ExternalIdentity.Create().
+		SetUserID(uid).
+		SetProvider(provider).
+		SetSubject(subject).
+		Save(ctx)
+}
+
+func (c *externalIdentityClient) Unlink(ctx context.Context, uid int, provider string) error {
+	// 硬删除，解除关联后允许重新关联同一外部账号
+	_, err := c.client.ExternalIdentity.Delete().
+		Where(externalidentity.UserID(uid), externalidentity.Provider(provider)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
@@ -224,6 +224,9 @@ type FileClient interface {
 	UpdateProps(ctx context.Context, file *ent.File, props *types.FileProps) (*ent.File, error)
 	// UpdateModifiedAt updates modified at of a file
 	UpdateModifiedAt(ctx context.Context, file *ent.File, modifiedAt time.Time) error
+	// RelocateEntity points an entity to its new source in given storage policy, returns a stale copy of the
+	// original entity for recycling the old blob.
+	RelocateEntity(ctx context.Context, policyID int, args *RelocateEntityParameter) (*ent.Entity, error)
 }
 
 func NewFileClient(client *ent.Client, dbType conf.DBType, hasher hashid.Encoder) FileClient {
@@ -744,6 +747,55 @@ func (f *fileClient) UpgradePlaceholder(ctx context.Context, file *ent.File, mod
 	return nil
 }
 
+func (f *fileClient) RelocateEntity(ctx context.Context, policyID int, args *RelocateEntityParameter) (*ent.Entity, error) {
+	// Only update the entity if it is not modified or deleted since relocation is prepared
+	affected, err := f.client.Entity.Update().
+		Where(
+			entity.ID(args.Entity.ID),
+			entity.Source(args.Entity.Source),
+			entity.StoragePolicyEntities(args.Entity.StoragePolicyEntities),
+			entity.ReferenceCountGT(0),
+		).
+		SetSource(args.NewSource).
+		SetStoragePolicyEntities(policyID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to relocate entity: %v", err)
+	}
+
+	if affected == 0 {
+		return nil, fmt.Errorf("entity %d is modified during relocation", args.Entity.ID)
+	}
+
+	// Keep the old blob in an unreferenced entity, it will be deleted by entity recycle
+	stm := f.client.Entity.
+		Create().
+		SetType(args.Entity.Type).
+		SetSource(args.Entity.Source).
+		SetSize(args.Entity.Size).
+		SetReferenceCount(0).
+		SetStoragePolicyID(args.Entity.StoragePolicyEntities)
+	if args.Entity.Props != nil {
+		stm.SetProps(args.Entity.Props)
+	}
+
+	stale, err := stm.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stale entity: %v", err)
+	}
+
+	if len(args.PrimaryEntityParentFiles) > 0 {
+		if _, err := f.client.File.Update().
+			Where(file.IDIn(args.PrimaryEntityParentFiles...), file.PrimaryEntity(args.Entity.ID)).
+			SetStoragePolicyFiles(policyID).
+			Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update file storage policy: %v", err)
+		}
+	}
+
+	return stale, nil
+}
+
 func (f *fileClient) SetPrimaryEntity(ctx context.Context, file *ent.File, entityID int) error {
 	return f.client.File.UpdateOne(file).SetPrimaryEntity(entityID).Exec(ctx)
 }
@@ -1065,7 +1117,7 @@ func (f *fileClient) GetEntitiesByIDs(ctx context.Context, ids []int, page int)
 		return nil, -1, fmt.Errorf("page out of range")
 	}
 
-	res, err := f.client.Entity.Query().Where(groups[page]).All(ctx)
+	res, err := withEntityEagerLoading(ctx, f.client.Entity.Query().Where(groups[page])).All(ctx)
 	if err != nil {
 		return nil, page, err
 	}
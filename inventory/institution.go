@@ -0,0 +1,364 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/institution"
+	"github.com/cloudreve/Cloudreve/v4/ent/major"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+var (
+	ErrInstitutionNotFound = errors.New("institution not found in directory")
+	ErrMajorNotFound       = errors.New("major not found in directory")
+)
+
+type (
+	InstitutionClient interface {
+		TxOperator
+		// ListAll returns all institutions with their majors.
+		ListAll(ctx context.Context) ([]*ent.Institution, error)
+		// GetByID returns the institution with its majors.
+		GetByID(ctx context.Context, id int) (*ent.Institution, error)
+		// Upsert creates or updates an institution.
+		Upsert(ctx context.Context, i *ent.Institution) (*ent.Institution, error)
+		// Delete deletes an institution with all its majors.
+		Delete(ctx context.Context, id int) error
+		// UpsertMajor creates or updates a major.
+		UpsertMajor(ctx context.Context, m *ent.Major) (*ent.Major, error)
+		// DeleteMajor deletes a major.
+		DeleteMajor(ctx context.Context, id int) error
+		// Canonicalize maps free-text university and major to canonical names in directory. If strict is false,
+		// values not found in directory are returned as-is; otherwise ErrInstitutionNotFound or ErrMajorNotFound
+		// is returned. Empty major is always allowed.
+		Canonicalize(ctx context.Context, university, major string, strict bool) (string, string, error)
+		// NormalizeUsers maps university and major of all existing users to canonical names.
+		NormalizeUsers(ctx context.Context, dryRun bool) (*NormalizeUsersResult, error)
+		// Mapping returns the group and extra storage mapped to given university and major. Mapping of major
+		// takes precedence over its institution. Returns nil if neither of them is mapped.
+		Mapping(ctx context.Context, university, major string) (*DirectoryMapping, error)
+		// CountUsers counts users belonging to given university, and major if it is not empty.
+		CountUsers(ctx context.Context, university, major string) (int, error)
+		// ListUsers lists users with group loaded belonging to given university (and major), with ID greater than afterID.
+		ListUsers(ctx context.Context, university, major string, afterID, limit int) ([]*ent.User, error)
+		// ApplyMapping updates user's group and extra storage according to the mapping. Group is kept if
+		// mapping does not specify one. Returns true if user is changed.
+		ApplyMapping(ctx context.Context, u *ent.User, m *DirectoryMapping) (bool, error)
+	}
+
+	// DirectoryMapping is the group and extra storage assigned to users of an institution or major.
+	DirectoryMapping struct {
+		GroupID      int
+		ExtraStorage int64
+	}
+
+	NormalizeUsersResult struct {
+		Scanned   int            `json:"scanned"`
+		Updated   int            `json:"updated"`
+		Conflicts []int          `json:"conflicts,omitempty"`
+		Unmatched map[string]int `json:"unmatched,omitempty"`
+		DryRun    bool           `json:"dry_run"`
+	}
+)
+
+func NewInstitutionClient(client *ent.Client) InstitutionClient {
+	return &institutionClient{client: client}
+}
+
+type institutionClient struct {
+	client *ent.Client
+}
+
+func (c *institutionClient) SetClient(newClient *ent.Client) TxOperator {
+	return &institutionClient{client: newClient}
+}
+
+func (c *institutionClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *institutionClient) ListAll(ctx context.Context) ([]*ent.Institution, error) {
+	return c.client.Institution.Query().
+		WithMajors(func(q *ent.MajorQuery) {
+			q.Order(major.ByName())
+		}).
+		Order(institution.ByName()).
+		All(ctx)
+}
+
+func (c *institutionClient) GetByID(ctx context.Context, id int) (*ent.Institution, error) {
+	return c.client.Institution.Query().
+		Where(institution.ID(id)).
+		WithMajors(func(q *ent.MajorQuery) {
+			q.Order(major.ByName())
+		}).
+		First(ctx)
+}
+
+func (c *institutionClient) Upsert(ctx context.Context, i *ent.Institution) (*ent.Institution, error) {
+	if i.ID == 0 {
+		return c.client.Institution.Create().
+			SetName(i.Name).
+			SetAliases(i.Aliases).
+			SetGroupID(i.GroupID).
+			SetExtraStorage(i.ExtraStorage).
+			Save(ctx)
+	}
+
+	return c.client.Institution.UpdateOne(i).
+		SetName(i.Name).
+		SetAliases(i.Aliases).
+		SetGroupID(i.GroupID).
+		SetExtraStorage(i.ExtraStorage).
+		Save(ctx)
+}
+
+func (c *institutionClient) Delete(ctx context.Context, id int) error {
+	if _, err := c.client.Major.Delete().Where(major.InstitutionID(id)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete majors: %w", err)
+	}
+
+	return c.client.Institution.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *institutionClient) UpsertMajor(ctx context.Context, m *ent.Major) (*ent.Major, error) {
+	if m.ID == 0 {
+		return c.client.Major.Create().
+			SetName(m.Name).
+			SetAliases(m.Aliases).
+			SetInstitutionID(m.InstitutionID).
+			SetGroupID(m.GroupID).
+			SetExtraStorage(m.ExtraStorage).
+			Save(ctx)
+	}
+
+	return c.client.Major.UpdateOne(m).
+		SetName(m.Name).
+		SetAliases(m.Aliases).
+		SetInstitutionID(m.InstitutionID).
+		SetGroupID(m.GroupID).
+		SetExtraStorage(m.ExtraStorage).
+		Save(ctx)
+}
+
+func (c *institutionClient) DeleteMajor(ctx context.Context, id int) error {
+	return c.client.Major.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *institutionClient) Canonicalize(ctx context.Context, university, majorName string, strict bool) (string, string, error) {
+	institutions, err := c.ListAll(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list institutions: %w", err)
+	}
+
+	return canonicalize(institutions, university, majorName, strict)
+}
+
+func (c *institutionClient) NormalizeUsers(ctx context.Context, dryRun bool) (*NormalizeUsersResult, error) {
+	institutions, err := c.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list institutions: %w", err)
+	}
+
+	res := &NormalizeUsersResult{
+		Unmatched: make(map[string]int),
+		DryRun:    dryRun,
+	}
+
+	const batchSize = 1000
+	lastID := 0
+	for {
+		users, err := c.client.User.Query().
+			Where(user.IDGT(lastID), user.UniversityNEQ("")).
+			Order(user.ByID()).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(users) == 0 {
+			break
+		}
+
+		for _, u := range users {
+			lastID = u.ID
+			res.Scanned++
+
+			university, majorName, err := canonicalize(institutions, u.University, u.Major, true)
+			if errors.Is(err, ErrInstitutionNotFound) {
+				res.Unmatched[u.University]++
+				continue
+			}
+
+			// 专业不在目录中时仍然规范院校名称，保留原专业
+			if errors.Is(err, ErrMajorNotFound) {
+				majorName = u.Major
+			}
+
+			if university == u.University && majorName == u.Major {
+				continue
+			}
+
+			if !dryRun {
+				if err := c.client.User.UpdateOne(u).SetUniversity(university).SetMajor(majorName).Exec(ctx); err != nil {
+					// 合并院校后学号可能重复，需要管理员手动处理
+					if ent.IsConstraintError(err) {
+						res.Conflicts = append(res.Conflicts, u.ID)
+						continue
+					}
+					return nil, fmt.Errorf("failed to update user %d: %w", u.ID, err)
+				}
+			}
+			res.Updated++
+		}
+	}
+
+	return res, nil
+}
+
+func (c *institutionClient) Mapping(ctx context.Context, university, majorName string) (*DirectoryMapping, error) {
+	institutions, err := c.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list institutions: %w", err)
+	}
+
+	return mappingOf(institutions, university, majorName), nil
+}
+
+func (c *institutionClient) CountUsers(ctx context.Context, university, majorName string) (int, error) {
+	return c.client.User.Query().Where(directoryUserPredicates(university, majorName)...).Count(ctx)
+}
+
+func (c *institutionClient) ListUsers(ctx context.Context, university, majorName string, afterID, limit int) ([]*ent.User, error) {
+	return c.client.User.Query().
+		Where(append(directoryUserPredicates(university, majorName), user.IDGT(afterID))...).
+		WithGroup().
+		Order(user.ByID()).
+		Limit(limit).
+		All(ctx)
+}
+
+func (c *institutionClient) ApplyMapping(ctx context.Context, u *ent.User, m *DirectoryMapping) (bool, error) {
+	groupID := u.GroupUsers
+	extraStorage := int64(0)
+	if m != nil {
+		if m.GroupID != 0 {
+			groupID = m.GroupID
+		}
+		extraStorage = m.ExtraStorage
+	}
+
+	if groupID == u.GroupUsers && extraStorage == u.ExtraStorage {
+		return false, nil
+	}
+
+	if err := c.client.User.UpdateOne(u).SetGroupID(groupID).SetExtraStorage(extraStorage).Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func directoryUserPredicates(university, majorName string) []predicate.User {
+	predicates := []predicate.User{user.University(university)}
+	if majorName != "" {
+		predicates = append(predicates, user.Major(majorName))
+	}
+
+	return predicates
+}
+
+// mappingOf 专业的用户组、额外容量分别覆盖院校的设置
+func mappingOf(institutions []*ent.Institution, university, majorName string) *DirectoryMapping {
+	inst := matchInstitution(institutions, university)
+	if inst == nil {
+		return nil
+	}
+
+	res := &DirectoryMapping{GroupID: inst.GroupID, ExtraStorage: inst.ExtraStorage}
+	for _, m := range inst.Edges.Majors {
+		if DirectoryMatch(majorName, m.Name, m.Aliases) {
+			if m.GroupID != 0 {
+				res.GroupID = m.GroupID
+			}
+			if m.ExtraStorage != 0 {
+				res.ExtraStorage = m.ExtraStorage
+			}
+			break
+		}
+	}
+
+	if res.GroupID == 0 && res.ExtraStorage == 0 {
+		return nil
+	}
+
+	return res
+}
+
+func canonicalize(institutions []*ent.Institution, university, majorName string, strict bool) (string, string, error) {
+	if strings.TrimSpace(university) == "" {
+		return university, majorName, nil
+	}
+
+	inst := matchInstitution(institutions, university)
+	if inst == nil {
+		if strict {
+			return "", "", ErrInstitutionNotFound
+		}
+		return university, majorName, nil
+	}
+
+	if strings.TrimSpace(majorName) == "" {
+		return inst.Name, majorName, nil
+	}
+
+	for _, m := range inst.Edges.Majors {
+		if DirectoryMatch(majorName, m.Name, m.Aliases) {
+			return inst.Name, m.Name, nil
+		}
+	}
+
+	if strict {
+		return inst.Name, "", ErrMajorNotFound
+	}
+
+	return inst.Name, majorName, nil
+}
+
+func matchInstitution(institutions []*ent.Institution, university string) *ent.Institution {
+	for _, inst := range institutions {
+		if DirectoryMatch(university, inst.Name, inst.Aliases) {
+			return inst
+		}
+	}
+
+	return nil
+}
+
+// DirectoryMatch reports whether input matches the name or one of aliases of a directory entry.
+func DirectoryMatch(input, name string, aliases []string) bool {
+	key := directoryKey(input)
+	if key == directoryKey(name) {
+		return true
+	}
+
+	for _, alias := range aliases {
+		if key == directoryKey(alias) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directoryKey 忽略大小写与空白，"Peking  Univ." 与 "peking univ." 视为相同
+func directoryKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
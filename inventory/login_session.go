@@ -0,0 +1,114 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+)
+
+type (
+	LoginSessionClient interface {
+		TxOperator
+		// GetByRootTokenID returns the session of given refresh token family.
+		GetByRootTokenID(ctx context.Context, rootTokenID string) (*ent.LoginSession, error)
+		// Upsert creates the session of given refresh token family if not exist, otherwise
+		// updates its last used time, client info and expiry.
+		Upsert(ctx context.Context, args *LoginSessionArgs) (*ent.LoginSession, error)
+		// ListActiveByUser lists sessions of given user that are neither revoked nor expired.
+		ListActiveByUser(ctx context.Context, uid int) ([]*ent.LoginSession, error)
+		// Revoke marks sessions of given refresh token families as revoked.
+		Revoke(ctx context.Context, uid int, rootTokenIDs []string) error
+		// DeleteExpired deletes expired sessions of given user.
+		DeleteExpired(ctx context.Context, uid int) error
+	}
+
+	LoginSessionArgs struct {
+		UserID      int
+		RootTokenID string
+		IP          string
+		UserAgent   string
+		ExpiresAt   time.Time
+	}
+)
+
+func NewLoginSessionClient(client *ent.Client) LoginSessionClient {
+	return &loginSessionClient{client: client}
+}
+
+type loginSessionClient struct {
+	client *ent.Client
+}
+
+func (c *loginSessionClient) SetClient(newClient *ent.Client) TxOperator {
+	return &loginSessionClient{client: newClient}
+}
+
+func (c *loginSessionClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *loginSessionClient) GetByRootTokenID(ctx context.Context, rootTokenID string) (*ent.LoginSession, error) {
+	return c.client.LoginSession.Query().
+		Where(loginsession.RootTokenID(rootTokenID)).
+		First(ctx)
+}
+
+func (c *loginSessionClient) Upsert(ctx context.Context, args *LoginSessionArgs) (*ent.LoginSession, error) {
+	now := time.Now()
+	existing, err := c.GetByRootTokenID(ctx, args.RootTokenID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing != nil {
+		return existing.Update().
+			SetIP(args.IP).
+			SetUserAgent(args.UserAgent).
+			SetLastUsedAt(now).
+			SetExpiresAt(args.ExpiresAt).
+			Save(ctx)
+	}
+
+	return c.client.LoginSession.Create().
+		SetUserID(args.UserID).
+		SetRootTokenID(args.RootTokenID).
+		SetIP(args.IP).
+		SetUserAgent(args.UserAgent).
+		SetLastUsedAt(now).
+		SetExpiresAt(args.ExpiresAt).
+		Save(ctx)
+}
+
+func (c *loginSessionClient) ListActiveByUser(ctx context.Context, uid int) ([]*ent.LoginSession, error) {
+	return c.client.LoginSession.Query().
+		Where(
+			loginsession.UserID(uid),
+			loginsession.Revoked(false),
+			loginsession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(loginsession.FieldLastUsedAt)).
+		All(ctx)
+}
+
+func (c *loginSessionClient) Revoke(ctx context.Context, uid int, rootTokenIDs []string) error {
+	if len(rootTokenIDs) == 0 {
+		return nil
+	}
+
+	_, err := c.client.LoginSession.Update().
+		Where(loginsession.UserID(uid), loginsession.RootTokenIDIn(rootTokenIDs...)).
+		SetRevoked(true).
+		Save(ctx)
+	return err
+}
+
+func (c *loginSessionClient) DeleteExpired(ctx context.Context, uid int) error {
+	// 已撤销的会话需保留至过期，防止旧版本签发的令牌被重新登记
+	_, err := c.client.LoginSession.Delete().
+		Where(loginsession.UserID(uid), loginsession.ExpiresAtLT(time.Now())).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
@@ -243,8 +244,8 @@ func migratePublicFolder(l logging.Logger, client *ent.Client, ctx context.Conte
 		return nil
 	}
 
-	// 检查公共文件夹是否已存在
-	fileClient := NewFileClient(client)
+	// 检查公共文件夹是否已存在，Root 及 CreateFolder 不依赖数据库类型和 hasher
+	fileClient := NewFileClient(client, "", nil)
 	adminRoot, err := fileClient.Root(ctx, adminUser)
 	if err != nil {
 		// 如果管理员根文件夹不存在，跳过
@@ -255,7 +256,7 @@ func migratePublicFolder(l logging.Logger, client *ent.Client, ctx context.Conte
 	// 检查公共文件夹是否已存在
 	existingPublicFolder, err := client.File.Query().
 		Where(
-			file.ParentID(adminRoot.ID),
+			file.FileChildren(adminRoot.ID),
 			file.NameEQ(PublicFolderName),
 		).
 		First(ctx)
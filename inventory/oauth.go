@@ -0,0 +1,200 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthapp"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+)
+
+type (
+	OAuthAppClient interface {
+		TxOperator
+		// ListApps lists all registered OAuth apps.
+		ListApps(ctx context.Context) ([]*ent.OAuthApp, error)
+		// GetAppByID returns the OAuth app with given ID.
+		GetAppByID(ctx context.Context, id int) (*ent.OAuthApp, error)
+		// GetAppByClientID returns the OAuth app with given public client ID.
+		GetAppByClientID(ctx context.Context, clientID string) (*ent.OAuthApp, error)
+		// UpsertApp creates or updates an OAuth app. Client ID and secret are only set on creation.
+		UpsertApp(ctx context.Context, app *ent.OAuthApp) (*ent.OAuthApp, error)
+		// SetAppSecret updates the client secret hash of the app.
+		SetAppSecret(ctx context.Context, id int, secretHash string) error
+		// DeleteApp deletes the app and all grants issued to it.
+		DeleteApp(ctx context.Context, id int) error
+		// CreateGrant creates a new grant, expired grants of the same user and app are cleaned up.
+		CreateGrant(ctx context.Context, args *OAuthGrantArgs) (*ent.OAuthGrant, error)
+		// RotateGrant replaces token pair of the grant.
+		RotateGrant(ctx context.Context, id int, args *OAuthGrantArgs) (*ent.OAuthGrant, error)
+		// GetGrantByAccessToken returns the grant with given access token hash, with app loaded.
+		GetGrantByAccessToken(ctx context.Context, hash string) (*ent.OAuthGrant, error)
+		// GetGrantByRefreshToken returns the grant with given refresh token hash, with app loaded.
+		GetGrantByRefreshToken(ctx context.Context, hash string) (*ent.OAuthGrant, error)
+		// ListGrantsByUser lists unexpired grants of given user, with app loaded.
+		ListGrantsByUser(ctx context.Context, uid int) ([]*ent.OAuthGrant, error)
+		// DeleteGrants deletes all grants of given user issued to the app.
+		DeleteGrants(ctx context.Context, uid, appID int) error
+		// DeleteGrant deletes the grant with given ID.
+		DeleteGrant(ctx context.Context, id int) error
+		// MarkGrantUsed updates last used time of the grant.
+		MarkGrantUsed(ctx context.Context, id int) error
+	}
+
+	OAuthGrantArgs struct {
+		UserID           int
+		AppID            int
+		Scopes           []string
+		AccessTokenHash  string
+		AccessExpiresAt  time.Time
+		RefreshTokenHash string
+		RefreshExpiresAt time.Time
+	}
+)
+
+func NewOAuthAppClient(client *ent.Client) OAuthAppClient {
+	return &oauthAppClient{client: client}
+}
+
+type oauthAppClient struct {
+	client *ent.Client
+}
+
+func (c *oauthAppClient) SetClient(newClient *ent.Client) TxOperator {
+	return &oauthAppClient{client: newClient}
+}
+
+func (c *oauthAppClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *oauthAppClient) ListApps(ctx context.Context) ([]*ent.OAuthApp, error) {
+	return c.client.OAuthApp.Query().
+		Order(ent.Asc(oauthapp.FieldID)).
+		All(ctx)
+}
+
+func (c *oauthAppClient) GetAppByID(ctx context.Context, id int) (*ent.OAuthApp, error) {
+	return c.client.OAuthApp.Get(ctx, id)
+}
+
+func (c *oauthAppClient) GetAppByClientID(ctx context.Context, clientID string) (*ent.OAuthApp, error) {
+	return c.client.OAuthApp.Query().
+		Where(oauthapp.ClientID(clientID)).
+		First(ctx)
+}
+
+func (c *oauthAppClient) UpsertApp(ctx context.Context, app *ent.OAuthApp) (*ent.OAuthApp, error) {
+	if app.ID == 0 {
+		return c.client.OAuthApp.Create().
+			SetName(app.Name).
+			SetClientID(app.ClientID).
+			SetSecretHash(app.SecretHash).
+			SetRedirectUris(app.RedirectUris).
+			SetScopes(app.Scopes).
+			SetHomepage(app.Homepage).
+			SetDescription(app.Description).
+			SetEnabled(app.Enabled).
+			Save(ctx)
+	}
+
+	return c.client.OAuthApp.UpdateOneID(app.ID).
+		SetName(app.Name).
+		SetRedirectUris(app.RedirectUris).
+		SetScopes(app.Scopes).
+		SetHomepage(app.Homepage).
+		SetDescription(app.Description).
+		SetEnabled(app.Enabled).
+		Save(ctx)
+}
+
+func (c *oauthAppClient) SetAppSecret(ctx context.Context, id int, secretHash string) error {
+	return c.client.OAuthApp.UpdateOneID(id).
+		SetSecretHash(secretHash).
+		Exec(ctx)
+}
+
+func (c *oauthAppClient) DeleteApp(ctx context.Context, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.OAuthGrant.Delete().Where(oauthgrant.AppID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete grants: %w", err)
+	}
+
+	return c.client.OAuthApp.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *oauthAppClient) CreateGrant(ctx context.Context, args *OAuthGrantArgs) (*ent.OAuthGrant, error) {
+	if _, err := c.client.OAuthGrant.Delete().
+		Where(
+			oauthgrant.UserID(args.UserID),
+			oauthgrant.AppID(args.AppID),
+			oauthgrant.RefreshExpiresAtLT(time.Now()),
+		).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to delete expired grants: %w", err)
+	}
+
+	return c.client.OAuthGrant.Create().
+		SetUserID(args.UserID).
+		SetAppID(args.AppID).
+		SetScopes(args.Scopes).
+		SetAccessTokenHash(args.AccessTokenHash).
+		SetAccessExpiresAt(args.AccessExpiresAt).
+		SetRefreshTokenHash(args.RefreshTokenHash).
+		SetRefreshExpiresAt(args.RefreshExpiresAt).
+		Save(ctx)
+}
+
+func (c *oauthAppClient) RotateGrant(ctx context.Context, id int, args *OAuthGrantArgs) (*ent.OAuthGrant, error) {
+	return c.client.OAuthGrant.UpdateOneID(id).
+		SetAccessTokenHash(args.AccessTokenHash).
+		SetAccessExpiresAt(args.AccessExpiresAt).
+		SetRefreshTokenHash(args.RefreshTokenHash).
+		SetRefreshExpiresAt(args.RefreshExpiresAt).
+		Save(ctx)
+}
+
+func (c *oauthAppClient) GetGrantByAccessToken(ctx context.Context, hash string) (*ent.OAuthGrant, error) {
+	return c.client.OAuthGrant.Query().
+		Where(oauthgrant.AccessTokenHash(hash)).
+		WithApp().
+		First(ctx)
+}
+
+func (c *oauthAppClient) GetGrantByRefreshToken(ctx context.Context, hash string) (*ent.OAuthGrant, error) {
+	return c.client.OAuthGrant.Query().
+		Where(oauthgrant.RefreshTokenHash(hash)).
+		WithApp().
+		First(ctx)
+}
+
+func (c *oauthAppClient) ListGrantsByUser(ctx context.Context, uid int) ([]*ent.OAuthGrant, error) {
+	return c.client.OAuthGrant.Query().
+		Where(oauthgrant.UserID(uid), oauthgrant.RefreshExpiresAtGT(time.Now())).
+		WithApp().
+		Order(ent.Desc(oauthgrant.FieldID)).
+		All(ctx)
+}
+
+func (c *oauthAppClient) DeleteGrants(ctx context.Context, uid, appID int) error {
+	_, err := c.client.OAuthGrant.Delete().
+		Where(oauthgrant.UserID(uid), oauthgrant.AppID(appID)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *oauthAppClient) DeleteGrant(ctx context.Context, id int) error {
+	_, err := c.client.OAuthGrant.Delete().
+		Where(oauthgrant.ID(id)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *oauthAppClient) MarkGrantUsed(ctx context.Context, id int) error {
+	return c.client.OAuthGrant.UpdateOneID(id).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx)
+}
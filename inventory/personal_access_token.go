@@ -0,0 +1,89 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+)
+
+type (
+	PersonalAccessTokenClient interface {
+		TxOperator
+		// Create creates a new personal access token.
+		Create(ctx context.Context, args *PersonalAccessTokenArgs) (*ent.PersonalAccessToken, error)
+		// GetByHash returns the token with given hash, expired tokens are also returned.
+		GetByHash(ctx context.Context, hash string) (*ent.PersonalAccessToken, error)
+		// ListByUser lists tokens of given user.
+		ListByUser(ctx context.Context, uid int) ([]*ent.PersonalAccessToken, error)
+		// Delete deletes the token of given user.
+		Delete(ctx context.Context, uid, id int) error
+		// MarkUsed updates last used time and IP of the token.
+		MarkUsed(ctx context.Context, id int, ip string) error
+	}
+
+	PersonalAccessTokenArgs struct {
+		UserID    int
+		Name      string
+		TokenHash string
+		TokenHint string
+		Scopes    []string
+		ExpiresAt *time.Time
+	}
+)
+
+func NewPersonalAccessTokenClient(client *ent.Client) PersonalAccessTokenClient {
+	return &personalAccessTokenClient{client: client}
+}
+
+type personalAccessTokenClient struct {
+	client *ent.Client
+}
+
+func (c *personalAccessTokenClient) SetClient(newClient *ent.Client) TxOperator {
+	return &personalAccessTokenClient{client: newClient}
+}
+
+func (c *personalAccessTokenClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *personalAccessTokenClient) Create(ctx context.Context, args *PersonalAccessTokenArgs) (*ent.PersonalAccessToken, error) {
+	return c.client.PersonalAccessToken.Create().
+		SetUserID(args.UserID).
+		SetName(args.Name).
+		SetTokenHash(args.TokenHash).
+		SetTokenHint(args.TokenHint).
+		SetScopes(args.Scopes).
+		SetNillableExpiresAt(args.ExpiresAt).
+		Save(ctx)
+}
+
+func (c *personalAccessTokenClient) GetByHash(ctx context.Context, hash string) (*ent.PersonalAccessToken, error) {
+	return c.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.TokenHash(hash)).
+		First(ctx)
+}
+
+func (c *personalAccessTokenClient) ListByUser(ctx context.Context, uid int) ([]*ent.PersonalAccessToken, error) {
+	return c.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.UserID(uid)).
+		Order(ent.Desc(personalaccesstoken.FieldID)).
+		All(ctx)
+}
+
+func (c *personalAccessTokenClient) Delete(ctx context.Context, uid, id int) error {
+	_, err := c.client.PersonalAccessToken.Delete().
+		Where(personalaccesstoken.UserID(uid), personalaccesstoken.ID(id)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *personalAccessTokenClient) MarkUsed(ctx context.Context, id int, ip string) error {
+	return c.client.PersonalAccessToken.UpdateOneID(id).
+		SetLastUsedAt(time.Now()).
+		SetLastUsedIP(ip).
+		Exec(ctx)
+}
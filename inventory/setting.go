@@ -368,6 +368,10 @@ type MailTemplateContent struct {
 	ResetTitle  string // Translation of `重设密码`
 	ResetDes    string // Translation of `请点击下方按钮重设你的密码，此链接有效期为 1 小时。`
 	ResetButton string // Translation of `重设密码`
+
+	LockoutTitle  string // Translation of `你的账号已被暂时锁定`
+	LockoutDes    string // Translation of `我们检测到你的账号多次登录失败……`, may reference {{ .IP }} and {{ .Until }}
+	LockoutButton string // Translation of `重设密码`
 }
 
 var mailTemplateContents = []MailTemplateContent{
@@ -380,6 +384,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Reset your password",
 		ResetDes:        "Please click the button below to reset your password. This link is valid for 1 hour.",
 		ResetButton:     "Reset",
+		LockoutTitle:    "Your account has been locked",
+		LockoutDes:      "We detected repeated failed sign-in attempts to your account, the last one from {{ .IP }}. Sign-in is locked until {{ .Until }}. If this wasn't you, please reset your password with the button below. This link is valid for 1 hour.",
+		LockoutButton:   "Reset",
 	},
 	{
 		Language:        "zh-CN",
@@ -390,6 +397,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "重设密码",
 		ResetDes:        "请点击下方按钮重设你的密码，此链接有效期为 1 小时。",
 		ResetButton:     "重设密码",
+		LockoutTitle:    "你的账号已被暂时锁定",
+		LockoutDes:      "我们检测到你的账号多次登录失败，最近一次来自 {{ .IP }}，账号已被锁定至 {{ .Until }}。如非本人操作，请点击下方按钮重设密码，此链接有效期为 1 小时。",
+		LockoutButton:   "重设密码",
 	},
 	{
 		Language:        "zh-TW",
@@ -400,6 +410,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "重設密碼",
 		ResetDes:        "請點擊下方按鈕重設你的密碼，此連結有效期為 1 小時。",
 		ResetButton:     "重設密碼",
+		LockoutTitle:    "你的帳號已被暫時鎖定",
+		LockoutDes:      "我們偵測到你的帳號多次登入失敗，最近一次來自 {{ .IP }}，帳號已被鎖定至 {{ .Until }}。如非本人操作，請點擊下方按鈕重設密碼，此連結有效期為 1 小時。",
+		LockoutButton:   "重設密碼",
 	},
 	{
 		Language:        "de-DE",
@@ -410,6 +423,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Passwort zurücksetzen",
 		ResetDes:        "Bitte klicken Sie auf die Schaltfläche unten, um Ihr Passwort zurückzusetzen. Dieser Link ist 1 Stunde lang gültig.",
 		ResetButton:     "Passwort zurücksetzen",
+		LockoutTitle:    "Ihr Konto wurde gesperrt",
+		LockoutDes:      "Wir haben wiederholte fehlgeschlagene Anmeldeversuche bei Ihrem Konto festgestellt, zuletzt von {{ .IP }}. Die Anmeldung ist bis {{ .Until }} gesperrt. Falls Sie das nicht waren, setzen Sie bitte Ihr Passwort über die Schaltfläche unten zurück. Dieser Link ist 1 Stunde lang gültig.",
+		LockoutButton:   "Passwort zurücksetzen",
 	},
 	{
 		Language:        "es-ES",
@@ -420,6 +436,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Restablecer tu contraseña",
 		ResetDes:        "Por favor, haz clic en el botón de abajo para restablecer tu contraseña. Este enlace es válido por 1 hora.",
 		ResetButton:     "Restablecer",
+		LockoutTitle:    "Tu cuenta ha sido bloqueada",
+		LockoutDes:      "Hemos detectado varios intentos fallidos de inicio de sesión en tu cuenta, el último desde {{ .IP }}. El inicio de sesión está bloqueado hasta {{ .Until }}. Si no fuiste tú, restablece tu contraseña con el botón de abajo. Este enlace es válido por 1 hora.",
+		LockoutButton:   "Restablecer",
 	},
 	{
 		Language:        "fr-FR",
@@ -430,6 +449,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Réinitialiser votre mot de passe",
 		ResetDes:        "Veuillez cliquer sur le bouton ci-dessous pour réinitialiser votre mot de passe. Ce lien est valable 1 heure.",
 		ResetButton:     "Réinitialiser",
+		LockoutTitle:    "Votre compte a été verrouillé",
+		LockoutDes:      "Nous avons détecté plusieurs tentatives de connexion échouées sur votre compte, la dernière depuis {{ .IP }}. La connexion est verrouillée jusqu'au {{ .Until }}. Si ce n'était pas vous, veuillez réinitialiser votre mot de passe avec le bouton ci-dessous. Ce lien est valable 1 heure.",
+		LockoutButton:   "Réinitialiser",
 	},
 	{
 		Language:        "it-IT",
@@ -440,6 +462,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Reimposta la tua password",
 		ResetDes:        "Per favore, clicca sul pulsante qui sotto per reimpostare la tua password. Questo link è valido per 1 ora.",
 		ResetButton:     "Reimposta",
+		LockoutTitle:    "Il tuo account è stato bloccato",
+		LockoutDes:      "Abbiamo rilevato ripetuti tentativi di accesso non riusciti al tuo account, l'ultimo da {{ .IP }}. L'accesso è bloccato fino al {{ .Until }}. Se non sei stato tu, reimposta la password con il pulsante qui sotto. Questo link è valido per 1 ora.",
+		LockoutButton:   "Reimposta",
 	},
 	{
 		Language:        "ja-JP",
@@ -450,6 +475,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "パスワードをリセットする",
 		ResetDes:        "以下のボタンをクリックしてパスワードをリセットしてください。このリンクは1時間有効です。",
 		ResetButton:     "リセットする",
+		LockoutTitle:    "アカウントがロックされました",
+		LockoutDes:      "アカウントへのログイン失敗が繰り返し検出されました（最新の試行元: {{ .IP }}）。{{ .Until }} までログインがロックされています。心当たりがない場合は、以下のボタンからパスワードをリセットしてください。このリンクは1時間有効です。",
+		LockoutButton:   "リセットする",
 	},
 	{
 		Language:        "ko-KR",
@@ -460,6 +488,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "비밀번호 재설정",
 		ResetDes:        "아래 버튼을 클릭하여 비밀번호를 재설정하세요. 이 링크는 1시간 동안 유효합니다.",
 		ResetButton:     "비밀번호 재설정",
+		LockoutTitle:    "계정이 잠겼습니다",
+		LockoutDes:      "계정에 대한 로그인 실패가 반복적으로 감지되었습니다. 마지막 시도는 {{ .IP }}에서 발생했습니다. {{ .Until }}까지 로그인이 잠깁니다. 본인이 아닌 경우 아래 버튼을 클릭하여 비밀번호를 재설정하세요. 이 링크는 1시간 동안 유효합니다.",
+		LockoutButton:   "비밀번호 재설정",
 	},
 	{
 		Language:        "pt-BR",
@@ -470,6 +501,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Redefinir sua senha",
 		ResetDes:        "Por favor, clique no botão abaixo para redefinir sua senha. Este link é válido por 1 hora.",
 		ResetButton:     "Redefinir",
+		LockoutTitle:    "Sua conta foi bloqueada",
+		LockoutDes:      "Detectamos várias tentativas de login malsucedidas na sua conta, a última de {{ .IP }}. O login está bloqueado até {{ .Until }}. Se não foi você, redefina sua senha com o botão abaixo. Este link é válido por 1 hora.",
+		LockoutButton:   "Redefinir",
 	},
 	{
 		Language:        "ru-RU",
@@ -480,6 +514,9 @@ var mailTemplateContents = []MailTemplateContent{
 		ResetTitle:      "Сбросить ваш пароль",
 		ResetDes:        "Пожалуйста, нажмите кнопку ниже, чтобы сбросить ваш пароль. Эта ссылка действительна в течение 1 часа.",
 		ResetButton:     "Сбросить пароль",
+		LockoutTitle:    "Ваша учетная запись заблокирована",
+		LockoutDes:      "Мы обнаружили несколько неудачных попыток входа в вашу учетную запись, последняя — с {{ .IP }}. Вход заблокирован до {{ .Until }}. Если это были не вы, сбросьте пароль с помощью кнопки ниже. Эта ссылка действительна в течение 1 часа.",
+		LockoutButton:   "Сбросить пароль",
 	},
 }
 
@@ -497,6 +534,59 @@ var DefaultSettings = map[string]string{
 	"pwa_theme_color":                            "#000000",
 	"pwa_background_color":                       "#ffffff",
 	"register_enabled":                           `1`,
+	"institution_directory_enforced":             `0`,
+	"sms_provider":                               ``,
+	"sms_aliyun_access_key_id":                   ``,
+	"sms_aliyun_access_key_secret":               ``,
+	"sms_aliyun_sign_name":                       ``,
+	"sms_aliyun_template_code":                   ``,
+	"sms_tencent_secret_id":                      ``,
+	"sms_tencent_secret_key":                     ``,
+	"sms_tencent_sdk_app_id":                     ``,
+	"sms_tencent_sign_name":                      ``,
+	"sms_tencent_template_id":                    ``,
+	"sms_webhook_url":                            ``,
+	"sms_webhook_method":                         `POST`,
+	"sms_webhook_headers":                        `{"Content-Type":"application/json"}`,
+	"sms_webhook_body":                           `{"phone":"{phone}","code":"{code}"}`,
+	"sms_captcha":                                `0`,
+	"sms_max_attempts":                           `5`,
+	"sms_phone_daily_quota":                      `10`,
+	"sms_ip_daily_quota":                         `20`,
+	"sms_prefix_daily_quota":                     `0`,
+	"sms_prefix_length":                          `7`,
+	"sms_block_duration":                         `3600`,
+	"login_lock_account_threshold":               `10`,
+	"login_lock_ip_threshold":                    `100`,
+	"login_delay_base":                           `1`,
+	"login_delay_max":                            `30`,
+	"login_lock_duration":                        `900`,
+	"login_failure_window":                       `3600`,
+	"login_lock_notify":                          `1`,
+	"password_min_length":                        `6`,
+	"password_min_classes":                       `0`,
+	"password_history":                           `0`,
+	"password_max_age":                           `0`,
+	"password_breach_check":                      `0`,
+	"sso_providers":                              `[]`,
+	"ldap_mode":                                  ``,
+	"ldap_url":                                   ``,
+	"ldap_start_tls":                             `0`,
+	"ldap_insecure_skip_verify":                  `0`,
+	"ldap_bind_dn":                               ``,
+	"ldap_bind_password":                         ``,
+	"ldap_base_dn":                               ``,
+	"ldap_user_filter":                           `(&(objectClass=person)(sAMAccountName={username}))`,
+	"ldap_attr_subject":                          ``,
+	"ldap_attr_student_id":                       ``,
+	"ldap_attr_university":                       ``,
+	"ldap_attr_major":                            ``,
+	"ldap_attr_nick":                             `displayName`,
+	"ldap_attr_email":                            `mail`,
+	"ldap_attr_group":                            `memberOf`,
+	"ldap_group_mapping":                         `[]`,
+	"ldap_default_university":                    ``,
+	"ldap_link_by_email":                         `0`,
 	"default_group":                              `2`,
 	"fromName":                                   `Cloudreve`,
 	"mail_keepalive":                             `30`,
@@ -532,6 +622,11 @@ var DefaultSettings = map[string]string{
 	"cron_entity_collect":                        "@every 15m",
 	"cron_trash_bin_collect":                     "@every 33m",
 	"cron_oauth_cred_refresh":                    "@every 230h",
+	"cron_audit_log_collect":                     "@every 12h",
+	"audit_log_retention_days":                   `180`,
+	"oauth_code_ttl":                             "600",
+	"oauth_access_token_ttl":                     "3600",
+	"oauth_refresh_token_ttl":                    "2592000", // 30 days
 	"authn_enabled":                              "1",
 	"captcha_type":                               "normal",
 	"captcha_height":                             "60",
@@ -734,6 +829,27 @@ func init() {
 	}
 	DefaultSettings["mail_reset_template"] = string(mailResetTemplates)
 
+	// 锁定通知沿用重设密码邮件的版式，按钮指向重设密码链接
+	lockoutMails := []map[string]string{}
+	for _, langContents := range mailTemplateContents {
+		lockoutMails = append(lockoutMails, map[string]string{
+			"language": langContents.Language,
+			"title":    "[{{ .CommonContext.SiteBasic.Name }}] " + langContents.LockoutTitle,
+			"body": util.Replace(map[string]string{
+				"[[ .Language ]]":        langContents.Language,
+				"[[ .ResetTitle ]]":      langContents.LockoutTitle,
+				"[[ .ResetDes ]]":        langContents.LockoutDes,
+				"[[ .ResetButton ]]":     langContents.LockoutButton,
+				"[[ .EmailIsAutoSend ]]": langContents.EmailIsAutoSend,
+			}, defaultResetMailBody),
+		})
+	}
+	mailLockoutTemplates, err := json.Marshal(lockoutMails)
+	if err != nil {
+		panic(err)
+	}
+	DefaultSettings["mail_lockout_template"] = string(mailLockoutTemplates)
+
 	key := make([]byte, 32)
 	if _, err := io.ReadFull(rand.Reader, key); err != nil {
 		panic(err)
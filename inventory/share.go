@@ -14,6 +14,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/samber/lo"
 )
 
@@ -239,6 +240,15 @@ func IsValidShare(share *ent.Share) error {
 	return nil
 }
 
+// IsShareIPAllowed 客户端 IP 是否满足分享的网络限制
+func IsShareIPAllowed(share *ent.Share, ip string) bool {
+	if share.Props == nil {
+		return true
+	}
+
+	return util.IPAllowed(ip, share.Props.IPAllowList, share.Props.IPDenyList)
+}
+
 func IsShareExpired(share *ent.Share) error {
 	// Check if share is expired
 	if (share.Expires != nil && share.Expires.Before(time.Now())) ||
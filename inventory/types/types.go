@@ -17,6 +17,8 @@ type (
 		DisableViewSync     bool                     `json:"disable_view_sync,omitempty"`
 		FsViewMap           map[string]ExplorerView  `json:"fs_view_map,omitempty"`
 		ShareLinksInProfile ShareLinksInProfileLevel `json:"share_links_in_profile,omitempty"`
+		// SessionsRevokedAt 强制下线时间，此前签发且没有登录会话记录的刷新令牌将被拒绝
+		SessionsRevokedAt *time.Time `json:"sessions_revoked_at,omitempty"`
 	}
 
 	ShareLinksInProfileLevel string
@@ -36,6 +38,12 @@ type (
 		MaxWalkedFiles        int                    `json:"max_walked_files,omitempty"`
 		TrashRetention        int                    `json:"trash_retention,omitempty"`
 		RedirectedSource      bool                   `json:"redirected_source,omitempty"`
+		// TwoFARequired 要求组内用户启用二步验证（TOTP 或通行密钥），未启用前登录后仅能完成启用流程
+		TwoFARequired bool `json:"two_fa_required,omitempty"`
+		// IPAllowList 允许组内用户访问的 IP 或 CIDR，为空表示不限制
+		IPAllowList []string `json:"ip_allow_list,omitempty"`
+		// IPDenyList 禁止组内用户访问的 IP 或 CIDR，优先于 IPAllowList
+		IPDenyList []string `json:"ip_deny_list,omitempty"`
 	}
 
 	// PolicySetting 非公有的存储策略属性
@@ -60,6 +68,20 @@ type (
 		InternalProxy bool `json:"internal_proxy,omitempty"`
 		// OdDriver OneDrive 驱动器定位符
 		OdDriver string `json:"od_driver,omitempty"`
+		// GDriveRoot Google Drive 存储根目录 ID，为空时使用「我的云端硬盘」
+		GDriveRoot string `json:"gd_root,omitempty"`
+		// SftpProtocol 传输协议，可选 sftp（默认）、ftp、ftps
+		SftpProtocol string `json:"sftp_protocol,omitempty"`
+		// SftpPrivateKey SFTP 登录私钥，为空时使用密码登录
+		SftpPrivateKey string `json:"sftp_private_key,omitempty"`
+		// SftpHostKey SFTP 服务器公钥（authorized_keys 格式），SFTP 协议必须设置
+		SftpHostKey string `json:"sftp_host_key,omitempty"`
+		// SftpRoot 存储根目录，文件路径均相对于此目录
+		SftpRoot string `json:"sftp_root,omitempty"`
+		// SftpMaxConn 每个存储策略的最大连接数
+		SftpMaxConn int `json:"sftp_max_conn,omitempty"`
+		// WebdavRangePut 使用带 Content-Range 的 PUT 请求分片上传，需要服务端支持部分写入
+		WebdavRangePut bool `json:"webdav_range_put,omitempty"`
 		// Region 区域代码
 		Region string `json:"region,omitempty"`
 		// ServerSideEndpoint 服务端请求使用的 Endpoint，为空时使用 Policy.Server 字段
@@ -205,6 +227,24 @@ type (
 		ShareView bool `json:"share_view,omitempty"`
 		// Whether to automatically show readme file in share view
 		ShowReadMe bool `json:"show_read_me,omitempty"`
+		// IPAllowList 允许访问分享的 IP 或 CIDR，为空表示不限制
+		IPAllowList []string `json:"ip_allow_list,omitempty"`
+		// IPDenyList 禁止访问分享的 IP 或 CIDR，优先于 IPAllowList
+		IPDenyList []string `json:"ip_deny_list,omitempty"`
+	}
+
+	// AssignmentSetting 作业提交规则，与存储策略的上传限制保持一致
+	AssignmentSetting struct {
+		// MaxSize 单个文件大小上限，0 表示不限制
+		MaxSize int64 `json:"max_size,omitempty"`
+		// FileType 允许的文件扩展名
+		FileType []string `json:"file_type,omitempty"`
+		// IsFileTypeDenyList Whether above list is a deny list.
+		IsFileTypeDenyList bool `json:"is_file_type_deny_list,omitempty"`
+		// NameRegexp 文件名正则表达式
+		NameRegexp string `json:"file_regexp,omitempty"`
+		// IsNameRegexpDenyList Whether above regexp is a deny list.
+		IsNameRegexpDenyList bool `json:"is_name_regexp_deny_list,omitempty"`
 	}
 
 	FileTypeIconSetting struct {
@@ -273,16 +313,20 @@ const (
 )
 
 const (
-	PolicyTypeLocal  = "local"
-	PolicyTypeQiniu  = "qiniu"
-	PolicyTypeUpyun  = "upyun"
-	PolicyTypeOss    = "oss"
-	PolicyTypeCos    = "cos"
-	PolicyTypeS3     = "s3"
-	PolicyTypeKs3    = "ks3"
-	PolicyTypeOd     = "onedrive"
-	PolicyTypeRemote = "remote"
-	PolicyTypeObs    = "obs"
+	PolicyTypeLocal       = "local"
+	PolicyTypeQiniu       = "qiniu"
+	PolicyTypeUpyun       = "upyun"
+	PolicyTypeOss         = "oss"
+	PolicyTypeCos         = "cos"
+	PolicyTypeS3          = "s3"
+	PolicyTypeKs3         = "ks3"
+	PolicyTypeOd          = "onedrive"
+	PolicyTypeRemote      = "remote"
+	PolicyTypeObs         = "obs"
+	PolicyTypeGoogleDrive = "googledrive"
+	PolicyTypeSftp        = "sftp"
+	PolicyTypeWebdav      = "webdav"
+	PolicyTypeAzblob      = "azblob"
 )
 
 const (
@@ -363,3 +407,19 @@ const (
 const (
 	CipherAES256CTR Cipher = "aes-256-ctr"
 )
+
+// TokenScope limits what a personal access token or OAuth access token can do.
+type TokenScope string
+
+const (
+	TokenScopeFilesRead    = TokenScope("files.read")
+	TokenScopeFilesWrite   = TokenScope("files.write")
+	TokenScopeSharesManage = TokenScope("shares.manage")
+	TokenScopeAdminRead    = TokenScope("admin.read")
+	TokenScopeWorkflow     = TokenScope("workflow")
+	TokenScopeUserRead     = TokenScope("user.read")
+)
+
+// TokenScopes lists all scopes can be granted to personal access tokens and OAuth apps.
+var TokenScopes = []TokenScope{TokenScopeFilesRead, TokenScopeFilesWrite, TokenScopeSharesManage, TokenScopeAdminRead,
+	TokenScopeWorkflow, TokenScopeUserRead}
@@ -14,9 +14,14 @@ import (
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
+	"github.com/cloudreve/Cloudreve/v4/ent/externalidentity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/loginsession"
+	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/personalaccesstoken"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -24,6 +29,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/samber/lo"
 )
 
 type (
@@ -35,6 +41,9 @@ type (
 	UserIDCtx struct{}
 )
 
+// MaxPasswordHistory 保存的历史密码数量上限
+const MaxPasswordHistory = 24
+
 var (
 	ErrUserEmailExisted      = errors.New("user email has been registered")
 	ErrUserPhoneExisted      = errors.New("user phone has been registered")
@@ -53,6 +62,10 @@ type (
 		GetByEmail(ctx context.Context, email string) (*ent.User, error)
 		// GetByPhone get the user with given phone, user status is ignored.
 		GetByPhone(ctx context.Context, phone string) (*ent.User, error)
+		// GetByStudentID get the user with given student ID in given university, user status is ignored.
+		GetByStudentID(ctx context.Context, university, studentID string) (*ent.User, error)
+		// ListByStudentID list users with given student ID across all universities, user status is ignored.
+		ListByStudentID(ctx context.Context, studentID string) ([]*ent.User, error)
 		// GetByID get user by its ID, user status is ignored.
 		GetByID(ctx context.Context, id int) (*ent.User, error)
 		// GetActiveByID get user by its ID, only active user will be returned.
@@ -75,8 +88,12 @@ type (
 		UpdateAvatar(ctx context.Context, u *ent.User, avatar string) (*ent.User, error)
 		// UpdateNickname updates user nickname.
 		UpdateNickname(ctx context.Context, u *ent.User, name string) (*ent.User, error)
+		// UpdateProfile updates non-empty profile fields and group of user, used by roster import.
+		UpdateProfile(ctx context.Context, u *ent.User, args *NewUserArgs) (*ent.User, error)
 		// UpdatePassword updates user password.
 		UpdatePassword(ctx context.Context, u *ent.User, newPassword string) (*ent.User, error)
+		// UpdatePhone updates bound phone of user, empty phone will unbind it.
+		UpdatePhone(ctx context.Context, u *ent.User, phone string) (*ent.User, error)
 		// UpdateTwoFASecret updates user two factor secret.
 		UpdateTwoFASecret(ctx context.Context, u *ent.User, secret string) (*ent.User, error)
 		// ListPasskeys list user's passkeys.
@@ -87,12 +104,17 @@ type (
 		RemovePasskey(ctx context.Context, uid int, keyId string) error
 		// MarkPasskeyUsed updates passkey used at.
 		MarkPasskeyUsed(ctx context.Context, uid int, keyId string) error
+		// TwoFAEnrolled returns if the user has set up TOTP or any passkey.
+		TwoFAEnrolled(ctx context.Context, u *ent.User) (bool, error)
+		// ResetTwoFA clears user's TOTP secret and deletes all passkeys.
+		ResetTwoFA(ctx context.Context, uid int) error
 		// CountByTimeRange count users by time range. Will return all records if start or end is nil.
 		CountByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
 		// ListUsers list users with pagination.
 		ListUsers(ctx context.Context, args *ListUserParameters) (*ListUserResult, error)
-		// Upsert upserts a user.
-		Upsert(ctx context.Context, u *ent.User, password, twoFa string) (*ent.User, error)
+		// Upsert upserts a user. When updating, empty university, major and student ID are kept
+		// unchanged unless their field names are listed in clearFields.
+		Upsert(ctx context.Context, u *ent.User, password, twoFa string, clearFields ...string) (*ent.User, error)
 		// Delete deletes a user.
 		Delete(ctx context.Context, uid int) error
 		// CalculateStorage calculate user's storage from scratch and update user's storage.
@@ -100,10 +122,12 @@ type (
 	}
 	ListUserParameters struct {
 		*PaginationArgs
-		GroupID int
-		Status  user.Status
-		Nick    string
-		Email   string
+		GroupID    int
+		Status     user.Status
+		Nick       string
+		Email      string
+		StudentID  string
+		University string
 	}
 	ListUserResult struct {
 		*PaginationResults
@@ -130,8 +154,10 @@ type (
 		GroupID       int
 		Avatar        string // Optional
 		Language      string // Optional
-		University   string // Optional
-		Major        string // Optional
+		University    string // Optional
+		Major         string // Optional
+		StudentID     string // Optional
+		ExtraStorage  int64  // Optional
 	}
 	CreateStoragePackArgs struct {
 		UserID   int
@@ -156,6 +182,33 @@ func (c *userClient) UpdateAvatar(ctx context.Context, u *ent.User, avatar strin
 	return c.client.User.UpdateOne(u).SetAvatar(avatar).Save(ctx)
 }
 
+func (c *userClient) UpdateProfile(ctx context.Context, u *ent.User, args *NewUserArgs) (*ent.User, error) {
+	stm := c.client.User.UpdateOne(u)
+	if args.Nick != "" {
+		stm.SetNick(args.Nick)
+	}
+	if args.Phone != "" {
+		stm.SetPhone(args.Phone)
+	}
+	if args.University != "" {
+		stm.SetUniversity(args.University)
+	}
+	if args.Major != "" {
+		stm.SetMajor(args.Major)
+	}
+	if args.StudentID != "" {
+		stm.SetStudentID(args.StudentID)
+	}
+	if args.GroupID != 0 {
+		stm.SetGroupID(args.GroupID)
+	}
+	if args.ExtraStorage != 0 {
+		stm.SetExtraStorage(args.ExtraStorage)
+	}
+
+	return stm.Save(ctx)
+}
+
 func (c *userClient) UpdateTwoFASecret(ctx context.Context, u *ent.User, secret string) (*ent.User, error) {
 	if secret == "" {
 		return c.client.User.UpdateOne(u).ClearTwoFactorSecret().Save(ctx)
@@ -169,7 +222,23 @@ func (c *userClient) UpdatePassword(ctx context.Context, u *ent.User, newPasswor
 		return nil, err
 	}
 
-	return c.client.User.UpdateOne(u).SetPassword(digest).Save(ctx)
+	return c.client.User.UpdateOne(u).
+		SetPassword(digest).
+		SetPasswordHistory(passwordHistory(u)).
+		SetPasswordChangedAt(time.Now()).
+		Save(ctx)
+}
+
+func (c *userClient) UpdatePhone(ctx context.Context, u *ent.User, phone string) (*ent.User, error) {
+	if phone == "" {
+		return c.client.User.UpdateOne(u).ClearPhone().Save(ctx)
+	}
+
+	if existed, err := c.GetByPhone(ctx, phone); err == nil && existed.ID != u.ID {
+		return nil, ErrUserPhoneExisted
+	}
+
+	return c.client.User.UpdateOne(u).SetPhone(phone).Save(ctx)
 }
 
 func (c *userClient) SetClient(newClient *ent.Client) TxOperator {
@@ -204,6 +273,30 @@ func (c *userClient) MarkPasskeyUsed(ctx context.Context, uid int, keyId string)
 	return err
 }
 
+func (c *userClient) TwoFAEnrolled(ctx context.Context, u *ent.User) (bool, error) {
+	if u.TwoFactorSecret != "" {
+		return true, nil
+	}
+
+	if u.Edges.Passkey != nil {
+		return len(u.Edges.Passkey) > 0, nil
+	}
+
+	return c.client.Passkey.Query().Where(passkey.UserID(u.ID)).Exist(ctx)
+}
+
+func (c *userClient) ResetTwoFA(ctx context.Context, uid int) error {
+	if err := c.client.User.UpdateOneID(uid).ClearTwoFactorSecret().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear 2FA secret: %w", err)
+	}
+
+	if _, err := c.client.Passkey.Delete().Where(passkey.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete passkeys: %w", err)
+	}
+
+	return nil
+}
+
 func (c *userClient) Delete(ctx context.Context, uid int) error {
 	// Dav accounts
 	if _, err := c.client.DavAccount.Delete().Where(davaccount.OwnerID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
@@ -215,6 +308,31 @@ func (c *userClient) Delete(ctx context.Context, uid int) error {
 		return fmt.Errorf("failed to delete passkeys: %w", err)
 	}
 
+	// Course memberships
+	if _, err := c.client.CourseMember.Delete().Where(coursemember.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete course memberships: %w", err)
+	}
+
+	// External identities
+	if _, err := c.client.ExternalIdentity.Delete().Where(externalidentity.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete external identities: %w", err)
+	}
+
+	// Login sessions
+	if _, err := c.client.LoginSession.Delete().Where(loginsession.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete login sessions: %w", err)
+	}
+
+	// Personal access tokens
+	if _, err := c.client.PersonalAccessToken.Delete().Where(personalaccesstoken.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete personal access tokens: %w", err)
+	}
+
+	// OAuth grants
+	if _, err := c.client.OAuthGrant.Delete().Where(oauthgrant.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete oauth grants: %w", err)
+	}
+
 	// Tasks
 	if _, err := c.client.Task.Delete().Where(task.UserTasks(uid)).Exec(ctx); err != nil {
 		return fmt.Errorf("failed to delete tasks: %w", err)
@@ -306,7 +424,7 @@ func (c *userClient) Create(ctx context.Context, args *NewUserArgs) (*ent.User,
 			return existedUser, ErrUserEmailExisted
 		}
 	}
-	
+
 	if args.Phone != "" {
 		if existedUser, err := c.GetByPhone(ctx, args.Phone); err == nil {
 			if existedUser.Status == user.StatusInactive {
@@ -346,13 +464,19 @@ func (c *userClient) Create(ctx context.Context, args *NewUserArgs) (*ent.User,
 	if args.Major != "" {
 		query.SetMajor(args.Major)
 	}
+	if args.StudentID != "" {
+		query.SetStudentID(args.StudentID)
+	}
+	if args.ExtraStorage != 0 {
+		query.SetExtraStorage(args.ExtraStorage)
+	}
 
 	if args.PlainPassword != "" {
 		pwdDigest, err := digestPassword(args.PlainPassword)
 		if err != nil {
 			return nil, fmt.Errorf("failed to sha256 password: %w", err)
 		}
-		query.SetPassword(pwdDigest)
+		query.SetPassword(pwdDigest).SetPasswordChangedAt(time.Now())
 	}
 
 	if args.Language != "" {
@@ -384,6 +508,14 @@ func (c *userClient) GetByPhone(ctx context.Context, phone string) (*ent.User, e
 	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.PhoneEQ(phone))).First(ctx)
 }
 
+func (c *userClient) GetByStudentID(ctx context.Context, university, studentID string) (*ent.User, error) {
+	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.University(university), user.StudentID(studentID))).First(ctx)
+}
+
+func (c *userClient) ListByStudentID(ctx context.Context, studentID string) ([]*ent.User, error) {
+	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.StudentID(studentID))).All(ctx)
+}
+
 func (c *userClient) GetByID(ctx context.Context, id int) (*ent.User, error) {
 	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.ID(id))).First(ctx)
 }
@@ -434,7 +566,7 @@ func (c *userClient) SearchActive(ctx context.Context, limit int, keyword string
 	return withUserEagerLoading(
 		ctx,
 		c.client.User.Query().
-			Where(user.Or(user.EmailContainsFold(keyword), user.NickContainsFold(keyword))).
+			Where(user.Or(user.EmailContainsFold(keyword), user.NickContainsFold(keyword), user.StudentID(keyword))).
 			Limit(limit),
 	).All(ctx)
 }
@@ -491,6 +623,12 @@ func (c *userClient) ListUsers(ctx context.Context, args *ListUserParameters) (*
 	if args.Email != "" {
 		query = query.Where(user.EmailContainsFold(args.Email))
 	}
+	if args.StudentID != "" {
+		query = query.Where(user.StudentIDContainsFold(args.StudentID))
+	}
+	if args.University != "" {
+		query = query.Where(user.UniversityContainsFold(args.University))
+	}
 	query.Order(getUserOrderOption(args)...)
 
 	// Count total items
@@ -514,7 +652,7 @@ func (c *userClient) ListUsers(ctx context.Context, args *ListUserParameters) (*
 	}, nil
 }
 
-func (c *userClient) Upsert(ctx context.Context, u *ent.User, password, twoFa string) (*ent.User, error) {
+func (c *userClient) Upsert(ctx context.Context, u *ent.User, password, twoFa string, clearFields ...string) (*ent.User, error) {
 	if u.ID == 0 {
 		q := c.client.User.Create().
 			SetEmail(u.Email).
@@ -522,20 +660,32 @@ func (c *userClient) Upsert(ctx context.Context, u *ent.User, password, twoFa st
 			SetAvatar(u.Avatar).
 			SetStatus(u.Status).
 			SetGroupID(u.GroupUsers).
+			SetExtraStorage(u.ExtraStorage).
 			SetPassword(u.Password).
 			SetSettings(&types.UserSetting{})
 
+		if u.University != "" {
+			q.SetUniversity(u.University)
+		}
+		if u.Major != "" {
+			q.SetMajor(u.Major)
+		}
+		if u.StudentID != "" {
+			q.SetStudentID(u.StudentID)
+		}
+
 		if password != "" {
 			pwdDigest, err := digestPassword(password)
 			if err != nil {
 				return nil, fmt.Errorf("failed to sha256 password: %w", err)
 			}
-			q.SetPassword(pwdDigest)
+			q.SetPassword(pwdDigest).SetPasswordChangedAt(time.Now())
 		}
 
 		return q.Save(ctx)
 	}
 
+	// 额外容量由院校、专业映射维护，编辑用户时保留原值
 	q := c.client.User.UpdateOne(u).
 		SetEmail(u.Email).
 		SetNick(u.Nick).
@@ -543,12 +693,39 @@ func (c *userClient) Upsert(ctx context.Context, u *ent.User, password, twoFa st
 		SetStatus(u.Status).
 		SetGroupID(u.GroupUsers)
 
+	if u.University != "" {
+		q.SetUniversity(u.University)
+	} else if lo.Contains(clearFields, user.FieldUniversity) {
+		q.ClearUniversity()
+	}
+	if u.Major != "" {
+		q.SetMajor(u.Major)
+	} else if lo.Contains(clearFields, user.FieldMajor) {
+		q.ClearMajor()
+	}
+
+	// 清除的学号存为 NULL，避免与同院校其他用户的唯一索引冲突
+	if u.StudentID != "" {
+		q.SetStudentID(u.StudentID)
+	} else if lo.Contains(clearFields, user.FieldStudentID) {
+		q.ClearStudentID()
+	}
+
 	if password != "" {
 		pwdDigest, err := digestPassword(password)
 		if err != nil {
 			return nil, fmt.Errorf("failed to sha256 password: %w", err)
 		}
-		q.SetPassword(pwdDigest)
+
+		// 管理员提交的用户不含密码字段，从数据库读取当前密码以记录历史
+		existing, err := c.client.User.Get(ctx, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+
+		q.SetPassword(pwdDigest).
+			SetPasswordHistory(passwordHistory(existing)).
+			SetPasswordChangedAt(time.Now())
 	}
 
 	if twoFa != "" {
@@ -567,6 +744,8 @@ func getUserOrderOption(args *ListUserParameters) []user.OrderOption {
 		return []user.OrderOption{user.ByStorage(orderTerm), user.ByID(orderTerm)}
 	case user.FieldEmail:
 		return []user.OrderOption{user.ByEmail(orderTerm), user.ByID(orderTerm)}
+	case user.FieldStudentID:
+		return []user.OrderOption{user.ByStudentID(orderTerm), user.ByID(orderTerm)}
 	case user.FieldUpdatedAt:
 		return []user.OrderOption{user.ByUpdatedAt(orderTerm), user.ByID(orderTerm)}
 	default:
@@ -579,10 +758,49 @@ func IsAnonymousUser(u *ent.User) bool {
 	return u.ID == 0
 }
 
+// IsTwoFARequired 用户所在用户组是否要求启用二步验证，需预先加载用户组
+func IsTwoFARequired(u *ent.User) bool {
+	return u.Edges.Group != nil && u.Edges.Group.Settings != nil && u.Edges.Group.Settings.TwoFARequired
+}
+
+// IsIPAllowed 客户端 IP 是否满足用户所在用户组的网络限制，需预先加载用户组
+func IsIPAllowed(u *ent.User, ip string) bool {
+	if u.Edges.Group == nil || u.Edges.Group.Settings == nil {
+		return true
+	}
+
+	return util.IPAllowed(ip, u.Edges.Group.Settings.IPAllowList, u.Edges.Group.Settings.IPDenyList)
+}
+
+// PasswordReused 明文密码是否与当前密码或最近的历史密码相同，n 为检查的密码总数
+func PasswordReused(u *ent.User, password string, n int) bool {
+	digests := lo.Slice(append([]string{u.Password}, u.PasswordHistory...), 0, n)
+	for _, digest := range digests {
+		if digest != "" && checkPasswordDigest(digest, password) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// passwordHistory 返回修改密码后的历史密码摘要，即将被替换的当前密码排在最前
+func passwordHistory(u *ent.User) []string {
+	if u.Password == "" {
+		return u.PasswordHistory
+	}
+
+	return lo.Slice(append([]string{u.Password}, u.PasswordHistory...), 0, MaxPasswordHistory)
+}
+
 // CheckPassword 根据明文校验密码
 func CheckPassword(u *ent.User, password string) error {
+	return checkPasswordDigest(u.Password, password)
+}
+
+func checkPasswordDigest(digest, password string) error {
 	// 根据存储密码拆分为 Salt 和 Digest
-	passwordStore := strings.Split(u.Password, ":")
+	passwordStore := strings.Split(digest, ":")
 	if len(passwordStore) != 2 && len(passwordStore) != 3 {
 		return ErrorUnknownPasswordType
 	}
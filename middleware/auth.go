@@ -1,7 +1,9 @@
 package middleware
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -15,14 +17,31 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 const (
 	CallbackFailedStatusCode = http.StatusUnauthorized
+	// tokenMarkUsedInterval 令牌最近使用时间的最小更新间隔，避免每次请求都写入数据库
+	tokenMarkUsedInterval = time.Minute
+
+	// scopedTokenKey 请求携带的个人访问令牌或 OAuth 访问令牌，由 CurrentUser 保存，待 RequireScope 校验
+	scopedTokenKey = "scoped_token"
+	// twoFAEnrollAllowedKey 由 AllowTwoFAEnrollment 设置，表示接口允许未启用二步验证的用户访问
+	twoFAEnrollAllowedKey = "two_fa_enroll_allowed"
+	// passwordRotationAllowedKey 由 AllowPasswordRotation 设置，表示接口允许密码已过期的用户访问
+	passwordRotationAllowedKey = "password_rotation_allowed"
 )
 
+// scopedToken 尚未校验的个人访问令牌或 OAuth 访问令牌
+type scopedToken struct {
+	token   string
+	setUser func(c *gin.Context, token string) error
+}
+
 // SignRequired 验证请求签名
 func SignRequired(authInstance auth.Auth) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -48,19 +67,33 @@ func SignRequired(authInstance auth.Auth) gin.HandlerFunc {
 func CurrentUser() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		dep := dependency.FromContext(c)
-		shouldContinue, err := dep.TokenAuth().VerifyAndRetrieveUser(c)
-		if err != nil {
-			c.JSON(200, serializer.Err(c, err))
-			c.Abort()
-			return
-		}
+		header := c.GetHeader(auth.AuthorizationHeader)
+		// 个人访问令牌与 OAuth 访问令牌仅对声明了权限范围的接口生效，在 RequireScope 中校验，
+		// 此前按匿名用户处理
+		if token, ok := auth.ScopedTokenFromHeader(header, auth.PersonalAccessTokenPrefix); ok {
+			c.Set(scopedTokenKey, scopedToken{token: token, setUser: setPersonalAccessTokenUser})
+		} else if token, ok := auth.ScopedTokenFromHeader(header, auth.OAuthAccessTokenPrefix); ok {
+			c.Set(scopedTokenKey, scopedToken{token: token, setUser: setOAuthTokenUser})
+		} else {
+			shouldContinue, err := dep.TokenAuth().VerifyAndRetrieveUser(c)
+			if err != nil {
+				c.JSON(200, serializer.Err(c, err))
+				c.Abort()
+				return
+			}
 
-		if shouldContinue {
-			// TODO: Logto handler
+			if shouldContinue {
+				// TODO: Logto handler
+			}
 		}
 
 		uid := inventory.UserIDFromContext(c)
-		if err := SetUserCtx(c, uid); err != nil {
+		err := SetUserCtx(c, uid)
+		if err == nil {
+			err = checkClientIP(c)
+		}
+
+		if err != nil {
 			c.JSON(200, serializer.Err(c, err))
 			c.Abort()
 			return
@@ -70,6 +103,17 @@ func CurrentUser() gin.HandlerFunc {
 	}
 }
 
+// checkClientIP 检查客户端 IP 是否满足登录用户所在用户组的网络限制。匿名用户不受限制，
+// 以免登录接口本身被拦截。
+func checkClientIP(c *gin.Context) error {
+	u := inventory.UserFromContext(c)
+	if u == nil || inventory.IsAnonymousUser(u) || inventory.IsIPAllowed(u, c.ClientIP()) {
+		return nil
+	}
+
+	return serializer.NewError(serializer.CodeIPNotAllowed, "Access from current network is not allowed", nil)
+}
+
 // SetUserCtx set the current login user via uid
 func SetUserCtx(c *gin.Context, uid int) error {
 	dep := dependency.FromContext(c)
@@ -87,16 +131,169 @@ func SetUserCtxByUser(c *gin.Context, user *ent.User) {
 	util.WithValue(c, inventory.UserCtx{}, user)
 }
 
+// checkScopedToken 使用请求携带的个人访问令牌或 OAuth 访问令牌设置登录用户，并检查令牌是否具备 scope 权限。
+// 通过登录会话访问时不受限制。
+func checkScopedToken(c *gin.Context, scope types.TokenScope) error {
+	v, ok := c.Get(scopedTokenKey)
+	if !ok {
+		return nil
+	}
+
+	scopes, resolved := auth.TokenScopesFromContext(c)
+	if !resolved {
+		t := v.(scopedToken)
+		if err := t.setUser(c, t.token); err != nil {
+			return err
+		}
+
+		if err := checkClientIP(c); err != nil {
+			return err
+		}
+
+		scopes, _ = auth.TokenScopesFromContext(c)
+	}
+
+	if !auth.HasScope(scopes, scope) {
+		return serializer.NewError(serializer.CodeNoPermissionErr, fmt.Sprintf("Token lacks scope %q", scope), nil)
+	}
+
+	return nil
+}
+
+func setPersonalAccessTokenUser(c *gin.Context, token string) error {
+	dep := dependency.FromContext(c)
+	patClient := dep.PersonalAccessTokenClient()
+	pat, err := patClient.GetByHash(c, auth.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeCredentialInvalid, "Invalid personal access token", nil)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to get personal access token", err)
+	}
+
+	if pat.ExpiresAt != nil && pat.ExpiresAt.Before(time.Now()) {
+		return serializer.NewError(serializer.CodeCredentialInvalid, "Personal access token expired", nil)
+	}
+
+	if err := SetUserCtx(c, pat.UserID); err != nil {
+		return err
+	}
+
+	util.WithValue(c, auth.TokenScopesCtx{}, pat.Scopes)
+	if pat.LastUsedAt == nil || time.Since(*pat.LastUsedAt) > tokenMarkUsedInterval {
+		if err := patClient.MarkUsed(c, pat.ID, c.ClientIP()); err != nil {
+			dep.Logger().Warning("Failed to update last used time of personal access token %d: %s", pat.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func setOAuthTokenUser(c *gin.Context, token string) error {
+	dep := dependency.FromContext(c)
+	oauthClient := dep.OAuthAppClient()
+	grant, err := oauthClient.GetGrantByAccessToken(c, auth.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeCredentialInvalid, "Invalid access token", nil)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to get access token", err)
+	}
+
+	if grant.AccessExpiresAt.Before(time.Now()) {
+		return serializer.NewError(serializer.CodeCredentialInvalid, "Access token expired", nil)
+	}
+
+	if grant.Edges.App == nil || !grant.Edges.App.Enabled {
+		return serializer.NewError(serializer.CodeCredentialInvalid, "OAuth app is disabled", nil)
+	}
+
+	if err := SetUserCtx(c, grant.UserID); err != nil {
+		return err
+	}
+
+	// 管理员缩小应用权限范围后，已签发的令牌随之受限
+	util.WithValue(c, auth.TokenScopesCtx{}, lo.Intersect(grant.Scopes, grant.Edges.App.Scopes))
+	if grant.LastUsedAt == nil || time.Since(*grant.LastUsedAt) > tokenMarkUsedInterval {
+		if err := oauthClient.MarkGrantUsed(c, grant.ID); err != nil {
+			dep.Logger().Warning("Failed to update last used time of OAuth grant %d: %s", grant.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// RequireScope 声明接口所需的令牌权限范围，通过登录会话访问时不受限制。
+// 未声明权限范围的接口不接受令牌，因此须位于 LoginRequired 之前。
+func RequireScope(scope types.TokenScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := checkScopedToken(c, scope); err != nil {
+			c.JSON(200, serializer.Err(c, err))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // LoginRequired 需要登录
 func LoginRequired() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if u := inventory.UserFromContext(c); u != nil && !inventory.IsAnonymousUser(u) {
-			c.Next()
+		u := inventory.UserFromContext(c)
+		if u == nil || inventory.IsAnonymousUser(u) {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeCheckLogin, "Login required", nil))
+			c.Abort()
 			return
 		}
 
-		c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeCheckLogin, "Login required", nil))
-		c.Abort()
+		// 用户组要求二步验证但用户尚未启用时，仅允许访问启用二步验证所需的接口
+		if inventory.IsTwoFARequired(u) && !c.GetBool(twoFAEnrollAllowedKey) {
+			enrolled, err := dependency.FromContext(c).UserClient().TwoFAEnrolled(c, u)
+			if err != nil {
+				c.JSON(200, serializer.DBErr(c, "Failed to check 2FA enrollment", err))
+				c.Abort()
+				return
+			}
+
+			if !enrolled {
+				c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeTwoFAEnrollRequired,
+					"Your group requires two-factor authentication, please enable it first", nil))
+				c.Abort()
+				return
+			}
+		}
+
+		// 密码超过最长使用期限时，仅允许访问修改密码所需的接口
+		if !c.GetBool(passwordRotationAllowedKey) &&
+			passwordpolicy.Expired(dependency.FromContext(c).SettingProvider().PasswordPolicy(c), u) {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodePasswordExpired,
+				"Your password has expired, please change it first", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AllowTwoFAEnrollment 标记接口可在启用二步验证前访问，用于用户组要求二步验证时完成启用流程。
+// 须位于 LoginRequired 之前。
+func AllowTwoFAEnrollment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(twoFAEnrollAllowedKey, true)
+		c.Next()
+	}
+}
+
+// AllowPasswordRotation 标记接口可在密码过期后访问，用于完成修改密码流程。
+// 须位于 LoginRequired 之前。
+func AllowPasswordRotation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(passwordRotationAllowedKey, true)
+		c.Next()
 	}
 }
 
@@ -159,6 +356,13 @@ func WebDAVAuth() gin.HandlerFunc {
 			return
 		}
 
+		if !inventory.IsIPAllowed(expectedUser, c.ClientIP()) {
+			c.Status(http.StatusForbidden)
+			l.Debug("WebDAVAuth: user %q is not allowed to access from %q.", expectedUser.Email, c.ClientIP())
+			c.Abort()
+			return
+		}
+
 		// 检查是否只读
 		if expectedUser.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountReadOnly)) {
 			switch c.Request.Method {
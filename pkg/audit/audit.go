@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+type Action string
+
+const (
+	ActionLogin           = Action("login")
+	ActionLoginFailed     = Action("login_failed")
+	ActionTwoFAEnable     = Action("2fa_enable")
+	ActionTwoFADisable    = Action("2fa_disable")
+	ActionPasskeyRegister = Action("passkey_register")
+	ActionPasskeyDelete   = Action("passkey_delete")
+	ActionShareCreate     = Action("share_create")
+	ActionShareUpdate     = Action("share_update")
+	ActionShareDelete     = Action("share_delete")
+	ActionFileDelete      = Action("file_delete")
+	ActionFileRestore     = Action("file_restore")
+	ActionFileMove        = Action("file_move")
+	ActionSettingUpdate   = Action("setting_update")
+	ActionUserBan         = Action("user_ban")
+	ActionUserUnban       = Action("user_unban")
+	ActionTwoFAReset      = Action("2fa_reset")
+	ActionLoginLocked     = Action("login_locked")
+	ActionLoginUnlock     = Action("login_unlock")
+	// 泄露密码库导入与清除
+	ActionBreachedPasswordImport = Action("breached_password_import")
+	ActionBreachedPasswordClear  = Action("breached_password_clear")
+	// 迁移存储策略中的所有文件
+	ActionPolicyRelocate = Action("policy_relocate")
+)
+
+type Option func(args *inventory.AuditLogArgs)
+
+// WithUser sets the acting user, used when no user is signed in, e.g. failed logins of an existing user.
+func WithUser(uid int) Option {
+	return func(args *inventory.AuditLogArgs) {
+		args.UserID = uid
+	}
+}
+
+// WithTarget sets the object of the action.
+func WithTarget(target string) Option {
+	return func(args *inventory.AuditLogArgs) {
+		args.Target = target
+	}
+}
+
+// WithProp adds an extra property to the entry.
+func WithProp(key, value string) Option {
+	return func(args *inventory.AuditLogArgs) {
+		if args.Props == nil {
+			args.Props = make(map[string]string)
+		}
+		args.Props[key] = value
+	}
+}
+
+func init() {
+	crontab.Register(setting.CronTypeAuditLogCollect, CronCollectAuditLogs)
+}
+
+// Record writes an audit log entry of the user and request info in ctx. Failures are logged
+// and never interrupt the audited action.
+func Record(ctx context.Context, action Action, opts ...Option) {
+	args := &inventory.AuditLogArgs{Action: string(action)}
+	if u := inventory.UserFromContext(ctx); u != nil && !inventory.IsAnonymousUser(u) {
+		args.UserID = u.ID
+	}
+
+	if info := requestinfo.RequestInfoFromContext(ctx); info != nil {
+		args.IP = info.IP
+		args.UserAgent = info.UserAgent
+	}
+
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	dep := dependency.FromContext(ctx)
+	if _, err := dep.AuditLogClient().Create(context.WithoutCancel(ctx), args); err != nil {
+		logging.FromContext(ctx).Warning("Failed to record audit log %q: %s", action, err)
+	}
+}
+
+// CronCollectAuditLogs deletes audit logs older than the retention period.
+func CronCollectAuditLogs(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	retention := dep.SettingProvider().AuditLogRetention(ctx)
+	if retention <= 0 {
+		return
+	}
+
+	deleted, err := dep.AuditLogClient().DeleteBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		l.Error("Failed to collect audit logs: %s", err)
+		return
+	}
+
+	l.Info("Deleted %d expired audit logs.", deleted)
+}
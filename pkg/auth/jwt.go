@@ -11,6 +11,8 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
@@ -20,6 +22,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/samber/lo"
 )
 
 type TokenAuth interface {
@@ -32,6 +35,12 @@ type TokenAuth interface {
 	Refresh(ctx context.Context, refreshToken string) (*Token, error)
 	// Claims parses the given token string and returns the claims.
 	Claims(ctx context.Context, tokenStr string) (*Claims, error)
+	// Revoke revokes sessions of given root token IDs owned by the user, refresh tokens
+	// and access tokens of revoked sessions are rejected afterwards.
+	Revoke(ctx context.Context, uid int, rootTokenIDs ...string) error
+	// RevokeAll revokes all sessions of the user except the given root token IDs, refresh tokens
+	// issued before session persistence are also rejected afterwards.
+	RevokeAll(ctx context.Context, u *ent.User, keepRootTokenIDs ...string) error
 }
 
 // Token stores token pair for authentication
@@ -72,24 +81,26 @@ type Claims struct {
 
 // NewTokenAuth creates a new token based auth provider.
 func NewTokenAuth(idEncoder hashid.Encoder, s setting.Provider, secret []byte, userClient inventory.UserClient,
-	l logging.Logger, kv cache.Driver) TokenAuth {
+	sessionClient inventory.LoginSessionClient, l logging.Logger, kv cache.Driver) TokenAuth {
 	return &tokenAuth{
-		idEncoder:  idEncoder,
-		s:          s,
-		secret:     secret,
-		userClient: userClient,
-		l:          l,
-		kv:         kv,
+		idEncoder:     idEncoder,
+		s:             s,
+		secret:        secret,
+		userClient:    userClient,
+		sessionClient: sessionClient,
+		l:             l,
+		kv:            kv,
 	}
 }
 
 type tokenAuth struct {
-	l          logging.Logger
-	idEncoder  hashid.Encoder
-	s          setting.Provider
-	secret     []byte
-	userClient inventory.UserClient
-	kv         cache.Driver
+	l             logging.Logger
+	idEncoder     hashid.Encoder
+	s             setting.Provider
+	secret        []byte
+	userClient    inventory.UserClient
+	sessionClient inventory.LoginSessionClient
+	kv            cache.Driver
 }
 
 func (t *tokenAuth) Claims(ctx context.Context, tokenStr string) (*Claims, error) {
@@ -149,6 +160,22 @@ func (t *tokenAuth) Refresh(ctx context.Context, refreshToken string) (*Token, e
 		return nil, ErrInvalidRefreshToken
 	}
 
+	// Sessions issued before session persistence have no record, they will be created on issue.
+	session, err := t.sessionClient.GetByRootTokenID(ctx, claims.RootTokenID.String())
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get login session: %w", err)
+	}
+
+	if session != nil && (session.Revoked || session.UserID != uid) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Sessions without record cannot be revoked one by one, reject them if issued before force logout.
+	if session == nil && expectedUser.Settings != nil && expectedUser.Settings.SessionsRevokedAt != nil &&
+		(claims.NotBefore == nil || claims.NotBefore.Before(*expectedUser.Settings.SessionsRevokedAt)) {
+		return nil, ErrInvalidRefreshToken
+	}
+
 	return t.Issue(ctx, expectedUser, claims.RootTokenID)
 }
 
@@ -183,6 +210,14 @@ func (t *tokenAuth) VerifyAndRetrieveUser(c *gin.Context) (bool, error) {
 		return false, serializer.NewError(serializer.CodeNotFound, "User not found", err)
 	}
 
+	if claims.RootTokenID != nil {
+		if _, revoked := t.kv.Get(fmt.Sprintf("%s%s", RevokeTokenPrefix, claims.RootTokenID.String())); revoked {
+			return false, serializer.NewError(serializer.CodeCredentialInvalid, "Session revoked", nil)
+		}
+
+		util.WithValue(c, TokenIDContextKey{}, claims.RootTokenID.String())
+	}
+
 	util.WithValue(c, inventory.UserIDCtx{}, uid)
 	return false, nil
 }
@@ -193,13 +228,15 @@ func (t *tokenAuth) Issue(ctx context.Context, u *ent.User, rootTokenID *uuid.UU
 	issueDate := time.Now()
 	accessTokenExpired := time.Now().Add(tokenSettings.AccessTokenTTL)
 	refreshTokenExpired := time.Now().Add(tokenSettings.RefreshTokenTTL)
-	if rootTokenID == nil {
+	newSession := rootTokenID == nil
+	if newSession {
 		newRootTokenID := uuid.Must(uuid.NewV4())
 		rootTokenID = &newRootTokenID
 	}
 
 	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
-		TokenType: TokenTypeAccess,
+		TokenType:   TokenTypeAccess,
+		RootTokenID: rootTokenID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   uidEncoded,
 			NotBefore: jwt.NewNumericDate(issueDate),
@@ -225,6 +262,26 @@ func (t *tokenAuth) Issue(ctx context.Context, u *ent.User, rootTokenID *uuid.UU
 		return nil, fmt.Errorf("faield to sign refresh token: %w", err)
 	}
 
+	if newSession {
+		if err := t.sessionClient.DeleteExpired(ctx, u.ID); err != nil {
+			t.l.Warning("Failed to delete expired login sessions of user %d: %s", u.ID, err)
+		}
+	}
+
+	sessionArgs := &inventory.LoginSessionArgs{
+		UserID:      u.ID,
+		RootTokenID: rootTokenID.String(),
+		ExpiresAt:   refreshTokenExpired,
+	}
+	if info := requestinfo.RequestInfoFromContext(ctx); info != nil {
+		sessionArgs.IP = info.IP
+		sessionArgs.UserAgent = info.UserAgent
+	}
+
+	if _, err := t.sessionClient.Upsert(ctx, sessionArgs); err != nil {
+		return nil, fmt.Errorf("failed to save login session: %w", err)
+	}
+
 	return &Token{
 		AccessToken:    accessToken,
 		RefreshToken:   refreshToken,
@@ -234,8 +291,47 @@ func (t *tokenAuth) Issue(ctx context.Context, u *ent.User, rootTokenID *uuid.UU
 	}, nil
 }
 
+func (t *tokenAuth) Revoke(ctx context.Context, uid int, rootTokenIDs ...string) error {
+	if err := t.sessionClient.Revoke(ctx, uid, rootTokenIDs); err != nil {
+		return fmt.Errorf("failed to revoke login sessions: %w", err)
+	}
+
+	// Also block in KV so that access tokens of revoked sessions are rejected immediately.
+	ttl := int(t.s.TokenAuth(ctx).RefreshTokenTTL.Seconds() + 10)
+	for _, id := range rootTokenIDs {
+		t.kv.Set(fmt.Sprintf("%s%s", RevokeTokenPrefix, id), true, ttl)
+	}
+
+	return nil
+}
+
+func (t *tokenAuth) RevokeAll(ctx context.Context, u *ent.User, keepRootTokenIDs ...string) error {
+	sessions, err := t.sessionClient.ListActiveByUser(ctx, u.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list login sessions: %w", err)
+	}
+
+	ids := lo.FilterMap(sessions, func(item *ent.LoginSession, index int) (string, bool) {
+		return item.RootTokenID, !lo.Contains(keepRootTokenIDs, item.RootTokenID)
+	})
+	if err := t.Revoke(ctx, u.ID, ids...); err != nil {
+		return err
+	}
+
+	if u.Settings == nil {
+		u.Settings = &types.UserSetting{}
+	}
+	now := time.Now()
+	u.Settings.SessionsRevokedAt = &now
+	if err := t.userClient.SaveSettings(ctx, u); err != nil {
+		return fmt.Errorf("failed to save user settings: %w", err)
+	}
+
+	return nil
+}
+
 // hashUserState returns a hash string for user state for critical fields, it is used
-// to detect refresh token revocation after user changed password.
+// to detect refresh token revocation after user changed password or bound phone.
 func (t *tokenAuth) hashUserState(ctx context.Context, u *ent.User) [32]byte {
-	return sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", u.Email, u.Password, t.s.SiteBasic(ctx).ID)))
+	return sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", u.Email, u.Phone, u.Password, t.s.SiteBasic(ctx).ID)))
 }
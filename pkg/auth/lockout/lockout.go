@@ -0,0 +1,286 @@
+package lockout
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	failuresPrefix    = "login_failures_"
+	lastFailurePrefix = "login_last_failure_"
+	lockPrefix        = "login_lock_"
+	// 被锁定账号与 IP 的索引，cache.Driver 不支持按前缀列出键
+	lockIndexKey = "login_lockout_index"
+	lockIndexTTL = 7 * 24 * 3600
+)
+
+type Kind string
+
+const (
+	KindUser = Kind("user")
+	KindIP   = Kind("ip")
+)
+
+// Subject is an account or a client IP whose failed logins are tracked.
+type Subject struct {
+	Kind Kind
+	Key  string
+}
+
+// User returns the subject of given user ID.
+func User(uid int) Subject {
+	return Subject{Kind: KindUser, Key: strconv.Itoa(uid)}
+}
+
+// IP returns the subject of given client IP.
+func IP(ip string) Subject {
+	return Subject{Kind: KindIP, Key: ip}
+}
+
+func (s Subject) String() string {
+	return string(s.Kind) + ":" + s.Key
+}
+
+// Locked is an account or client IP temporarily not allowed to log in with password.
+type Locked struct {
+	Kind     Kind      `json:"kind"`
+	Key      string    `json:"key"`
+	Failures int       `json:"failures"`
+	Until    time.Time `json:"until"`
+}
+
+func (l Locked) Subject() Subject {
+	return Subject{Kind: l.Kind, Key: l.Key}
+}
+
+// Failures is the consecutive failed attempts of a subject.
+type Failures struct {
+	Count int
+	Last  time.Time
+}
+
+// 同一进程内串行化锁定索引的读改写，失败次数由缓存驱动原子递增
+var mu sync.Mutex
+
+func init() {
+	gob.Register(Locked{})
+	gob.Register(time.Time{})
+	gob.Register(map[string]int64{})
+}
+
+// Limiter applies progressive delays and temporary lockouts to failed password logins.
+type Limiter struct {
+	kv    cache.Driver
+	limit *setting.LoginLimit
+}
+
+func NewLimiter(kv cache.Driver, limit *setting.LoginLimit) *Limiter {
+	return &Limiter{kv: kv, limit: limit}
+}
+
+// Check returns an error if any of the subjects is locked, or should wait before next attempt.
+func (l *Limiter) Check(subjects ...Subject) error {
+	now := time.Now()
+	for _, s := range subjects {
+		if locked := getLock(l.kv, s); locked != nil {
+			return serializer.NewError(serializer.CodeLoginLocked,
+				fmt.Sprintf("登录失败次数过多，请于 %s 后重试", locked.Until.Format("15:04")), nil)
+		}
+
+		if wait := l.wait(s, now); wait > 0 {
+			return serializer.NewError(serializer.CodeLoginThrottled,
+				fmt.Sprintf("登录尝试过于频繁，请 %d 秒后重试", int(math.Ceil(wait.Seconds()))), nil)
+		}
+	}
+
+	return nil
+}
+
+// Fail records a failed attempt of given subjects, returns subjects locked by this attempt.
+func (l *Limiter) Fail(subjects ...Subject) []Locked {
+	now := time.Now()
+	var res []Locked
+	for _, s := range subjects {
+		threshold := l.threshold(s.Kind)
+		if threshold <= 0 && (s.Kind != KindUser || l.limit.DelayBase <= 0) {
+			continue
+		}
+
+		n := l.incr(s, now)
+		if threshold <= 0 || n < threshold || l.limit.LockDuration <= 0 {
+			continue
+		}
+
+		locked := Locked{Kind: s.Kind, Key: s.Key, Failures: n, Until: now.Add(l.limit.LockDuration)}
+		if err := lock(l.kv, locked); err != nil {
+			continue
+		}
+
+		l.Succeed(s)
+		res = append(res, locked)
+	}
+
+	return res
+}
+
+// Succeed resets failed attempts of given subjects.
+func (l *Limiter) Succeed(subjects ...Subject) {
+	for _, s := range subjects {
+		_ = l.kv.Delete("", failuresPrefix+s.String(), lastFailurePrefix+s.String())
+	}
+}
+
+// wait returns how long the subject should wait before next attempt. Only accounts are delayed,
+// users behind a shared NAT would otherwise be delayed by each other.
+func (l *Limiter) wait(s Subject, now time.Time) time.Duration {
+	if s.Kind != KindUser || l.limit.DelayBase <= 0 {
+		return 0
+	}
+
+	f := getFailures(l.kv, s)
+	if f.Count == 0 {
+		return 0
+	}
+
+	delay := l.limit.DelayBase
+	for i := 1; i < f.Count; i++ {
+		delay *= 2
+		if l.limit.DelayMax > 0 && delay >= l.limit.DelayMax {
+			delay = l.limit.DelayMax
+			break
+		}
+	}
+
+	return f.Last.Add(delay).Sub(now)
+}
+
+func (l *Limiter) threshold(kind Kind) int {
+	if kind == KindUser {
+		return l.limit.AccountThreshold
+	}
+
+	return l.limit.IPThreshold
+}
+
+// incr increases failed attempts of the subject and returns the new count. Attempts are
+// forgotten after the window since the last failure.
+func (l *Limiter) incr(s Subject, now time.Time) int {
+	ttl := int(l.limit.Window.Seconds())
+	if ttl <= 0 {
+		ttl = lockIndexTTL
+	}
+
+	n, err := l.kv.Incr(failuresPrefix+s.String(), ttl)
+	if err != nil {
+		// 无法记录失败次数时按达到阈值处理
+		return math.MaxInt32
+	}
+
+	_ = l.kv.Set(lastFailurePrefix+s.String(), now, ttl)
+	return n
+}
+
+// ListLocked returns all accounts and client IPs currently locked.
+func ListLocked(kv cache.Driver) []Locked {
+	mu.Lock()
+	defer mu.Unlock()
+
+	index := lockIndex(kv)
+	res := make([]Locked, 0, len(index))
+	for key := range index {
+		if v, ok := kv.Get(lockPrefix + key); ok {
+			if locked, ok := v.(Locked); ok && time.Now().Before(locked.Until) {
+				res = append(res, locked)
+				continue
+			}
+		}
+
+		delete(index, key)
+	}
+	_ = kv.Set(lockIndexKey, index, lockIndexTTL)
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Until.After(res[j].Until)
+	})
+	return res
+}
+
+// Unlock removes the lock and resets failed attempts of given subject.
+func Unlock(kv cache.Driver, s Subject) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	index := lockIndex(kv)
+	delete(index, s.String())
+	if err := kv.Set(lockIndexKey, index, lockIndexTTL); err != nil {
+		return err
+	}
+
+	return kv.Delete("", lockPrefix+s.String(), failuresPrefix+s.String(), lastFailurePrefix+s.String())
+}
+
+func getLock(kv cache.Driver, s Subject) *Locked {
+	v, ok := kv.Get(lockPrefix + s.String())
+	if !ok {
+		return nil
+	}
+
+	locked, ok := v.(Locked)
+	if !ok || time.Now().After(locked.Until) {
+		return nil
+	}
+
+	return &locked
+}
+
+func getFailures(kv cache.Driver, s Subject) Failures {
+	var f Failures
+	if v, ok := kv.Get(failuresPrefix + s.String()); ok {
+		f.Count, _ = v.(int)
+	}
+	if v, ok := kv.Get(lastFailurePrefix + s.String()); ok {
+		f.Last, _ = v.(time.Time)
+	}
+
+	return f
+}
+
+func lock(kv cache.Driver, locked Locked) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := locked.Subject().String()
+	ttl := int(time.Until(locked.Until).Seconds()) + 1
+	if err := kv.Set(lockPrefix+key, locked, ttl); err != nil {
+		return err
+	}
+
+	index := lockIndex(kv)
+	index[key] = locked.Until.Unix()
+	return kv.Set(lockIndexKey, index, lockIndexTTL)
+}
+
+func lockIndex(kv cache.Driver) map[string]int64 {
+	res := make(map[string]int64)
+	if v, ok := kv.Get(lockIndexKey); ok {
+		if index, ok := v.(map[string]int64); ok {
+			now := time.Now().Unix()
+			for key, until := range index {
+				if until > now {
+					res[key] = until
+				}
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,78 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimiter(limit *setting.LoginLimit) (*Limiter, cache.Driver) {
+	kv := cache.NewMemoStore("", logging.NewConsoleLogger(logging.LevelError))
+	return NewLimiter(kv, limit), kv
+}
+
+func TestLimiter_AccountLockout(t *testing.T) {
+	asserts := assert.New(t)
+	l, kv := newTestLimiter(&setting.LoginLimit{AccountThreshold: 3, LockDuration: time.Hour, Window: time.Hour})
+
+	asserts.NoError(l.Check(User(1)))
+	asserts.Empty(l.Fail(User(1)))
+	asserts.Empty(l.Fail(User(1)))
+	asserts.NoError(l.Check(User(1)))
+
+	locked := l.Fail(User(1))
+	asserts.Len(locked, 1)
+	asserts.Equal(KindUser, locked[0].Kind)
+	asserts.Equal("1", locked[0].Key)
+	asserts.Error(l.Check(User(1)))
+	asserts.NoError(l.Check(User(2)))
+	asserts.Len(ListLocked(kv), 1)
+
+	asserts.NoError(Unlock(kv, User(1)))
+	asserts.NoError(l.Check(User(1)))
+	asserts.Empty(ListLocked(kv))
+
+	// Failures are counted again from zero after unlock
+	asserts.Empty(l.Fail(User(1)))
+}
+
+func TestLimiter_Delay(t *testing.T) {
+	asserts := assert.New(t)
+	l, kv := newTestLimiter(&setting.LoginLimit{DelayBase: time.Second, DelayMax: 4 * time.Second, Window: time.Hour})
+
+	l.Fail(User(1))
+	asserts.Error(l.Check(User(1)))
+	asserts.InDelta(time.Second, l.wait(User(1), time.Now()), float64(100*time.Millisecond))
+
+	l.Fail(User(1))
+	l.Fail(User(1))
+	l.Fail(User(1))
+	asserts.InDelta(4*time.Second, l.wait(User(1), time.Now()), float64(100*time.Millisecond))
+
+	// Client IPs are never delayed, accounts are never locked without threshold
+	l.Fail(IP("1.1.1.1"))
+	asserts.NoError(l.Check(IP("1.1.1.1")))
+	asserts.Empty(ListLocked(kv))
+
+	l.Succeed(User(1))
+	asserts.NoError(l.Check(User(1)))
+}
+
+func TestLimiter_IPLockout(t *testing.T) {
+	asserts := assert.New(t)
+	l, kv := newTestLimiter(&setting.LoginLimit{AccountThreshold: 5, IPThreshold: 2, LockDuration: time.Hour, Window: time.Hour})
+
+	asserts.Empty(l.Fail(IP("1.1.1.1"), User(1)))
+	locked := l.Fail(IP("1.1.1.1"), User(2))
+	asserts.Len(locked, 1)
+	asserts.Equal(KindIP, locked[0].Kind)
+	asserts.Error(l.Check(IP("1.1.1.1")))
+	asserts.NoError(l.Check(IP("1.1.1.2"), User(1)))
+
+	asserts.NoError(Unlock(kv, IP("1.1.1.1")))
+	asserts.NoError(l.Check(IP("1.1.1.1")))
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// OAuthAccessTokenPrefix distinguishes OAuth access tokens from other tokens in Authorization header.
+	OAuthAccessTokenPrefix  = "croat_"
+	OAuthRefreshTokenPrefix = "crort_"
+	oauthClientSecretPrefix = "crocs_"
+
+	// PKCEMethodS256 is the only supported code challenge method, "plain" is not accepted.
+	PKCEMethodS256 = "S256"
+)
+
+// NewOAuthTokenPair generates a new pair of OAuth access token and refresh token, returns
+// plain tokens and their hashes.
+func NewOAuthTokenPair() (accessToken, accessHash, refreshToken, refreshHash string, err error) {
+	accessToken, accessHash, err = newOpaqueToken(OAuthAccessTokenPrefix)
+	if err != nil {
+		return
+	}
+
+	refreshToken, refreshHash, err = newOpaqueToken(OAuthRefreshTokenPrefix)
+	return
+}
+
+// NewOAuthAuthorizationCode generates a new one-time authorization code.
+func NewOAuthAuthorizationCode() (string, error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate authorization code: %w", err)
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// NewOAuthClientSecret generates a new client secret for confidential OAuth clients.
+func NewOAuthClientSecret() (string, string, error) {
+	return newOpaqueToken(oauthClientSecretPrefix)
+}
+
+// VerifyPKCE checks the code verifier against code challenge using S256 method defined in RFC 7636.
+func VerifyPKCE(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// VerifyTokenHash checks the plain token against stored hash in constant time.
+func VerifyTokenHash(token, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashToken(token)), []byte(hash)) == 1
+}
@@ -0,0 +1,199 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+// BreachedFile is the list of breached password hashes uploaded by admin, relative to data folder.
+const BreachedFile = "breached_passwords.txt"
+
+//go:embed breached.txt
+var bundledBreached string
+
+type hashSet map[[sha1.Size]byte]struct{}
+
+// BreachList is a set of SHA-1 hashes of breached passwords, composed of a bundled list of the most
+// common passwords and an optional list uploaded by admin. The uploaded list is reloaded when changed.
+type BreachList struct {
+	mu       sync.RWMutex
+	bundled  hashSet
+	uploaded hashSet
+	modTime  time.Time
+}
+
+// BreachListStats describes the loaded breach lists.
+type BreachListStats struct {
+	Bundled   int        `json:"bundled"`
+	Uploaded  int        `json:"uploaded"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+var (
+	defaultBreachList     *BreachList
+	defaultBreachListOnce sync.Once
+)
+
+// DefaultBreachList returns the breach list shared by the process.
+func DefaultBreachList() *BreachList {
+	defaultBreachListOnce.Do(func() {
+		bundled, _ := parseHashes(strings.NewReader(bundledBreached))
+		defaultBreachList = &BreachList{bundled: bundled}
+	})
+
+	return defaultBreachList
+}
+
+// Contains returns true if the password is in either bundled or uploaded list.
+func (b *BreachList) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	if _, ok := b.bundled[sum]; ok {
+		return true
+	}
+
+	b.reload()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, ok := b.uploaded[sum]
+	return ok
+}
+
+// Stats returns the number of hashes loaded from each list.
+func (b *BreachList) Stats() BreachListStats {
+	b.reload()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	res := BreachListStats{Bundled: len(b.bundled), Uploaded: len(b.uploaded)}
+	if !b.modTime.IsZero() {
+		updatedAt := b.modTime
+		res.UpdatedAt = &updatedAt
+	}
+
+	return res
+}
+
+// Import validates and replaces the uploaded list with hashes read from r. Each line is an uppercase or
+// lowercase SHA-1 hex digest, optionally followed by ":count" as in the "Have I Been Pwned" dump.
+// Returns the number of distinct hashes imported.
+func (b *BreachList) Import(r io.Reader) (int, error) {
+	hashes, err := parseHashes(r)
+	if err != nil {
+		return 0, err
+	}
+
+	path := util.DataPath(BreachedFile)
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	w := bufio.NewWriter(f)
+	for sum := range hashes {
+		w.WriteString(strings.ToUpper(hex.EncodeToString(sum[:])))
+		w.WriteByte('\n')
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return 0, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	f.Close()
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return 0, fmt.Errorf("failed to replace file: %w", err)
+	}
+
+	b.reload()
+	return len(hashes), nil
+}
+
+// Clear removes the uploaded list.
+func (b *BreachList) Clear() error {
+	if err := os.Remove(util.DataPath(BreachedFile)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	b.reload()
+	return nil
+}
+
+// reload loads the uploaded list again if the file is modified, removed or created since last load.
+func (b *BreachList) reload() {
+	var modTime time.Time
+	info, err := os.Stat(util.DataPath(BreachedFile))
+	if err == nil {
+		modTime = info.ModTime()
+	}
+
+	b.mu.RLock()
+	unchanged := modTime.Equal(b.modTime)
+	b.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// 文件无法解析时同样记录修改时间，避免每次检查都重新读取
+	b.uploaded, b.modTime = nil, modTime
+	if modTime.IsZero() {
+		return
+	}
+
+	f, err := os.Open(util.DataPath(BreachedFile))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	if hashes, err := parseHashes(f); err == nil {
+		b.uploaded = hashes
+	}
+}
+
+// parseHashes reads one SHA-1 hex digest per line, blank lines and lines starting with "#" are skipped.
+func parseHashes(r io.Reader) (hashSet, error) {
+	res := make(hashSet)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		digest, _, _ := strings.Cut(text, ":")
+		var sum [sha1.Size]byte
+		if len(digest) != sha1.Size*2 {
+			return nil, fmt.Errorf("line %d is not a valid SHA-1 hash", line)
+		}
+
+		if _, err := hex.Decode(sum[:], []byte(digest)); err != nil {
+			return nil, fmt.Errorf("line %d is not a valid SHA-1 hash", line)
+		}
+
+		res[sum] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,139 @@
+package passwordpolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+// Rules a password can violate, used by clients as keys of localized messages.
+const (
+	RuleMinLength  = "min_length"
+	RuleMinClasses = "min_classes"
+	RuleReused     = "reused"
+	RuleBreached   = "breached"
+)
+
+// Violation is a rule the password does not satisfy. Param is the threshold of the rule, if any.
+type Violation struct {
+	Rule    string `json:"rule"`
+	Param   int    `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// Violations is all rules the password does not satisfy, returned as response data.
+type Violations []Violation
+
+func (v Violations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Message)
+	}
+
+	return strings.Join(msgs, "；")
+}
+
+func (v Violations) Details() any {
+	return v
+}
+
+// Checker validates new passwords against the password policy.
+type Checker struct {
+	policy   *setting.PasswordPolicy
+	breached *BreachList
+}
+
+func NewChecker(policy *setting.PasswordPolicy) *Checker {
+	return &Checker{policy: policy, breached: DefaultBreachList()}
+}
+
+// Check returns a serializer error with all violations as details if the password does not satisfy
+// the policy. u is the user whose password is being changed, or nil for new users, in which case
+// the reuse rule is skipped.
+func (c *Checker) Check(password string, u *ent.User) error {
+	var res Violations
+	if c.policy.MinLength > 0 && utf8.RuneCountInString(password) < c.policy.MinLength {
+		res = append(res, Violation{
+			Rule:    RuleMinLength,
+			Param:   c.policy.MinLength,
+			Message: fmt.Sprintf("密码长度不能少于 %d 位", c.policy.MinLength),
+		})
+	}
+
+	if c.policy.MinClasses > 0 && charClasses(password) < c.policy.MinClasses {
+		res = append(res, Violation{
+			Rule:    RuleMinClasses,
+			Param:   c.policy.MinClasses,
+			Message: fmt.Sprintf("密码需包含小写字母、大写字母、数字、符号中的至少 %d 类", c.policy.MinClasses),
+		})
+	}
+
+	if u != nil && c.policy.History > 0 && inventory.PasswordReused(u, password, c.policy.History) {
+		res = append(res, Violation{
+			Rule:    RuleReused,
+			Param:   c.policy.History,
+			Message: fmt.Sprintf("不能使用最近 %d 次使用过的密码", c.policy.History),
+		})
+	}
+
+	if c.policy.BreachCheck && c.breached.Contains(password) {
+		res = append(res, Violation{
+			Rule:    RuleBreached,
+			Message: "该密码已出现在公开泄露的密码库中，请更换",
+		})
+	}
+
+	if len(res) > 0 {
+		return serializer.NewError(serializer.CodePasswordPolicyViolation, res.Error(), res)
+	}
+
+	return nil
+}
+
+// Expired returns true if the password of u is older than the max age of the policy. Users without
+// a password are never expired; passwords set before the change time was tracked count from registration.
+func Expired(policy *setting.PasswordPolicy, u *ent.User) bool {
+	if policy.MaxAge <= 0 || u.Password == "" {
+		return false
+	}
+
+	changedAt := u.CreatedAt
+	if u.PasswordChangedAt != nil {
+		changedAt = *u.PasswordChangedAt
+	}
+
+	return time.Since(changedAt) > policy.MaxAge
+}
+
+// charClasses returns the number of character classes used in the password.
+func charClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	n := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,102 @@
+package passwordpolicy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+func digest(password, salt string) string {
+	sum := sha256.Sum256([]byte(password + salt))
+	return salt + ":" + hex.EncodeToString(sum[:])
+}
+
+func violations(err error) []string {
+	var v Violations
+	if !errors.As(err, &v) {
+		return nil
+	}
+
+	res := make([]string, 0, len(v))
+	for _, violation := range v {
+		res = append(res, violation.Rule)
+	}
+	return res
+}
+
+func TestChecker_Check(t *testing.T) {
+	asserts := assert.New(t)
+
+	c := NewChecker(&setting.PasswordPolicy{MinLength: 8, MinClasses: 3, BreachCheck: true})
+	asserts.NoError(c.Check("Correct-horse-9", nil))
+
+	err := c.Check("abc", nil)
+	var appErr serializer.AppError
+	asserts.True(errors.As(err, &appErr))
+	asserts.Equal(serializer.CodePasswordPolicyViolation, appErr.Code)
+	asserts.Equal([]string{RuleMinLength, RuleMinClasses}, violations(err))
+
+	asserts.Equal([]string{RuleMinClasses, RuleBreached}, violations(c.Check("password123", nil)))
+
+	// 多字节字符按字符计算长度
+	c = NewChecker(&setting.PasswordPolicy{MinLength: 4})
+	asserts.NoError(c.Check("密码测试", nil))
+	asserts.Equal([]string{RuleMinLength}, violations(c.Check("密码", nil)))
+
+	// 未启用泄露检查
+	asserts.NoError(c.Check("123456", nil))
+}
+
+func TestChecker_Reused(t *testing.T) {
+	asserts := assert.New(t)
+
+	u := &ent.User{
+		Password:        digest("current", "salt0"),
+		PasswordHistory: []string{digest("previous", "salt1"), digest("oldest", "salt2")},
+	}
+
+	c := NewChecker(&setting.PasswordPolicy{History: 2})
+	asserts.Equal([]string{RuleReused}, violations(c.Check("current", u)))
+	asserts.Equal([]string{RuleReused}, violations(c.Check("previous", u)))
+	asserts.NoError(c.Check("oldest", u))
+	asserts.NoError(c.Check("previous", nil))
+}
+
+func TestExpired(t *testing.T) {
+	asserts := assert.New(t)
+
+	changed := time.Now().Add(-48 * time.Hour)
+	u := &ent.User{Password: "salt:digest", CreatedAt: time.Now().Add(-72 * time.Hour), PasswordChangedAt: &changed}
+
+	asserts.False(Expired(&setting.PasswordPolicy{}, u))
+	asserts.True(Expired(&setting.PasswordPolicy{MaxAge: 24 * time.Hour}, u))
+	asserts.False(Expired(&setting.PasswordPolicy{MaxAge: 60 * time.Hour}, u))
+
+	u.PasswordChangedAt = nil
+	asserts.True(Expired(&setting.PasswordPolicy{MaxAge: 60 * time.Hour}, u))
+
+	u.Password = ""
+	asserts.False(Expired(&setting.PasswordPolicy{MaxAge: time.Hour}, u))
+}
+
+func TestParseHashes(t *testing.T) {
+	asserts := assert.New(t)
+
+	hashes, err := parseHashes(strings.NewReader("# comment\n\n7C4A8D09CA3762AF61E59520943DC26494F8941B:24230577\nf7c3bc1d808e04732adf679965ccc34ca7ae3441\n"))
+	asserts.NoError(err)
+	asserts.Len(hashes, 2)
+
+	_, err = parseHashes(strings.NewReader("7C4A8D09CA3762AF61E59520943DC26494F8941B\nnot-a-hash\n"))
+	asserts.EqualError(err, "line 2 is not a valid SHA-1 hash")
+
+	asserts.True(DefaultBreachList().Contains("123456"))
+	asserts.False(DefaultBreachList().Contains("Correct-horse-9"))
+}
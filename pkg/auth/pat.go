@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+)
+
+const (
+	// PersonalAccessTokenPrefix distinguishes personal access tokens from JWT in Authorization header.
+	PersonalAccessTokenPrefix = "crpat_"
+	opaqueTokenBytes          = 32
+	personalAccessTokenHint   = 4
+)
+
+// TokenScopesCtx context key of scopes granted to the scoped token (personal access token or
+// OAuth access token) used by current request.
+type TokenScopesCtx struct{}
+
+// NewPersonalAccessToken generates a new personal access token, returns the plain token and its hash.
+func NewPersonalAccessToken() (string, string, error) {
+	return newOpaqueToken(PersonalAccessTokenPrefix)
+}
+
+// newOpaqueToken generates a random token with given prefix, returns the plain token and its hash.
+func newOpaqueToken(prefix string) (string, string, error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := prefix + hex.EncodeToString(buf)
+	return token, HashToken(token), nil
+}
+
+// HashToken returns the hash of an opaque token stored in DB. Tokens are random enough
+// so that a plain SHA-256 digest is sufficient.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonalAccessTokenHint returns the last few characters of token, used to recognize the token in list.
+func PersonalAccessTokenHint(token string) string {
+	if len(token) <= personalAccessTokenHint {
+		return token
+	}
+
+	return token[len(token)-personalAccessTokenHint:]
+}
+
+// ScopedTokenFromHeader extracts the opaque token with given prefix from value of Authorization header.
+func ScopedTokenFromHeader(header, prefix string) (string, bool) {
+	token := strings.TrimPrefix(header, TokenHeaderPrefix)
+	if token == header || !strings.HasPrefix(token, prefix) {
+		return "", false
+	}
+
+	return token, true
+}
+
+// TokenScopesFromContext returns scopes granted to current request, ok is false if the request
+// is not authenticated by a scoped token and thus not limited.
+func TokenScopesFromContext(ctx context.Context) (scopes []string, ok bool) {
+	scopes, ok = ctx.Value(TokenScopesCtx{}).([]string)
+	return
+}
+
+// IsValidScope returns if the scope can be granted.
+func IsValidScope(scope string) bool {
+	return lo.Contains(types.TokenScopes, types.TokenScope(scope))
+}
+
+// HasScope returns if given scope is granted.
+func HasScope(scopes []string, scope types.TokenScope) bool {
+	return lo.Contains(scopes, string(scope))
+}
@@ -25,6 +25,17 @@ type Driver interface {
 	// Delete values by [Prefix + key]. If no ket is presented, all keys with given prefix will be deleted.
 	Delete(prefix string, keys ...string) error
 
+	// Incr atomically increases the counter of key by one and returns the new value, ttl is reset on
+	// each increment. Current value of the counter can be read by Get as int.
+	Incr(key string, ttl int) (int, error)
+
+	// Decr atomically decreases the counter of key by one and returns the new value, ttl is kept.
+	// Expired or missing counter is not created.
+	Decr(key string) (int, error)
+
+	// SetNX sets value of key only if the key does not exist, returns whether the value is set.
+	SetNX(key string, value any, ttl int) (bool, error)
+
 	// Save in-memory cache to disk
 	Persist(path string) error
 
@@ -136,6 +136,73 @@ func (store *MemoStore) Delete(prefix string, keys ...string) error {
 	return nil
 }
 
+// Incr 原子地增加计数器
+func (store *MemoStore) Incr(key string, ttl int) (int, error) {
+	for {
+		old, loaded := store.Store.Load(key)
+		n := 1
+		if v, ok := getValue(old, loaded); ok {
+			if current, ok := v.(int); ok {
+				n = current + 1
+			}
+		}
+
+		if !loaded {
+			if _, loaded := store.Store.LoadOrStore(key, newItem(n, ttl)); !loaded {
+				return n, nil
+			}
+			continue
+		}
+
+		if store.Store.CompareAndSwap(key, old, newItem(n, ttl)) {
+			return n, nil
+		}
+	}
+}
+
+// Decr 原子地减少计数器，不改变过期时间
+func (store *MemoStore) Decr(key string) (int, error) {
+	for {
+		old, loaded := store.Store.Load(key)
+		v, ok := getValue(old, loaded)
+		if !ok {
+			return 0, nil
+		}
+
+		current, _ := v.(int)
+		expires := int64(0)
+		if item, ok := old.(itemWithTTL); ok {
+			expires = item.Expires
+		}
+
+		if store.Store.CompareAndSwap(key, old, itemWithTTL{Value: current - 1, Expires: expires}) {
+			return current - 1, nil
+		}
+	}
+}
+
+// SetNX 仅在键不存在时存储值
+func (store *MemoStore) SetNX(key string, value any, ttl int) (bool, error) {
+	for {
+		old, loaded := store.Store.Load(key)
+		if _, ok := getValue(old, loaded); ok {
+			return false, nil
+		}
+
+		if !loaded {
+			if _, loaded := store.Store.LoadOrStore(key, newItem(value, ttl)); !loaded {
+				return true, nil
+			}
+			continue
+		}
+
+		// 已过期的值视为不存在
+		if store.Store.CompareAndSwap(key, old, newItem(value, ttl)) {
+			return true, nil
+		}
+	}
+}
+
 // Persist write memory store into cache
 func (store *MemoStore) Persist(path string) error {
 	persisted := make(map[string]itemWithTTL)
@@ -121,6 +121,10 @@ func (store *RedisStore) Get(key string) (any, bool) {
 
 	finalValue, err := deserializer(v)
 	if err != nil {
+		// 由 Incr 写入的计数器以整数形式存储
+		if n, err := strconv.Atoi(string(v)); err == nil {
+			return n, true
+		}
 		return nil, false
 	}
 
@@ -221,6 +225,76 @@ func (store *RedisStore) Delete(prefix string, keys ...string) error {
 	return nil
 }
 
+// Incr 原子地增加计数器并重置过期时间
+func (store *RedisStore) Incr(key string, ttl int) (int, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+	if rc.Err() != nil {
+		return 0, rc.Err()
+	}
+
+	if err := rc.Send("MULTI"); err != nil {
+		return 0, err
+	}
+	if err := rc.Send("INCR", key); err != nil {
+		return 0, err
+	}
+	if ttl > 0 {
+		if err := rc.Send("EXPIRE", key, ttl); err != nil {
+			return 0, err
+		}
+	}
+
+	values, err := redis.Values(rc.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+
+	return redis.Int(values[0], nil)
+}
+
+// decrScript 仅在计数器存在时减少，避免计数器过期后被重新创建为不过期的负数
+var decrScript = redis.NewScript(1, `if redis.call("EXISTS", KEYS[1]) == 1 then return redis.call("DECR", KEYS[1]) end return 0`)
+
+// Decr 原子地减少计数器，不改变过期时间
+func (store *RedisStore) Decr(key string) (int, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+	if rc.Err() != nil {
+		return 0, rc.Err()
+	}
+
+	return redis.Int(decrScript.Do(rc, key))
+}
+
+// SetNX 仅在键不存在时存储值
+func (store *RedisStore) SetNX(key string, value any, ttl int) (bool, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+
+	serialized, err := serializer(value)
+	if err != nil {
+		return false, err
+	}
+
+	if rc.Err() != nil {
+		return false, rc.Err()
+	}
+
+	var reply any
+	if ttl > 0 {
+		reply, err = rc.Do("SET", key, serialized, "EX", ttl, "NX")
+	} else {
+		reply, err = rc.Do("SET", key, serialized, "NX")
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return reply != nil, nil
+}
+
 // DeleteAll 批量所有键
 func (store *RedisStore) DeleteAll() error {
 	rc := store.pool.Get()
@@ -61,6 +61,12 @@ func MasterUserResetUrl(base *url.URL) *url.URL {
 	return base.ResolveReference(masterUserReset)
 }
 
+// MasterSSOCallbackUrl returns the frontend page IdP redirects users back to after signing in.
+func MasterSSOCallbackUrl(base *url.URL, providerID string) *url.URL {
+	route, _ := url.Parse("/session/sso/" + url.PathEscape(providerID))
+	return base.ResolveReference(route)
+}
+
 func MasterShareUrl(base *url.URL, id, password string) *url.URL {
 	p := "/s/" + id
 	if password != "" {
@@ -106,6 +112,16 @@ func MasterPolicyOAuthCallback(base *url.URL) *url.URL {
 	return base.ResolveReference(routes)
 }
 
+// MasterGoogleDriveOAuthCallback returns the OAuth redirect URL of Google Drive, it forwards
+// the authorization code to MasterPolicyOAuthCallback.
+func MasterGoogleDriveOAuthCallback(base *url.URL) *url.URL {
+	routes, err := url.Parse(constants.APIPrefix + "/callback/googledrive/auth")
+	if err != nil {
+		return nil
+	}
+	return base.ResolveReference(routes)
+}
+
 func MasterGetCredentialUrl(base, key string) *url.URL {
 	masterBase, err := url.Parse(base)
 	if err != nil {
@@ -46,8 +46,11 @@ type System struct {
 	SessionSecret string
 	HashIDSalt    string // deprecated
 	GracePeriod   int    `validate:"gte=0"`
-	ProxyHeader   string
-	LogLevel      string `validate:"oneof=debug info warning error"`
+	// ProxyHeader 反向代理设置的客户端 IP 请求头，与 X-Forwarded-For 一样仅采信来自 TrustedProxies 的请求
+	ProxyHeader string
+	// TrustedProxies 可信反向代理的 IP 或 CIDR，默认仅为本机回环地址
+	TrustedProxies []string
+	LogLevel       string `validate:"oneof=debug info warning error"`
 }
 
 type SSL struct {
@@ -111,11 +114,12 @@ var DatabaseConfig = &Database{
 
 // SystemConfig 系统公用配置
 var SystemConfig = &System{
-	Debug:       false,
-	Mode:        MasterMode,
-	Listen:      ":5212",
-	ProxyHeader: "",
-	LogLevel:    "info",
+	Debug:          false,
+	Mode:           MasterMode,
+	Listen:         ":5212",
+	ProxyHeader:    "",
+	TrustedProxies: []string{"127.0.0.1", "::1"},
+	LogLevel:       "info",
 }
 
 // CORSConfig 跨域配置
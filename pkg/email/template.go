@@ -6,6 +6,7 @@ import (
 	"html/template"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -109,6 +110,58 @@ func NewActivationEmail(ctx context.Context, settings setting.Provider, user *en
 	return resTitle.String(), resBody.String(), nil
 }
 
+// LockoutContext used for variables in account lockout email
+type LockoutContext struct {
+	*CommonContext
+	User *ent.User
+	// Url is the link to reset password
+	Url string
+	// IP is the client IP of the last failed attempt
+	IP    string
+	Until string
+}
+
+// NewLockoutEmail generates account lockout notification from template
+func NewLockoutEmail(ctx context.Context, settings setting.Provider, user *ent.User, url, ip string, until time.Time) (string, string, error) {
+	templates := settings.LockoutEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("lockout email template not configured")
+	}
+
+	selected := selectTemplate(templates, user)
+	lockoutCtx := LockoutContext{
+		CommonContext: commonContext(ctx, settings),
+		User:          user,
+		Url:           url,
+		IP:            ip,
+		Until:         until.Format("2006-01-02 15:04 MST"),
+	}
+
+	tmplTitle, err := template.New("lockoutTitle").Parse(selected.Title)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email title: %w", err)
+	}
+
+	var resTitle strings.Builder
+	err = tmplTitle.Execute(&resTitle, lockoutCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email title: %w", err)
+	}
+
+	tmplBody, err := template.New("lockoutBody").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var resBody strings.Builder
+	err = tmplBody.Execute(&resBody, lockoutCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return resTitle.String(), resBody.String(), nil
+}
+
 func commonContext(ctx context.Context, settings setting.Provider) *CommonContext {
 	logo := settings.Logo(ctx)
 	siteUrl := settings.SiteURL(ctx)
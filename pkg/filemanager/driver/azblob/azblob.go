@@ -0,0 +1,371 @@
+package azblob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/mime"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	defaultChunkSize = 25 << 20 // 25MB
+	chunkRetrySleep  = time.Second * 5
+	// defaultSourceTTL 未指定过期时间时，下载地址的有效期
+	defaultSourceTTL = 7 * 24 * time.Hour
+)
+
+// Driver Azure Blob Storage 适配器
+type Driver struct {
+	policy    *ent.StoragePolicy
+	client    *client
+	settings  setting.Provider
+	config    conf.ConfigProvider
+	l         logging.Logger
+	mime      mime.MimeDetector
+	chunkSize int64
+}
+
+var (
+	features = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityUploadSentinelRequired: true,
+	}, features)
+}
+
+// New 从存储策略初始化新的Driver实例
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger, mime mime.MimeDetector) (*Driver, error) {
+	c, err := newClient(policy, request.NewClient(config, request.WithLogger(l)), l)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkSize := policy.Settings.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	return &Driver{
+		policy:    policy,
+		client:    c,
+		settings:  settings,
+		config:    config,
+		l:         l,
+		mime:      mime,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// List 列出给定路径下的文件
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	// 初始化列目录参数
+	base = strings.TrimPrefix(base, "/")
+	if base != "" {
+		base += "/"
+	}
+
+	// 是否为递归列出
+	delimiter := ""
+	if !recursive {
+		delimiter = "/"
+	}
+
+	var (
+		res    []fs.PhysicalObject
+		marker string
+	)
+	for {
+		page, err := handler.client.ListBlobs(ctx, base, delimiter, marker)
+		if err != nil {
+			return nil, err
+		}
+
+		// 处理目录
+		for _, prefix := range page.Blobs.BlobPrefix {
+			rel, err := filepath.Rel(base, prefix.Name)
+			if err != nil {
+				continue
+			}
+			res = append(res, fs.PhysicalObject{
+				Name:         path.Base(prefix.Name),
+				RelativePath: filepath.ToSlash(rel),
+				IsDir:        true,
+				LastModify:   time.Now(),
+			})
+		}
+		onProgress(len(page.Blobs.BlobPrefix))
+
+		// 处理文件
+		for _, blob := range page.Blobs.Blob {
+			rel, err := filepath.Rel(base, blob.Name)
+			if err != nil {
+				continue
+			}
+
+			lastModify, err := http.ParseTime(blob.Properties.LastModified)
+			if err != nil {
+				lastModify = time.Now()
+			}
+
+			res = append(res, fs.PhysicalObject{
+				Name:         path.Base(blob.Name),
+				Source:       blob.Name,
+				RelativePath: filepath.ToSlash(rel),
+				Size:         blob.Properties.ContentLength,
+				LastModify:   lastModify,
+			})
+		}
+		onProgress(len(page.Blobs.Blob))
+
+		// 如果本次未列取完，则继续使用marker获取结果
+		if page.NextMarker == "" {
+			break
+		}
+		marker = page.NextMarker
+	}
+
+	return res, nil
+}
+
+// Open 打开文件
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+// Put 将文件流保存到指定目录
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	// 是否允许覆盖
+	overwrite := file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+	if !overwrite {
+		// Check for duplicated file
+		if _, err := handler.client.Meta(ctx, file.Props.SavePath); err == nil {
+			return fs.ErrFileExisted
+		}
+	}
+
+	// Initial chunk groups
+	chunks := chunk.NewChunkGroup(file, handler.chunkSize, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		return handler.client.PutBlock(ctx, file.Props.SavePath, blockID(current.Index()), content, current.Length())
+	}
+
+	// 空文件直接提交空的分片列表
+	if file.Props.Size > 0 {
+		for chunks.Next() {
+			if err := chunks.Process(uploadFunc); err != nil {
+				return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+			}
+		}
+	}
+
+	return handler.client.PutBlockList(ctx, file.Props.SavePath, blockIDs(file.Props.Size, handler.chunkSize),
+		handler.mimeType(file.Props))
+}
+
+// Delete 删除一个或多个文件，
+// 返回未删除的文件，及遇到的最后一个错误
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var lastErr error
+
+	for _, file := range files {
+		err := handler.client.Delete(ctx, file)
+		if err != nil && !errors.Is(err, ErrObjectNotExist) {
+			handler.l.Warning("Failed to delete file %q: %s", file, err)
+			failed = append(failed, file)
+			lastErr = err
+		}
+	}
+
+	return failed, lastErr
+}
+
+// Thumb 获取文件缩略图
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source 获取外链URL
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	// 公有容器无需签名，与其他存储策略一致，不支持自定义下载文件名
+	if !handler.policy.IsPrivate {
+		return handler.client.blobURL(e.Source(), nil).String(), nil
+	}
+
+	contentDisposition := ""
+	if args.IsDownload {
+		encodedFilename := url.PathEscape(args.DisplayName)
+		contentDisposition = fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`,
+			encodedFilename, encodedFilename)
+	}
+
+	expire := time.Now().Add(defaultSourceTTL)
+	if args.Expire != nil {
+		expire = *args.Expire
+	}
+
+	return handler.client.SignedURL(e.Source(), "r", expire, contentDisposition, nil), nil
+}
+
+// Token 获取上传凭证，客户端使用签名后的地址依次上传各个分片
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	// Check for duplicated file
+	if _, err := handler.client.Meta(ctx, file.Props.SavePath); err == nil {
+		return nil, fs.ErrFileExisted
+	}
+
+	// 生成回调地址
+	siteURL := handler.settings.SiteURL(setting.UseFirstSiteUrl(ctx))
+	uploadSession.ChunkSize = handler.chunkSize
+	uploadSession.Callback = routes.MasterSlaveCallbackUrl(siteURL, types.PolicyTypeAzblob, uploadSession.Props.UploadSessionID, uploadSession.CallbackSecret).String()
+
+	// 为每个分片签名上传 URL，分片在回调时提交
+	ids := blockIDs(file.Props.Size, handler.chunkSize)
+	urls := make([]string, len(ids))
+	for i, id := range ids {
+		urls[i] = handler.client.SignedURL(uploadSession.Props.SavePath, "cw", uploadSession.Props.ExpireAt, "", url.Values{
+			"comp":    {"block"},
+			"blockid": {id},
+		})
+	}
+
+	return &fs.UploadCredential{
+		UploadURLs: urls,
+		SessionID:  uploadSession.Props.UploadSessionID,
+		ChunkSize:  handler.chunkSize,
+	}, nil
+}
+
+// CORS 创建跨域策略。Azure 的跨域规则对整个存储账户生效，已有的规则会被保留。
+func (handler *Driver) CORS() error {
+	ctx := context.Background()
+	props, err := handler.client.GetServiceProperties(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get service properties: %w", err)
+	}
+
+	rule := corsRule{
+		AllowedOrigins:  "*",
+		AllowedMethods:  "GET,HEAD,PUT,POST,DELETE,OPTIONS",
+		AllowedHeaders:  "*",
+		ExposedHeaders:  "ETag",
+		MaxAgeInSeconds: 3600,
+	}
+
+	if props.Cors == nil {
+		props.Cors = &corsRules{}
+	}
+
+	for _, existed := range props.Cors.CorsRule {
+		if existed == rule {
+			return nil
+		}
+	}
+
+	props.Cors.CorsRule = append(props.Cors.CorsRule, rule)
+	return handler.client.SetServiceProperties(ctx, props)
+}
+
+// CancelToken 取消上传凭证，未提交的分片会在一周后由 Azure 自动清理
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+// CompleteUpload 提交客户端已上传的分片
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	// 中转上传时分片已在 Put 中提交
+	if session.ChunkSize == 0 {
+		return nil
+	}
+
+	ids := blockIDs(session.Props.Size, session.ChunkSize)
+	if err := handler.client.PutBlockList(ctx, session.Props.SavePath, ids, handler.mimeType(session.Props)); err != nil {
+		return fmt.Errorf("failed to commit block list: %w", err)
+	}
+
+	// Make sure uploaded file size is correct
+	size, err := handler.client.Meta(ctx, session.Props.SavePath)
+	if err != nil {
+		return fmt.Errorf("failed to get uploaded file size: %w", err)
+	}
+
+	if size != session.Props.Size {
+		return serializer.NewError(
+			serializer.CodeMetaMismatch,
+			fmt.Sprintf("File size not match, expected: %d, actual: %d", session.Props.Size, size),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures: features,
+		MediaMetaProxy: handler.policy.Settings.MediaMetaGeneratorProxy,
+		ThumbProxy:     handler.policy.Settings.ThumbGeneratorProxy,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+func (handler *Driver) mimeType(props *fs.UploadProps) string {
+	if props.MimeType != "" {
+		return props.MimeType
+	}
+
+	return handler.mime.TypeByName(props.Uri.Name())
+}
+
+// blockIDs 获取按大小分片后各个分片的 ID，空文件没有分片
+func blockIDs(size, chunkSize int64) []string {
+	if size == 0 {
+		return []string{}
+	}
+
+	num := int((size + chunkSize - 1) / chunkSize)
+	ids := make([]string, num)
+	for i := range ids {
+		ids[i] = blockID(i)
+	}
+
+	return ids
+}
@@ -0,0 +1,659 @@
+package azblob
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/internal/drivertest"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// Azurite 默认的存储账户及密钥
+	testAccount   = "devstoreaccount1"
+	testKey       = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer = "cloudreve"
+	// testListPageSize 模拟服务端单次列取的数量
+	testListPageSize = 2
+)
+
+type testSettings struct {
+	drivertest.Settings
+}
+
+func (testSettings) SiteURL(ctx context.Context) *url.URL {
+	u, _ := url.Parse("https://cloudreve.org")
+	return u
+}
+
+type testMime struct{}
+
+func (testMime) TypeByName(ext string) string {
+	return "text/plain"
+}
+
+// stubServer 模拟 Azurite 的部分 Blob 服务接口
+type stubServer struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	blobs        map[string][]byte
+	contentTypes map[string]string
+	blocks       map[string]map[string][]byte
+	properties   []byte
+	propertyPuts int
+	requests     []string
+}
+
+func newStubServer() *stubServer {
+	s := &stubServer{
+		blobs:        make(map[string][]byte),
+		contentTypes: make(map[string]string),
+		blocks:       make(map[string]map[string][]byte),
+		properties:   []byte("<StorageServiceProperties></StorageServiceProperties>"),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *stubServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	s.requests = append(s.requests, r.Method+" "+query.Get("comp"))
+
+	// 使用 SAS 或 Shared Key 认证，签名本身在 TestClient_Sign 中验证
+	if query.Get("sig") == "" && !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAccount+":") {
+		writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	if query.Get("sig") != "" {
+		expire, err := time.Parse(sasTimeFormat, query.Get("se"))
+		if err != nil || expire.Before(time.Now()) || !sasAllows(query.Get("sp"), r.Method) {
+			writeError(w, http.StatusForbidden, "AuthenticationFailed")
+			return
+		}
+	}
+
+	p := strings.TrimPrefix(r.URL.Path, "/"+testAccount)
+	switch {
+	case p == "/" && query.Get("restype") == "service" && query.Get("comp") == "properties":
+		s.serveProperties(w, r)
+	case p == "/"+testContainer && query.Get("restype") == "container" && query.Get("comp") == "list":
+		s.serveList(w, query)
+	case strings.HasPrefix(p, "/"+testContainer+"/"):
+		s.serveBlob(w, r, strings.TrimPrefix(p, "/"+testContainer+"/"))
+	default:
+		writeError(w, http.StatusBadRequest, "InvalidUri")
+	}
+}
+
+func (s *stubServer) serveProperties(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		w.Write(s.properties)
+	case "PUT":
+		s.properties, _ = io.ReadAll(r.Body)
+		s.propertyPuts++
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (s *stubServer) serveList(w http.ResponseWriter, query url.Values) {
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+
+	names := make([]string, 0, len(s.blobs))
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// 按分隔符折叠为目录
+	type entry struct {
+		name  string
+		isDir bool
+	}
+	var entries []entry
+	for _, name := range names {
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				dir := name[:len(prefix)+i+len(delimiter)]
+				if len(entries) == 0 || entries[len(entries)-1].name != dir {
+					entries = append(entries, entry{name: dir, isDir: true})
+				}
+				continue
+			}
+		}
+		entries = append(entries, entry{name: name})
+	}
+
+	start, _ := strconv.Atoi(query.Get("marker"))
+	end := start + testListPageSize
+	res := &listBlobsResult{}
+	if end < len(entries) {
+		res.NextMarker = strconv.Itoa(end)
+	} else {
+		end = len(entries)
+	}
+
+	for _, e := range entries[start:end] {
+		if e.isDir {
+			res.Blobs.BlobPrefix = append(res.Blobs.BlobPrefix, blobPrefix{Name: e.name})
+			continue
+		}
+
+		item := blobItem{Name: e.name}
+		item.Properties.LastModified = "Fri, 16 Oct 2026 10:17:32 GMT"
+		item.Properties.ContentLength = int64(len(s.blobs[e.name]))
+		res.Blobs.Blob = append(res.Blobs.Blob, item)
+	}
+
+	body, _ := xml.Marshal(res)
+	w.Write(body)
+}
+
+func (s *stubServer) serveBlob(w http.ResponseWriter, r *http.Request, name string) {
+	query := r.URL.Query()
+	switch {
+	case r.Method == "PUT" && query.Get("comp") == "block":
+		content, _ := io.ReadAll(r.Body)
+		if s.blocks[name] == nil {
+			s.blocks[name] = make(map[string][]byte)
+		}
+		s.blocks[name][query.Get("blockid")] = content
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "PUT" && query.Get("comp") == "blocklist":
+		list := &blockList{}
+		if err := xml.NewDecoder(r.Body).Decode(list); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+
+		var content []byte
+		for _, id := range list.Latest {
+			block, ok := s.blocks[name][id]
+			if !ok {
+				writeError(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			content = append(content, block...)
+		}
+
+		s.blobs[name] = content
+		s.contentTypes[name] = r.Header.Get("X-Ms-Blob-Content-Type")
+		delete(s.blocks, name)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "HEAD":
+		content, ok := s.blobs[name]
+		if !ok {
+			w.Header().Set("X-Ms-Error-Code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	case r.Method == "GET":
+		content, ok := s.blobs[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		if rscd := query.Get("rscd"); rscd != "" {
+			w.Header().Set("Content-Disposition", rscd)
+		}
+		w.Write(content)
+	case r.Method == "DELETE":
+		if _, ok := s.blobs[name]; !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (s *stubServer) countRequests(req string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, r := range s.requests {
+		if r == req {
+			count++
+		}
+	}
+	return count
+}
+
+func sasAllows(permissions, method string) bool {
+	switch method {
+	case "GET", "HEAD":
+		return strings.Contains(permissions, "r")
+	case "PUT":
+		return strings.Contains(permissions, "w")
+	case "DELETE":
+		return strings.Contains(permissions, "d")
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("X-Ms-Error-Code", code)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func newTestDriver(t *testing.T, s *stubServer, isPrivate bool) *Driver {
+	handler, err := New(context.Background(), &ent.StoragePolicy{
+		Type:       types.PolicyTypeAzblob,
+		Server:     s.server.URL + "/" + testAccount + "/",
+		AccessKey:  testAccount,
+		SecretKey:  testKey,
+		BucketName: testContainer,
+		IsPrivate:  isPrivate,
+		Settings:   &types.PolicySetting{ChunkSize: 4},
+	}, testSettings{}, drivertest.Config{}, logging.NewConsoleLogger(logging.LevelError), testMime{})
+	assert.NoError(t, err)
+	return handler
+}
+
+func upload(handler *Driver, dst string, data []byte, mode fs.WriteMode) error {
+	req := drivertest.UploadRequest(dst, data, mode)
+	req.Props.MimeType = "image/png"
+	return handler.Put(context.Background(), req)
+}
+
+func TestNew(t *testing.T) {
+	asserts := assert.New(t)
+	l := logging.NewConsoleLogger(logging.LevelError)
+
+	// 无效的服务端地址
+	{
+		_, err := New(context.Background(), &ent.StoragePolicy{
+			Server:    "ftp://example.com",
+			SecretKey: testKey,
+			Settings:  &types.PolicySetting{},
+		}, testSettings{}, drivertest.Config{}, l, testMime{})
+		asserts.Error(err)
+	}
+
+	// 无效的账户密钥
+	{
+		_, err := New(context.Background(), &ent.StoragePolicy{
+			Server:    "https://account.blob.core.windows.net",
+			SecretKey: "not base64!",
+			Settings:  &types.PolicySetting{},
+		}, testSettings{}, drivertest.Config{}, l, testMime{})
+		asserts.Error(err)
+	}
+
+	// 默认分片大小
+	{
+		handler, err := New(context.Background(), &ent.StoragePolicy{
+			Server:    "https://account.blob.core.windows.net/",
+			SecretKey: testKey,
+			Settings:  &types.PolicySetting{},
+		}, testSettings{}, drivertest.Config{}, l, testMime{})
+		asserts.NoError(err)
+		asserts.EqualValues(defaultChunkSize, handler.chunkSize)
+		asserts.Equal("https://account.blob.core.windows.net", handler.client.endpoint.String())
+	}
+}
+
+// 签名结果与 Azure SDK 生成的一致
+func TestClient_Sign(t *testing.T) {
+	asserts := assert.New(t)
+	c, err := newClient(&ent.StoragePolicy{
+		Server:     "http://127.0.0.1:1/" + testAccount,
+		AccessKey:  testAccount,
+		SecretKey:  testKey,
+		BucketName: testContainer,
+	}, nil, nil)
+	asserts.NoError(err)
+
+	// Service SAS
+	{
+		expire := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		sas := c.sas("dir/文件 1.txt", "r", expire, `attachment; filename="a.txt"`)
+		asserts.Equal("9nep4eHLoK3q9VfI8rnX89VxbzmH0xS+jR3QQgWWVGE=", sas.Get("sig"))
+		asserts.Equal("2030-01-02T03:04:05Z", sas.Get("se"))
+		asserts.Equal(`attachment; filename="a.txt"`, sas.Get("rscd"))
+
+		sas = c.sas("dir/文件 1.txt", "cw", expire, "")
+		asserts.Equal("YlI9alvyu5UamiTslO4h6dWZRIZdVydmQiw2wX5EQec=", sas.Get("sig"))
+		asserts.Empty(sas.Get("rscd"))
+	}
+
+	// Shared Key
+	{
+		u := c.containerURL(url.Values{
+			"restype": {"container"},
+			"comp":    {"list"},
+			"prefix":  {"dir/文件 1"},
+		})
+		header := http.Header{}
+		header.Set("X-Ms-Date", "Fri, 16 Oct 2026 10:17:32 GMT")
+		header.Set("X-Ms-Version", "2025-11-05")
+		header.Set("Accept", "application/xml")
+		asserts.Equal("SharedKey devstoreaccount1:oaLcQLOOsW7Ue9HHRaQbBXAXE8xOMdJBuuA4tyU8KLk=", c.sign("GET", u, header, 0))
+
+		u, _ = url.Parse("http://127.0.0.1:1/devstoreaccount1/cloudreve/dir%2F%E6%96%87%E4%BB%B6%201.txt?comp=blocklist")
+		header = http.Header{}
+		header.Set("X-Ms-Date", "Fri, 16 Oct 2026 10:17:39 GMT")
+		header.Set("X-Ms-Version", "2025-11-05")
+		header.Set("X-Ms-Blob-Content-Type", "text/plain")
+		header.Set("Content-Type", "application/xml")
+		asserts.Equal("SharedKey devstoreaccount1:kkwCyrHVLgTWAZrPtCtHvPT0mIXXGEmYnTa2rhJwJCs=", c.sign("PUT", u, header, 120))
+	}
+}
+
+func TestDriver_Put(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+
+	// 分片上传
+	asserts.NoError(upload(handler, "dir/文件 1.txt", []byte("0123456789"), fs.ModeNone))
+	asserts.Equal("0123456789", string(s.blobs["dir/文件 1.txt"]))
+	asserts.Equal("image/png", s.contentTypes["dir/文件 1.txt"])
+	asserts.Equal(3, s.countRequests("PUT block"))
+
+	// 文件已存在
+	asserts.ErrorIs(upload(handler, "dir/文件 1.txt", []byte("new"), fs.ModeNone), fs.ErrFileExisted)
+
+	// 覆盖
+	asserts.NoError(upload(handler, "dir/文件 1.txt", []byte("new"), fs.ModeOverwrite))
+	asserts.Equal("new", string(s.blobs["dir/文件 1.txt"]))
+
+	// 空文件
+	asserts.NoError(upload(handler, "empty.txt", []byte{}, fs.ModeNone))
+	content, ok := s.blobs["empty.txt"]
+	asserts.True(ok)
+	asserts.Empty(content)
+	asserts.Equal(4, s.countRequests("PUT block"))
+}
+
+func TestDriver_Token(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+	data := []byte("0123456789")
+
+	newSession := func(name string, size int64) *fs.UploadSession {
+		return &fs.UploadSession{
+			CallbackSecret: "secret",
+			Props: &fs.UploadProps{
+				Uri:             &fs.URI{U: &url.URL{Path: name}},
+				SavePath:        name,
+				Size:            size,
+				UploadSessionID: "session",
+				ExpireAt:        time.Now().Add(time.Hour),
+			},
+		}
+	}
+
+	// 使用签名地址上传分片并提交
+	{
+		session := newSession("dir/a.txt", int64(len(data)))
+		credential, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.NoError(err)
+		asserts.Equal("session", credential.SessionID)
+		asserts.EqualValues(4, credential.ChunkSize)
+		asserts.EqualValues(4, session.ChunkSize)
+		asserts.Equal("https://cloudreve.org/api/v4/callback/azblob/session/secret", session.Callback)
+		asserts.Len(credential.UploadURLs, 3)
+
+		for i, uploadURL := range credential.UploadURLs {
+			u, err := url.Parse(uploadURL)
+			asserts.NoError(err)
+			asserts.Equal(blockID(i), u.Query().Get("blockid"))
+			asserts.Equal("cw", u.Query().Get("sp"))
+			asserts.Empty(u.Query().Get("rscd"))
+
+			end := (i + 1) * 4
+			if end > len(data) {
+				end = len(data)
+			}
+			req, _ := http.NewRequest("PUT", uploadURL, bytes.NewReader(data[i*4:end]))
+			req.Header.Set("X-Ms-Blob-Type", "BlockBlob")
+			resp, err := http.DefaultClient.Do(req)
+			asserts.NoError(err)
+			resp.Body.Close()
+			asserts.Equal(http.StatusCreated, resp.StatusCode)
+		}
+
+		asserts.NoError(handler.CompleteUpload(context.Background(), session))
+		asserts.Equal(string(data), string(s.blobs["dir/a.txt"]))
+		asserts.Equal("text/plain", s.contentTypes["dir/a.txt"])
+	}
+
+	// 文件已存在
+	{
+		session := newSession("dir/a.txt", int64(len(data)))
+		_, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.ErrorIs(err, fs.ErrFileExisted)
+	}
+
+	// 空文件无需上传分片
+	{
+		session := newSession("empty.txt", 0)
+		credential, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.NoError(err)
+		asserts.Empty(credential.UploadURLs)
+		asserts.NoError(handler.CompleteUpload(context.Background(), session))
+		_, ok := s.blobs["empty.txt"]
+		asserts.True(ok)
+	}
+
+	// 分片缺失
+	{
+		session := newSession("missing.txt", 8)
+		_, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.NoError(err)
+		err = handler.CompleteUpload(context.Background(), session)
+		var respErr *RespError
+		asserts.True(errors.As(err, &respErr))
+		asserts.Equal("InvalidBlockList", respErr.Code)
+	}
+
+	// 上传的大小与预期不符
+	{
+		session := newSession("mismatch.txt", 10)
+		credential, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.NoError(err)
+		for _, uploadURL := range credential.UploadURLs {
+			req, _ := http.NewRequest("PUT", uploadURL, strings.NewReader("01234"))
+			resp, err := http.DefaultClient.Do(req)
+			asserts.NoError(err)
+			resp.Body.Close()
+		}
+
+		err = handler.CompleteUpload(context.Background(), session)
+		var appErr serializer.AppError
+		asserts.True(errors.As(err, &appErr))
+		asserts.Equal(serializer.CodeMetaMismatch, appErr.Code)
+	}
+
+	// 签名已过期
+	{
+		session := newSession("expired.txt", 4)
+		session.Props.ExpireAt = time.Now().Add(-time.Minute)
+		credential, err := handler.Token(context.Background(), session, &fs.UploadRequest{Props: session.Props})
+		asserts.NoError(err)
+		req, _ := http.NewRequest("PUT", credential.UploadURLs[0], strings.NewReader("0123"))
+		resp, err := http.DefaultClient.Do(req)
+		asserts.NoError(err)
+		resp.Body.Close()
+		asserts.Equal(http.StatusForbidden, resp.StatusCode)
+	}
+}
+
+func TestDriver_Source(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+	asserts.NoError(upload(handler, "dir/文件 1.txt", []byte("content"), fs.ModeNone))
+	e := fs.NewEntity(&ent.Entity{Source: "dir/文件 1.txt"})
+
+	// 下载地址
+	{
+		expire := time.Now().Add(time.Hour).Truncate(time.Second)
+		source, err := handler.Source(context.Background(), e, &driver.GetSourceArgs{
+			Expire:      &expire,
+			IsDownload:  true,
+			DisplayName: "文件 1.txt",
+		})
+		asserts.NoError(err)
+
+		u, err := url.Parse(source)
+		asserts.NoError(err)
+		asserts.Equal("r", u.Query().Get("sp"))
+		asserts.Equal(expire.UTC().Format(sasTimeFormat), u.Query().Get("se"))
+		asserts.Equal(`attachment; filename="%E6%96%87%E4%BB%B6%201.txt"; filename*=UTF-8''%E6%96%87%E4%BB%B6%201.txt`, u.Query().Get("rscd"))
+
+		resp, err := http.Get(source)
+		asserts.NoError(err)
+		defer resp.Body.Close()
+		content, _ := io.ReadAll(resp.Body)
+		asserts.Equal("content", string(content))
+		asserts.Equal(u.Query().Get("rscd"), resp.Header.Get("Content-Disposition"))
+	}
+
+	// 默认有效期
+	{
+		source, err := handler.Source(context.Background(), e, &driver.GetSourceArgs{})
+		asserts.NoError(err)
+		u, err := url.Parse(source)
+		asserts.NoError(err)
+		se, err := time.Parse(sasTimeFormat, u.Query().Get("se"))
+		asserts.NoError(err)
+		asserts.WithinDuration(time.Now().Add(defaultSourceTTL), se, time.Minute)
+		asserts.Empty(u.Query().Get("rscd"))
+	}
+
+	// 公有容器
+	{
+		handler := newTestDriver(t, s, false)
+		source, err := handler.Source(context.Background(), e, &driver.GetSourceArgs{IsDownload: true})
+		asserts.NoError(err)
+		asserts.Equal(s.server.URL+"/devstoreaccount1/cloudreve/dir/%E6%96%87%E4%BB%B6%201.txt", source)
+	}
+}
+
+func TestDriver_List(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+	for _, name := range []string{"a.txt", "dir/b.txt", "dir/c.txt", "dir/sub/d.txt", "dir2/e.txt", "z.txt"} {
+		asserts.NoError(upload(handler, name, []byte(name), fs.ModeNone))
+	}
+
+	progress := 0
+	onProgress := func(count int) {
+		progress += count
+	}
+
+	// 非递归列出根目录，需要多次分页
+	{
+		res, err := handler.List(context.Background(), "/", onProgress, false)
+		asserts.NoError(err)
+		asserts.Len(res, 4)
+		asserts.Equal(4, progress)
+
+		objects := make(map[string]fs.PhysicalObject)
+		for _, object := range res {
+			objects[object.RelativePath] = object
+		}
+		asserts.True(objects["dir"].IsDir)
+		asserts.True(objects["dir2"].IsDir)
+		asserts.False(objects["a.txt"].IsDir)
+		asserts.Equal("a.txt", objects["a.txt"].Source)
+		asserts.EqualValues(5, objects["a.txt"].Size)
+		asserts.Equal(time.Date(2026, 10, 16, 10, 17, 32, 0, time.UTC), objects["a.txt"].LastModify.UTC())
+	}
+
+	// 递归列出子目录
+	{
+		res, err := handler.List(context.Background(), "dir", onProgress, true)
+		asserts.NoError(err)
+
+		relPaths := make([]string, 0, len(res))
+		for _, object := range res {
+			asserts.False(object.IsDir)
+			relPaths = append(relPaths, object.RelativePath)
+		}
+		asserts.Equal([]string{"b.txt", "c.txt", "sub/d.txt"}, relPaths)
+		asserts.Equal("sub/d.txt", res[2].RelativePath)
+		asserts.Equal("dir/sub/d.txt", res[2].Source)
+		asserts.Equal("d.txt", res[2].Name)
+	}
+}
+
+func TestDriver_Delete(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+	asserts.NoError(upload(handler, "a.txt", []byte("a"), fs.ModeNone))
+
+	failed, err := handler.Delete(context.Background(), "a.txt", "not-exist.txt")
+	asserts.NoError(err)
+	asserts.Empty(failed)
+	asserts.Empty(s.blobs)
+
+	// 认证失败
+	handler.client.policy.AccessKey = "other"
+	failed, err = handler.Delete(context.Background(), "b.txt")
+	asserts.Error(err)
+	asserts.Equal([]string{"b.txt"}, failed)
+}
+
+func TestDriver_CORS(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, true)
+	s.properties = []byte(`<StorageServiceProperties><Cors><CorsRule><AllowedOrigins>https://example.com</AllowedOrigins><AllowedMethods>GET</AllowedMethods><AllowedHeaders></AllowedHeaders><ExposedHeaders></ExposedHeaders><MaxAgeInSeconds>60</MaxAgeInSeconds></CorsRule></Cors></StorageServiceProperties>`)
+
+	// 保留已有的规则
+	asserts.NoError(handler.CORS())
+	props := &serviceProperties{}
+	asserts.NoError(xml.Unmarshal(s.properties, props))
+	asserts.Len(props.Cors.CorsRule, 2)
+	asserts.Equal("https://example.com", props.Cors.CorsRule[0].AllowedOrigins)
+	asserts.Equal("*", props.Cors.CorsRule[1].AllowedOrigins)
+	asserts.Contains(props.Cors.CorsRule[1].AllowedMethods, "PUT")
+	asserts.Equal(1, s.propertyPuts)
+
+	// 规则已存在
+	asserts.NoError(handler.CORS())
+	asserts.Equal(1, s.propertyPuts)
+}
@@ -0,0 +1,74 @@
+package azblob
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// RespError Azure 返回的错误
+type RespError struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+// Error 实现error接口
+func (e *RespError) Error() string {
+	return fmt.Sprintf("Azure Blob Storage returns error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Unwrap 将 404 错误转换为 ErrObjectNotExist
+func (e *RespError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return ErrObjectNotExist
+	}
+
+	return nil
+}
+
+// listBlobsResult List Blobs 响应
+type listBlobsResult struct {
+	XMLName xml.Name `xml:"EnumerationResults"`
+	Blobs   struct {
+		Blob       []blobItem   `xml:"Blob"`
+		BlobPrefix []blobPrefix `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+type blobItem struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		LastModified  string `xml:"Last-Modified"`
+		ContentLength int64  `xml:"Content-Length"`
+	} `xml:"Properties"`
+}
+
+type blobPrefix struct {
+	Name string `xml:"Name"`
+}
+
+// blockList Put Block List 请求正文
+type blockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+// serviceProperties Blob 服务属性，只处理跨域规则，未包含的属性保持不变
+type serviceProperties struct {
+	XMLName xml.Name   `xml:"StorageServiceProperties"`
+	Cors    *corsRules `xml:"Cors"`
+}
+
+type corsRules struct {
+	CorsRule []corsRule `xml:"CorsRule"`
+}
+
+type corsRule struct {
+	AllowedOrigins  string `xml:"AllowedOrigins"`
+	AllowedMethods  string `xml:"AllowedMethods"`
+	AllowedHeaders  string `xml:"AllowedHeaders"`
+	ExposedHeaders  string `xml:"ExposedHeaders"`
+	MaxAgeInSeconds int    `xml:"MaxAgeInSeconds"`
+}
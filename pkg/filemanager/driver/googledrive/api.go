@@ -0,0 +1,494 @@
+package googledrive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	// ChunkSizeUnit 分片大小必须为 256 KiB 的整数倍
+	ChunkSizeUnit int64 = 256 << 10
+	// ListPageSize 单次列取的最大数量
+	ListPageSize    = 1000
+	chunkRetrySleep = time.Second * 5
+
+	folderMimeType = "application/vnd.google-apps.folder"
+	fileFields     = "id,name,mimeType,size,modifiedTime"
+
+	// Drive 对未完成的分片上传返回 308 Resume Incomplete
+	statusResumeIncomplete = http.StatusPermanentRedirect
+)
+
+// splitPath 将存储路径拆分为各级名称
+func splitPath(p string) []string {
+	return strings.FieldsFunc(p, func(r rune) bool {
+		return r == '/'
+	})
+}
+
+// escapeQuery 转义查询语句中的字符串
+func escapeQuery(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
+
+func (client *client) getRequestURL(base string, api string, query url.Values) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("supportsAllDrives", "true")
+	return base + "/" + api + "?" + query.Encode()
+}
+
+// findChild 在父目录中查找给定名称的对象
+func (client *client) findChild(ctx context.Context, parentID, name string) (*File, error) {
+	query := url.Values{
+		"q":        {fmt.Sprintf("name = '%s' and '%s' in parents and trashed = false", escapeQuery(name), escapeQuery(parentID))},
+		"fields":   {"files(" + fileFields + ")"},
+		"pageSize": {"1"},
+		// 共享云端硬盘中的文件需要此参数才能被列出
+		"includeItemsFromAllDrives": {"true"},
+	}
+
+	res, err := client.requestWithStr(ctx, "GET", client.getRequestURL(client.endpoints.apiURL, "files", query), "")
+	if err != nil {
+		return nil, err
+	}
+
+	var list ListResponse
+	if err := json.Unmarshal([]byte(res), &list); err != nil {
+		return nil, err
+	}
+
+	if len(list.Files) == 0 {
+		return nil, ErrObjectNotExist
+	}
+
+	return &list.Files[0], nil
+}
+
+// createFolder 在父目录下创建目录
+func (client *client) createFolder(ctx context.Context, parentID, name string) (*File, error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"name":     name,
+		"mimeType": folderMimeType,
+		"parents":  []string{parentID},
+	})
+
+	res, err := client.requestWithStr(ctx, "POST",
+		client.getRequestURL(client.endpoints.apiURL, "files", url.Values{"fields": {fileFields}}), string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var folder File
+	if err := json.Unmarshal([]byte(res), &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// resolveFolder 获取目录路径对应的 ID，create 为 true 时创建不存在的目录
+func (client *client) resolveFolder(ctx context.Context, dir string, create bool) (string, error) {
+	names := splitPath(dir)
+	id := client.endpoints.root
+	for i, name := range names {
+		current := strings.Join(names[:i+1], "/")
+		client.mu.Lock()
+		cached, ok := client.folders[current]
+		client.mu.Unlock()
+		if ok {
+			id = cached
+			continue
+		}
+
+		folder, err := client.findChild(ctx, id, name)
+		if errors.Is(err, ErrObjectNotExist) && create {
+			client.l.Debug("Creating Google Drive folder %q.", current)
+			folder, err = client.createFolder(ctx, id, name)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve folder %q: %w", current, err)
+		}
+
+		if !folder.IsFolder() {
+			return "", fmt.Errorf("%q is not a folder: %w", current, ErrObjectNotExist)
+		}
+
+		id = folder.ID
+		client.mu.Lock()
+		client.folders[current] = id
+		client.mu.Unlock()
+	}
+
+	return id, nil
+}
+
+// ListChildren 根据路径列取子对象
+func (client *client) ListChildren(ctx context.Context, path string) ([]File, error) {
+	parentID, err := client.resolveFolder(ctx, path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"q":                         {fmt.Sprintf("'%s' in parents and trashed = false", escapeQuery(parentID))},
+		"fields":                    {"nextPageToken,files(" + fileFields + ")"},
+		"pageSize":                  {strconv.Itoa(ListPageSize)},
+		"includeItemsFromAllDrives": {"true"},
+	}
+
+	var allFiles []File
+	for {
+		res, err := client.requestWithStr(ctx, "GET", client.getRequestURL(client.endpoints.apiURL, "files", query), "")
+		if err != nil {
+			return nil, err
+		}
+
+		var list ListResponse
+		if err := json.Unmarshal([]byte(res), &list); err != nil {
+			return nil, err
+		}
+
+		allFiles = append(allFiles, list.Files...)
+		if list.NextPageToken == "" {
+			break
+		}
+
+		client.l.Debug("Load next page, page token: %s", list.NextPageToken)
+		query.Set("pageToken", list.NextPageToken)
+	}
+
+	return allFiles, nil
+}
+
+// Meta 根据文件路径获取文件元信息
+func (client *client) Meta(ctx context.Context, p string) (*File, error) {
+	dir, name := path.Split(strings.Trim(p, "/"))
+	parentID, err := client.resolveFolder(ctx, dir, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.findChild(ctx, parentID, name)
+}
+
+// CreateUploadSession 创建分片上传会话，返回上传地址
+func (client *client) CreateUploadSession(ctx context.Context, dst string, size int64, opts ...Option) (string, error) {
+	options := newDefaultOption()
+	for _, o := range opts {
+		o.apply(options)
+	}
+
+	dir, name := path.Split(strings.Trim(dst, "/"))
+	parentID, err := client.resolveFolder(ctx, dir, true)
+	if err != nil {
+		return "", err
+	}
+
+	// Drive 允许同名文件，需要自行处理冲突
+	existed, err := client.findChild(ctx, parentID, name)
+	if err != nil && !errors.Is(err, ErrObjectNotExist) {
+		return "", err
+	}
+
+	method := "POST"
+	api := "files"
+	body := map[string]interface{}{
+		"name":    name,
+		"parents": []string{parentID},
+	}
+	if existed != nil {
+		if !options.overwrite {
+			return "", ErrObjectExisted
+		}
+
+		// 更新已有文件的内容
+		method = "PATCH"
+		api = "files/" + existed.ID
+		body = map[string]interface{}{}
+	}
+
+	bodyBytes, _ := json.Marshal(body)
+	resp, _, err := client.do(ctx, method,
+		client.getRequestURL(client.endpoints.uploadURL, api, url.Values{"uploadType": {"resumable"}}),
+		strings.NewReader(string(bodyBytes)),
+		request.WithContentLength(int64(len(bodyBytes))),
+		request.WithHeader(http.Header{
+			"X-Upload-Content-Length": {strconv.FormatInt(size, 10)},
+		}),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	uploadURL := resp.Header.Get("Location")
+	if uploadURL == "" {
+		return "", ErrNoUploadURL
+	}
+
+	return uploadURL, nil
+}
+
+// UploadChunk 上传分片
+func (client *client) UploadChunk(ctx context.Context, uploadURL string, content io.Reader, current *chunk.ChunkGroup) error {
+	_, err := client.request(
+		ctx, "PUT", uploadURL, content,
+		request.WithContentLength(current.Length()),
+		request.WithHeader(http.Header{
+			"Content-Range": {current.RangeHeader()},
+		}),
+		request.WithoutHeader([]string{"Authorization", "Content-Type"}),
+		request.WithTimeout(0),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload Google Drive chunk #%d: %w", current.Index(), err)
+	}
+
+	return nil
+}
+
+// Upload 上传文件
+func (client *client) Upload(ctx context.Context, file *fs.UploadRequest) error {
+	overwrite := file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+	dst := file.Props.SavePath
+
+	// 空文件无法使用分片上传，直接创建
+	if file.Props.Size == 0 {
+		return client.createEmptyFile(ctx, dst, overwrite)
+	}
+
+	uploadURL, err := client.CreateUploadSession(ctx, dst, file.Props.Size, WithOverwrite(overwrite))
+	if err != nil {
+		return err
+	}
+
+	// Initial chunk groups
+	chunks := chunk.NewChunkGroup(file, client.chunkSize, &backoff.ConstantBackoff{
+		Max:   client.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, client.settings.UseChunkBuffer(ctx), client.l, client.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		return client.UploadChunk(ctx, uploadURL, content, current)
+	}
+
+	// upload chunks
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			if err := client.DeleteUploadSession(ctx, uploadURL); err != nil {
+				client.l.Warning("Failed to delete upload session: %s", err)
+			}
+			return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+		}
+	}
+
+	return nil
+}
+
+// createEmptyFile 创建空文件
+func (client *client) createEmptyFile(ctx context.Context, dst string, overwrite bool) error {
+	dir, name := path.Split(strings.Trim(dst, "/"))
+	parentID, err := client.resolveFolder(ctx, dir, true)
+	if err != nil {
+		return err
+	}
+
+	existed, err := client.findChild(ctx, parentID, name)
+	if err != nil && !errors.Is(err, ErrObjectNotExist) {
+		return err
+	}
+
+	if existed != nil {
+		if !overwrite {
+			return ErrObjectExisted
+		}
+
+		if err := client.deleteByID(ctx, existed.ID); err != nil {
+			return err
+		}
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"name":    name,
+		"parents": []string{parentID},
+	})
+	_, err = client.requestWithStr(ctx, "POST",
+		client.getRequestURL(client.endpoints.apiURL, "files", url.Values{"fields": {fileFields}}), string(body))
+	return err
+}
+
+// DeleteUploadSession 取消上传会话
+func (client *client) DeleteUploadSession(ctx context.Context, uploadURL string) error {
+	_, err := client.request(ctx, "DELETE", uploadURL, nil,
+		request.WithContentLength(0),
+		request.WithoutHeader([]string{"Authorization"}),
+	)
+
+	// 取消成功时 Drive 返回 499
+	var respErr *RespError
+	if errors.As(err, &respErr) && respErr.APIError.Code == 499 {
+		return nil
+	}
+
+	return err
+}
+
+// BatchDelete 依次删除给出的文件，返回删除失败的文件，及最后一个遇到的错误
+func (client *client) BatchDelete(ctx context.Context, dst []string) ([]string, error) {
+	failed := make([]string, 0, len(dst))
+	var lastErr error
+	for _, p := range dst {
+		client.l.Debug("Delete file %q.", p)
+		file, err := client.Meta(ctx, p)
+		if errors.Is(err, ErrObjectNotExist) {
+			continue
+		}
+
+		if err == nil {
+			err = client.deleteByID(ctx, file.ID)
+		}
+
+		if err != nil {
+			client.l.Warning("Failed to delete file %q: %s", p, err)
+			failed = append(failed, p)
+			lastErr = err
+		}
+	}
+
+	if len(failed) > 0 && lastErr == nil {
+		lastErr = ErrDeleteFile
+	}
+
+	return failed, lastErr
+}
+
+func (client *client) deleteByID(ctx context.Context, id string) error {
+	_, err := client.requestWithStr(ctx, "DELETE", client.getRequestURL(client.endpoints.apiURL, "files/"+id, nil), "")
+	var respErr *RespError
+	if errors.As(err, &respErr) && respErr.APIError.Code == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// DownloadURL 获取文件的下载地址。Drive 不支持预签名地址，返回的地址包含访问令牌，
+// 仅可在服务端使用。
+func (client *client) DownloadURL(ctx context.Context, path string) (string, error) {
+	file, err := client.Meta(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	if file.IsFolder() {
+		return "", fmt.Errorf("%q is a folder: %w", path, ErrObjectNotExist)
+	}
+
+	return client.getRequestURL(client.endpoints.apiURL, "files/"+file.ID, url.Values{
+		"alt":          {"media"},
+		"access_token": {client.credential.String()},
+	}), nil
+}
+
+func sysError(err error) *RespError {
+	return &RespError{APIError: APIError{
+		Code:    0,
+		Message: err.Error(),
+	}}
+}
+
+func (client *client) do(ctx context.Context, method string, url string, body io.Reader, option ...request.Option) (*http.Response, string, error) {
+	// 获取凭证
+	err := client.UpdateCredential(ctx)
+	if err != nil {
+		return nil, "", sysError(err)
+	}
+
+	opts := []request.Option{
+		request.WithHeader(http.Header{
+			"Authorization": {"Bearer " + client.credential.String()},
+			"Content-Type":  {"application/json; charset=UTF-8"},
+		}),
+		request.WithContext(ctx),
+		request.WithTPSLimit(
+			fmt.Sprintf("policy_%d", client.policy.ID),
+			client.policy.Settings.TPSLimit,
+			client.policy.Settings.TPSLimitBurst,
+		),
+	}
+
+	// 发送请求
+	res := client.httpClient.Request(
+		method,
+		url,
+		body,
+		append(opts, option...)...,
+	)
+
+	if res.Err != nil {
+		return nil, "", sysError(res.Err)
+	}
+
+	respBody, err := res.GetResponse()
+	if err != nil {
+		return nil, "", sysError(err)
+	}
+
+	// 解析请求响应
+	status := res.Response.StatusCode
+	if (status < 200 || status >= 300) && status != statusResumeIncomplete {
+		errResp := &RespError{}
+		if decodeErr := json.Unmarshal([]byte(respBody), errResp); decodeErr != nil || errResp.APIError.Message == "" {
+			client.l.Debug("Google Drive returns unknown response: %s", respBody)
+			errResp.APIError.Message = fmt.Sprintf("unexpected status code %d", status)
+		}
+		errResp.APIError.Code = status
+
+		if status == http.StatusTooManyRequests || (status == http.StatusForbidden && errResp.isRateLimited()) {
+			client.l.Warning("Google Drive request is throttled.")
+			return nil, "", backoff.NewRetryableErrorFromHeader(errResp, res.Response.Header)
+		}
+
+		return nil, "", errResp
+	}
+
+	return res.Response, respBody, nil
+}
+
+func (client *client) request(ctx context.Context, method string, url string, body io.Reader, option ...request.Option) (string, error) {
+	_, respBody, err := client.do(ctx, method, url, body, option...)
+	return respBody, err
+}
+
+func (client *client) requestWithStr(ctx context.Context, method string, url string, body string) (string, error) {
+	// 发送请求
+	bodyReader := io.NopCloser(strings.NewReader(body))
+	return client.request(ctx, method, url, bodyReader,
+		request.WithContentLength(int64(len(body))),
+	)
+}
+
+// isRateLimited 403 错误是否由请求频率限制引起
+func (err *RespError) isRateLimited() bool {
+	for _, e := range err.APIError.Errors {
+		if e.Reason == "rateLimitExceeded" || e.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package googledrive
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	// ErrInvalidRefreshToken 上传策略无有效的RefreshToken
+	ErrInvalidRefreshToken = errors.New("no valid refresh token in this policy")
+	// ErrDeleteFile 无法删除文件
+	ErrDeleteFile = errors.New("cannot delete file")
+	// ErrObjectNotExist 路径对应的文件不存在
+	ErrObjectNotExist = errors.New("object not exist")
+	// ErrObjectExisted 路径对应的文件已存在
+	ErrObjectExisted = errors.New("object already exist")
+	// ErrNoUploadURL 创建上传会话时未返回上传地址
+	ErrNoUploadURL = errors.New("upload session url not found in response")
+)
+
+// RequiredScope 授权时必须获得的权限
+var RequiredScope = []string{
+	"https://www.googleapis.com/auth/drive",
+}
+
+const (
+	// DefaultServer Google API 默认地址
+	DefaultServer = "https://www.googleapis.com"
+	// rootFolder 「我的云端硬盘」根目录的别名
+	rootFolder = "root"
+)
+
+type Client interface {
+	ListChildren(ctx context.Context, path string) ([]File, error)
+	Meta(ctx context.Context, path string) (*File, error)
+	CreateUploadSession(ctx context.Context, dst string, size int64, opts ...Option) (string, error)
+	DeleteUploadSession(ctx context.Context, uploadURL string) error
+	Upload(ctx context.Context, file *fs.UploadRequest) error
+	BatchDelete(ctx context.Context, dst []string) ([]string, error)
+	DownloadURL(ctx context.Context, path string) (string, error)
+	OAuthURL(ctx context.Context, scopes []string) string
+	ObtainToken(ctx context.Context, opts ...Option) (*Credential, error)
+}
+
+// client Google Drive客户端
+type client struct {
+	endpoints  *endpoints
+	policy     *ent.StoragePolicy
+	credential credmanager.Credential
+
+	httpClient request.Client
+	cred       credmanager.CredManager
+	l          logging.Logger
+	settings   setting.Provider
+
+	chunkSize int64
+
+	// 已解析的目录路径到 ID 的映射
+	mu      sync.Mutex
+	folders map[string]string
+}
+
+// endpoints Google Drive客户端相关设置
+type endpoints struct {
+	oAuthEndpoints *oauthEndpoint
+	apiURL         string // 接口请求的基URL
+	uploadURL      string // 上传请求的基URL
+	root           string // 存储根目录 ID
+}
+
+// NewClient 根据存储策略获取新的client
+func NewClient(policy *ent.StoragePolicy, httpClient request.Client, cred credmanager.CredManager,
+	l logging.Logger, settings setting.Provider, chunkSize int64) Client {
+	server := strings.TrimSuffix(policy.Server, "/")
+	if server == "" {
+		server = DefaultServer
+	}
+
+	client := &client{
+		endpoints: &endpoints{
+			oAuthEndpoints: getOAuthEndpoint(),
+			apiURL:         server + "/drive/v3",
+			uploadURL:      server + "/upload/drive/v3",
+			root:           policy.Settings.GDriveRoot,
+		},
+		policy:     policy,
+		httpClient: httpClient,
+		cred:       cred,
+		l:          l,
+		settings:   settings,
+		chunkSize:  chunkSize,
+		folders:    make(map[string]string),
+	}
+
+	if client.endpoints.root == "" {
+		client.endpoints.root = rootFolder
+	}
+
+	return client
+}
@@ -0,0 +1,226 @@
+package googledrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+// Driver Google Drive 适配器
+type (
+	Driver struct {
+		policy    *ent.StoragePolicy
+		client    Client
+		settings  setting.Provider
+		config    conf.ConfigProvider
+		l         logging.Logger
+		chunkSize int64
+	}
+	ListPathRealRootCtx struct{}
+)
+
+var (
+	features = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityUploadSentinelRequired: true,
+	}, features)
+}
+
+// New 从存储策略初始化新的Driver实例
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger, cred credmanager.CredManager) (*Driver, error) {
+	chunkSize := policy.Settings.ChunkSize
+	if policy.Settings.ChunkSize == 0 {
+		chunkSize = 50 << 20 // 50MB
+	}
+
+	// 分片大小必须为 256 KiB 的整数倍
+	chunkSize = max(chunkSize/ChunkSizeUnit, 1) * ChunkSizeUnit
+
+	c := NewClient(policy, request.NewClient(config, request.WithLogger(l)), cred, l, settings, chunkSize)
+
+	return &Driver{
+		policy:    policy,
+		client:    c,
+		settings:  settings,
+		l:         l,
+		config:    config,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// List 列取项目
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	base = strings.Trim(base, "/")
+	// 列取子项目
+	objects, err := handler.client.ListChildren(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取真实的列取起始根目录
+	rootPath := base
+	if realBase, ok := ctx.Value(ListPathRealRootCtx{}).(string); ok {
+		rootPath = realBase
+	} else {
+		ctx = context.WithValue(ctx, ListPathRealRootCtx{}, base)
+	}
+
+	// 整理结果
+	res := make([]fs.PhysicalObject, 0, len(objects))
+	for _, object := range objects {
+		// Google 文档等在线文件无法下载原始内容
+		if !object.IsFolder() && strings.HasPrefix(object.MimeType, "application/vnd.google-apps.") {
+			continue
+		}
+
+		source := path.Join(base, object.Name)
+		rel, err := filepath.Rel(rootPath, source)
+		if err != nil {
+			continue
+		}
+		res = append(res, fs.PhysicalObject{
+			Name:         object.Name,
+			RelativePath: filepath.ToSlash(rel),
+			Source:       source,
+			Size:         object.GetSize(),
+			IsDir:        object.IsFolder(),
+			LastModify:   object.ModifiedTime,
+		})
+	}
+
+	onProgress(len(objects))
+
+	// 递归列取子目录
+	if recursive {
+		for _, object := range objects {
+			if object.IsFolder() {
+				sub, err := handler.List(ctx, path.Join(base, object.Name), onProgress, recursive)
+				if err != nil {
+					handler.l.Warning("Failed to list folder %q: %s", path.Join(base, object.Name), err)
+					continue
+				}
+				res = append(res, sub...)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+// Put 将文件流保存到指定目录
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	return handler.client.Upload(ctx, file)
+}
+
+// Delete 删除一个或多个文件，
+// 返回未删除的文件，及遇到的最后一个错误
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	return handler.client.BatchDelete(ctx, files)
+}
+
+// Thumb 获取文件缩略图，Drive 的缩略图地址需要鉴权，无法直接使用
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source 获取文件内容的URL。地址包含访问令牌，存储策略会强制使用中转，不会返回给客户端。
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return handler.client.DownloadURL(ctx, e.Source())
+}
+
+// Token 获取上传会话URL
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	// 生成回调地址
+	siteURL := handler.settings.SiteURL(setting.UseFirstSiteUrl(ctx))
+	uploadSession.Callback = routes.MasterSlaveCallbackUrl(siteURL, types.PolicyTypeGoogleDrive, uploadSession.Props.UploadSessionID, uploadSession.CallbackSecret).String()
+
+	uploadURL, err := handler.client.CreateUploadSession(ctx, file.Props.SavePath, file.Props.Size)
+	if err != nil {
+		if errors.Is(err, ErrObjectExisted) {
+			return nil, fs.ErrFileExisted.WithError(err)
+		}
+		return nil, err
+	}
+
+	uploadSession.ChunkSize = handler.chunkSize
+	uploadSession.UploadURL = uploadURL
+	return &fs.UploadCredential{
+		ChunkSize:  handler.chunkSize,
+		UploadURLs: []string{uploadURL},
+	}, nil
+}
+
+// CancelToken 取消上传凭证
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return handler.client.DeleteUploadSession(ctx, uploadSession.UploadURL)
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	if session.SentinelTaskID == 0 {
+		return nil
+	}
+
+	// Make sure uploaded file size is correct
+	res, err := handler.client.Meta(ctx, session.Props.SavePath)
+	if err != nil {
+		return fmt.Errorf("failed to get uploaded file size: %w", err)
+	}
+
+	if res.GetSize() != session.Props.Size {
+		return serializer.NewError(
+			serializer.CodeMetaMismatch,
+			fmt.Sprintf("File size not match, expected: %d, actual: %d", session.Props.Size, res.GetSize()),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures:         features,
+		ThumbSupportedExts:     handler.policy.Settings.ThumbExts,
+		ThumbSupportAllExts:    handler.policy.Settings.ThumbSupportAllExts,
+		ThumbMaxSize:           handler.policy.Settings.ThumbMaxSize,
+		ThumbProxy:             handler.policy.Settings.ThumbGeneratorProxy,
+		MediaMetaProxy:         handler.policy.Settings.MediaMetaGeneratorProxy,
+		BrowserRelayedDownload: handler.policy.Settings.StreamSaver,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
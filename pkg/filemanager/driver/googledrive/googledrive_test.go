@@ -0,0 +1,515 @@
+package googledrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/internal/drivertest"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAccessToken = "test_access_token"
+	stubPageSize    = 2
+)
+
+var (
+	queryWithName = regexp.MustCompile(`^name = '([^']*)' and '([^']*)' in parents`)
+	queryChildren = regexp.MustCompile(`^'([^']*)' in parents`)
+	contentRange  = regexp.MustCompile(`^bytes (\d+)-(\d+)/(\d+)$`)
+)
+
+type stubFile struct {
+	File
+	parent  string
+	content []byte
+}
+
+type stubSession struct {
+	name    string
+	parent  string
+	size    int64
+	content []byte
+}
+
+// stubDrive 模拟 Drive API 的最小实现
+type stubDrive struct {
+	mu       sync.Mutex
+	server   *httptest.Server
+	files    map[string]*stubFile
+	sessions map[string]*stubSession
+	nextID   int
+	chunks   []string
+}
+
+func newStubDrive() *stubDrive {
+	d := &stubDrive{
+		files:    make(map[string]*stubFile),
+		sessions: make(map[string]*stubSession),
+	}
+	d.server = httptest.NewServer(http.HandlerFunc(d.serve))
+	return d
+}
+
+func (d *stubDrive) add(parent, name, mimeType string, content []byte) string {
+	d.nextID++
+	id := fmt.Sprintf("id%d", d.nextID)
+	f := &stubFile{
+		File: File{
+			ID:           id,
+			Name:         name,
+			MimeType:     mimeType,
+			ModifiedTime: time.Now().UTC(),
+		},
+		parent:  parent,
+		content: content,
+	}
+	if mimeType != folderMimeType {
+		f.Size = strconv.Itoa(len(content))
+	}
+	d.files[id] = f
+	return id
+}
+
+func (d *stubDrive) children(parent string) []File {
+	res := make([]File, 0)
+	for _, f := range d.files {
+		if f.parent == parent {
+			res = append(res, f.File)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+func (d *stubDrive) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (d *stubDrive) writeError(w http.ResponseWriter, status int, msg string) {
+	d.writeJSON(w, status, RespError{APIError: APIError{Code: status, Message: msg}})
+}
+
+func (d *stubDrive) serve(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// 上传会话地址不需要鉴权
+	if strings.HasPrefix(r.URL.Path, "/session/") {
+		d.serveSession(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+		d.writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/drive/v3/files":
+		d.serveList(w, r)
+	case r.Method == "POST" && r.URL.Path == "/drive/v3/files":
+		var body struct {
+			Name     string   `json:"name"`
+			MimeType string   `json:"mimeType"`
+			Parents  []string `json:"parents"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Parents) != 1 {
+			d.writeError(w, http.StatusBadRequest, "invalid body")
+			return
+		}
+		id := d.add(body.Parents[0], body.Name, body.MimeType, nil)
+		d.writeJSON(w, http.StatusOK, d.files[id].File)
+	case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/drive/v3/files/"):
+		id := strings.TrimPrefix(r.URL.Path, "/drive/v3/files/")
+		if _, ok := d.files[id]; !ok {
+			d.writeError(w, http.StatusNotFound, "file not found")
+			return
+		}
+		delete(d.files, id)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "POST" && r.URL.Path == "/upload/drive/v3/files":
+		var body struct {
+			Name    string   `json:"name"`
+			Parents []string `json:"parents"`
+		}
+		if r.URL.Query().Get("uploadType") != "resumable" || json.NewDecoder(r.Body).Decode(&body) != nil {
+			d.writeError(w, http.StatusBadRequest, "invalid upload request")
+			return
+		}
+		size, _ := strconv.ParseInt(r.Header.Get("X-Upload-Content-Length"), 10, 64)
+		d.nextID++
+		sessionID := strconv.Itoa(d.nextID)
+		d.sessions[sessionID] = &stubSession{name: body.Name, parent: body.Parents[0], size: size}
+		w.Header().Set("Location", d.server.URL+"/session/"+sessionID)
+		w.WriteHeader(http.StatusOK)
+	default:
+		d.writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (d *stubDrive) serveList(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query().Get("q")
+	if match := queryWithName.FindStringSubmatch(q); match != nil {
+		res := ListResponse{Files: make([]File, 0)}
+		for _, f := range d.children(match[2]) {
+			if f.Name == match[1] {
+				res.Files = append(res.Files, f)
+			}
+		}
+		d.writeJSON(w, http.StatusOK, res)
+		return
+	}
+
+	match := queryChildren.FindStringSubmatch(q)
+	if match == nil {
+		d.writeError(w, http.StatusBadRequest, "invalid query")
+		return
+	}
+
+	all := d.children(match[1])
+	offset, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	end := min(offset+stubPageSize, len(all))
+	res := ListResponse{Files: all[offset:end]}
+	if end < len(all) {
+		res.NextPageToken = strconv.Itoa(end)
+	}
+	d.writeJSON(w, http.StatusOK, res)
+}
+
+func (d *stubDrive) serveSession(w http.ResponseWriter, r *http.Request) {
+	sessionID := strings.TrimPrefix(r.URL.Path, "/session/")
+	session, ok := d.sessions[sessionID]
+	if !ok {
+		d.writeError(w, http.StatusNotFound, "session not found")
+		return
+	}
+
+	if r.Header.Get("Authorization") != "" {
+		d.writeError(w, http.StatusBadRequest, "unexpected authorization header")
+		return
+	}
+
+	if r.Method == "DELETE" {
+		delete(d.sessions, sessionID)
+		w.WriteHeader(499)
+		return
+	}
+
+	rangeHeader := r.Header.Get("Content-Range")
+	match := contentRange.FindStringSubmatch(rangeHeader)
+	if match == nil {
+		d.writeError(w, http.StatusBadRequest, "invalid content range")
+		return
+	}
+	d.chunks = append(d.chunks, rangeHeader)
+
+	content, _ := io.ReadAll(r.Body)
+	session.content = append(session.content, content...)
+	end, _ := strconv.ParseInt(match[2], 10, 64)
+	if end+1 < session.size {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", end))
+		w.WriteHeader(statusResumeIncomplete)
+		return
+	}
+
+	delete(d.sessions, sessionID)
+	id := d.add(session.parent, session.name, "application/octet-stream", session.content)
+	d.writeJSON(w, http.StatusOK, d.files[id].File)
+}
+
+func newTestClient(t *testing.T, d *stubDrive) *client {
+	l := logging.NewConsoleLogger(logging.LevelError)
+	cred := credmanager.New(cache.NewMemoStore("", l))
+	if err := cred.Upsert(context.Background(), &Credential{
+		AccessToken: testAccessToken,
+		ExpiresIn:   time.Now().Add(time.Hour).Unix(),
+		PolicyID:    1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &ent.StoragePolicy{
+		ID:       1,
+		Type:     types.PolicyTypeGoogleDrive,
+		Server:   d.server.URL,
+		Settings: &types.PolicySetting{},
+	}
+
+	return NewClient(policy, request.NewClient(drivertest.Config{}), cred, l, drivertest.Settings{}, ChunkSizeUnit).(*client)
+}
+
+func TestClient_ResolveFolder(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	// 不存在的目录
+	{
+		_, err := c.resolveFolder(context.Background(), "a/b", false)
+		asserts.ErrorIs(err, ErrObjectNotExist)
+	}
+
+	// 自动创建目录，并使用缓存
+	{
+		id, err := c.resolveFolder(context.Background(), "a/b", true)
+		asserts.NoError(err)
+		asserts.Equal(folderMimeType, d.files[id].MimeType)
+		asserts.Equal("b", d.files[id].Name)
+		asserts.Len(d.files, 2)
+		asserts.Equal(id, c.folders["a/b"])
+
+		again, err := c.resolveFolder(context.Background(), "/a/b/", true)
+		asserts.NoError(err)
+		asserts.Equal(id, again)
+		asserts.Len(d.files, 2)
+	}
+
+	// 路径中的文件不能作为目录
+	{
+		d.add(rootFolder, "file", "text/plain", []byte("1"))
+		_, err := c.resolveFolder(context.Background(), "file/sub", true)
+		asserts.ErrorIs(err, ErrObjectNotExist)
+	}
+}
+
+func TestClient_ListChildren(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	dir := d.add(rootFolder, "dir", folderMimeType, nil)
+	d.add(dir, "1.txt", "text/plain", []byte("1"))
+	d.add(dir, "2.txt", "text/plain", []byte("22"))
+	d.add(dir, "3.txt", "text/plain", []byte("333"))
+
+	files, err := c.ListChildren(context.Background(), "dir")
+	asserts.NoError(err)
+	asserts.Len(files, 3)
+	asserts.Equal("3.txt", files[2].Name)
+	asserts.EqualValues(3, files[2].GetSize())
+
+	_, err = c.ListChildren(context.Background(), "not_exist")
+	asserts.ErrorIs(err, ErrObjectNotExist)
+}
+
+func TestDriver_List(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	handler := &Driver{client: newTestClient(t, d), l: logging.NewConsoleLogger(logging.LevelError)}
+
+	dir := d.add(rootFolder, "dir", folderMimeType, nil)
+	d.add(dir, "1.txt", "text/plain", []byte("1"))
+	d.add(dir, "doc", "application/vnd.google-apps.document", nil)
+	sub := d.add(dir, "sub", folderMimeType, nil)
+	d.add(sub, "2.txt", "text/plain", []byte("22"))
+
+	progress := 0
+	objects, err := handler.List(context.Background(), "/dir", func(i int) {
+		progress += i
+	}, true)
+	asserts.NoError(err)
+	asserts.Equal(4, progress)
+	asserts.Len(objects, 3)
+
+	paths := make(map[string]fs.PhysicalObject)
+	for _, o := range objects {
+		paths[o.RelativePath] = o
+	}
+	asserts.Contains(paths, "1.txt")
+	asserts.Contains(paths, "sub")
+	asserts.True(paths["sub"].IsDir)
+	asserts.Equal("dir/sub/2.txt", paths["sub/2.txt"].Source)
+	asserts.EqualValues(2, paths["sub/2.txt"].Size)
+}
+
+func TestClient_Upload(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	content := bytes.Repeat([]byte("a"), int(ChunkSizeUnit)+10)
+	upload := func(dst string, data []byte, mode fs.WriteMode) error {
+		return drivertest.Upload(c.Upload, dst, data, mode)
+	}
+
+	// 分片上传
+	{
+		asserts.NoError(upload("a/b/file.bin", content, 0))
+		asserts.Equal([]string{
+			fmt.Sprintf("bytes 0-%d/%d", ChunkSizeUnit-1, len(content)),
+			fmt.Sprintf("bytes %d-%d/%d", ChunkSizeUnit, len(content)-1, len(content)),
+		}, d.chunks)
+
+		meta, err := c.Meta(context.Background(), "a/b/file.bin")
+		asserts.NoError(err)
+		asserts.EqualValues(len(content), meta.GetSize())
+		asserts.Equal(content, d.files[meta.ID].content)
+	}
+
+	// 文件已存在
+	{
+		err := upload("a/b/file.bin", content, 0)
+		asserts.ErrorIs(err, ErrObjectExisted)
+	}
+
+	// 空文件
+	{
+		asserts.NoError(upload("a/empty", nil, 0))
+		meta, err := c.Meta(context.Background(), "a/empty")
+		asserts.NoError(err)
+		asserts.EqualValues(0, meta.GetSize())
+	}
+
+	// 覆盖空文件
+	{
+		asserts.NoError(upload("a/empty", nil, fs.ModeOverwrite))
+		files, err := c.ListChildren(context.Background(), "a")
+		asserts.NoError(err)
+		asserts.Len(files, 2)
+	}
+}
+
+func TestClient_UploadSession(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	uploadURL, err := c.CreateUploadSession(context.Background(), "dir/file", 100)
+	asserts.NoError(err)
+	asserts.True(strings.HasPrefix(uploadURL, d.server.URL+"/session/"))
+	asserts.Len(d.sessions, 1)
+
+	asserts.NoError(c.DeleteUploadSession(context.Background(), uploadURL))
+	asserts.Len(d.sessions, 0)
+}
+
+func TestClient_BatchDelete(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	dir := d.add(rootFolder, "dir", folderMimeType, nil)
+	d.add(dir, "1.txt", "text/plain", []byte("1"))
+	d.add(dir, "2.txt", "text/plain", []byte("2"))
+
+	failed, err := c.BatchDelete(context.Background(), []string{"dir/1.txt", "dir/2.txt", "dir/3.txt", "not_exist/1.txt"})
+	asserts.NoError(err)
+	asserts.Empty(failed)
+	asserts.Len(d.files, 1)
+}
+
+func TestClient_DownloadURL(t *testing.T) {
+	asserts := assert.New(t)
+	d := newStubDrive()
+	defer d.server.Close()
+	c := newTestClient(t, d)
+
+	dir := d.add(rootFolder, "dir", folderMimeType, nil)
+	id := d.add(dir, "1.txt", "text/plain", []byte("1"))
+
+	res, err := c.DownloadURL(context.Background(), "dir/1.txt")
+	asserts.NoError(err)
+	u, err := url.Parse(res)
+	asserts.NoError(err)
+	asserts.Equal("/drive/v3/files/"+id, u.Path)
+	asserts.Equal("media", u.Query().Get("alt"))
+	asserts.Equal(testAccessToken, u.Query().Get("access_token"))
+
+	_, err = c.DownloadURL(context.Background(), "dir")
+	asserts.ErrorIs(err, ErrObjectNotExist)
+
+	_, err = c.DownloadURL(context.Background(), "dir/2.txt")
+	asserts.ErrorIs(err, ErrObjectNotExist)
+}
+
+func TestObtainToken(t *testing.T) {
+	asserts := assert.New(t)
+	var resp string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Unauthorized"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	args := func(code, secret string) *obtainTokenArgs {
+		return &obtainTokenArgs{
+			clientId:      "client",
+			secret:        secret,
+			code:          code,
+			refreshToken:  "refresh",
+			client:        request.NewClient(drivertest.Config{}),
+			tokenEndpoint: server.URL,
+			policyID:      1,
+		}
+	}
+
+	// 授权码兑换
+	{
+		resp = `{"access_token":"at","refresh_token":"rt","expires_in":3599,"scope":"https://www.googleapis.com/auth/drive"}`
+		cred, err := obtainToken(context.Background(), args("code", "secret"))
+		asserts.NoError(err)
+		asserts.Equal("rt", cred.RefreshToken)
+		asserts.Equal(1, cred.PolicyID)
+		asserts.True(cred.Expiry().Before(time.Now()))
+	}
+
+	// 未授予必须的权限
+	{
+		resp = `{"access_token":"at","refresh_token":"rt","expires_in":3599,"scope":"https://www.googleapis.com/auth/drive.file"}`
+		_, err := obtainToken(context.Background(), args("code", "secret"))
+		asserts.ErrorContains(err, "missing required scope")
+	}
+
+	// 刷新令牌，响应中不包含 refresh_token
+	{
+		resp = `{"access_token":"at2","expires_in":3599,"scope":"https://www.googleapis.com/auth/drive"}`
+		cred, err := obtainToken(context.Background(), args("", "secret"))
+		asserts.NoError(err)
+		asserts.Equal("at2", cred.AccessToken)
+		asserts.Empty(cred.RefreshToken)
+		asserts.True(cred.Expiry().After(time.Now()))
+	}
+
+	// OAuth 错误
+	{
+		_, err := obtainToken(context.Background(), args("", "wrong"))
+		asserts.ErrorContains(err, "Unauthorized")
+	}
+}
@@ -0,0 +1,76 @@
+package googledrive
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RespError 接口返回错误
+type RespError struct {
+	APIError APIError `json:"error"`
+}
+
+// APIError 接口返回的错误内容
+type APIError struct {
+	Code    int           `json:"code"`
+	Message string        `json:"message"`
+	Errors  []ErrorDetail `json:"errors,omitempty"`
+}
+
+// ErrorDetail 错误详情
+type ErrorDetail struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// File 文件元信息
+type File struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	MimeType     string    `json:"mimeType"`
+	Size         string    `json:"size,omitempty"`
+	ModifiedTime time.Time `json:"modifiedTime"`
+}
+
+// ListResponse 列取子项目响应
+type ListResponse struct {
+	Files         []File `json:"files"`
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// oauthEndpoint OAuth接口地址
+type oauthEndpoint struct {
+	token     url.URL
+	authorize url.URL
+}
+
+// OAuthError OAuth相关接口的错误响应
+type OAuthError struct {
+	ErrorType        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Error 实现error接口
+func (err RespError) Error() string {
+	return err.APIError.Message
+}
+
+// Error 实现error接口
+func (err OAuthError) Error() string {
+	if err.ErrorDescription == "" {
+		return err.ErrorType
+	}
+	return err.ErrorDescription
+}
+
+// IsFolder 是否为目录
+func (f *File) IsFolder() bool {
+	return f.MimeType == folderMimeType
+}
+
+// GetSize 获取文件大小，Google 文档等在线文件没有大小
+func (f *File) GetSize() int64 {
+	size, _ := strconv.ParseInt(f.Size, 10, 64)
+	return size
+}
@@ -3,6 +3,7 @@ package driver
 import (
 	"context"
 	"encoding/gob"
+	"io"
 	"os"
 	"time"
 
@@ -21,6 +22,9 @@ const (
 	// to delete the placeholder file and cancel the upload session if upload callback is not made after upload
 	// session expire.
 	HandlerCapabilityUploadSentinelRequired
+	// HandlerCapabilityStreamGet this handler cannot generate URLs for file content, content can only be
+	// read as a stream through StreamOpener. Cloudreve will always proxy the file content.
+	HandlerCapabilityStreamGet
 )
 
 type (
@@ -86,6 +90,12 @@ type (
 		MediaMeta(ctx context.Context, path, ext, language string) ([]MediaMeta, error)
 	}
 
+	// StreamOpener is implemented by handlers with HandlerCapabilityStreamGet capability.
+	StreamOpener interface {
+		// OpenStream opens a read stream of given file, starting from offset.
+		OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error)
+	}
+
 	Capabilities struct {
 		StaticFeatures *boolset.BooleanSet
 		// MaxSourceExpire indicates the maximum allowed expiration duration of a source URL
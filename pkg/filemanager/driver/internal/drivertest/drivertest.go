@@ -0,0 +1,68 @@
+// Package drivertest 存储驱动测试共用的配置桩与读写辅助方法
+package drivertest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+// Config 主机模式的配置文件桩，其余配置项未实现
+type Config struct {
+	conf.ConfigProvider
+}
+
+func (Config) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+// Settings 上传相关设置桩：分片不重试、不使用缓冲区，其余设置项未实现
+type Settings struct {
+	setting.Provider
+}
+
+func (Settings) ChunkRetryLimit(ctx context.Context) int {
+	return 0
+}
+
+func (Settings) UseChunkBuffer(ctx context.Context) bool {
+	return false
+}
+
+func (Settings) TempPath(ctx context.Context) string {
+	return os.TempDir()
+}
+
+// UploadRequest 构造上传 data 到 dst 的请求
+func UploadRequest(dst string, data []byte, mode fs.WriteMode) *fs.UploadRequest {
+	return &fs.UploadRequest{
+		Props: &fs.UploadProps{SavePath: dst, Size: int64(len(data))},
+		Mode:  mode,
+		File:  io.NopCloser(bytes.NewReader(data)),
+	}
+}
+
+// Upload 使用 put（通常为驱动的 Put 方法）上传 data 到 dst
+func Upload(put func(ctx context.Context, file *fs.UploadRequest) error, dst string, data []byte, mode fs.WriteMode) error {
+	return put(context.Background(), UploadRequest(dst, data, mode))
+}
+
+// ReadAll 使用 open（通常为驱动的 OpenStream 方法）从 offset 处读取文件全部内容
+func ReadAll(t *testing.T, open func(ctx context.Context, path string, offset int64) (io.ReadCloser, error), p string, offset int64) []byte {
+	stream, err := open(context.Background(), p, offset)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer stream.Close()
+
+	content, err := io.ReadAll(stream)
+	assert.NoError(t, err)
+	return content
+}
@@ -0,0 +1,414 @@
+package sftp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/jlaffaye/ftp"
+	gosftp "github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	ProtocolSftp = "sftp"
+	ProtocolFtp  = "ftp"
+	ProtocolFtps = "ftps"
+
+	dialTimeout = 30 * time.Second
+)
+
+// ErrHostKeyRequired SFTP 协议必须设置服务器公钥，未设置时拒绝连接
+var ErrHostKeyRequired = errors.New("host key of sftp server is not set")
+
+// conn 与存储端之间的单个连接，不可并发使用
+type conn interface {
+	// Stat 获取文件信息，文件不存在时返回 os.ErrNotExist
+	Stat(path string) (os.FileInfo, error)
+	// ReadDir 列取目录下的直接子项目
+	ReadDir(path string) ([]os.FileInfo, error)
+	// MkdirAll 递归创建目录
+	MkdirAll(path string) error
+	// WriteAt 从 offset 处开始写入文件，offset 为 0 时清空已有内容
+	WriteAt(path string, r io.Reader, offset int64) error
+	// OpenAt 从 offset 处开始读取文件，返回的流关闭前连接不可用于其他操作
+	OpenAt(path string, offset int64) (io.ReadCloser, error)
+	// Remove 删除文件，文件不存在时返回 os.ErrNotExist
+	Remove(path string) error
+	Close() error
+}
+
+// dialFunc 建立新的连接
+type dialFunc func(ctx context.Context) (conn, error)
+
+// newDialer 根据存储策略返回建立连接的方法
+func newDialer(policy *ent.StoragePolicy) (dialFunc, error) {
+	protocol := policy.Settings.SftpProtocol
+	if protocol == "" {
+		protocol = ProtocolSftp
+	}
+
+	switch protocol {
+	case ProtocolSftp:
+		config, err := sshConfig(policy)
+		if err != nil {
+			return nil, err
+		}
+
+		addr := withDefaultPort(policy.Server, "22")
+		return func(ctx context.Context) (conn, error) {
+			return dialSftp(ctx, addr, config)
+		}, nil
+	case ProtocolFtp, ProtocolFtps:
+		addr := withDefaultPort(policy.Server, "21")
+		opts := []ftp.DialOption{ftp.DialWithTimeout(dialTimeout)}
+		if protocol == ProtocolFtps {
+			host, _, _ := net.SplitHostPort(addr)
+			opts = append(opts, ftp.DialWithExplicitTLS(&tls.Config{ServerName: host}))
+		}
+
+		return func(ctx context.Context) (conn, error) {
+			return dialFtp(ctx, addr, policy.AccessKey, policy.SecretKey, opts)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown protocol %q", protocol)
+	}
+}
+
+// withDefaultPort 为未指定端口的地址添加默认端口
+func withDefaultPort(server, port string) string {
+	server = strings.TrimSpace(server)
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+
+	return net.JoinHostPort(strings.Trim(server, "[]"), port)
+}
+
+// sshConfig 返回校验服务器公钥的 SSH 连接配置
+func sshConfig(policy *ent.StoragePolicy) (*ssh.ClientConfig, error) {
+	if policy.Settings.SftpHostKey == "" {
+		return nil, ErrHostKeyRequired
+	}
+
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(policy.Settings.SftpHostKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse host key: %w", err)
+	}
+
+	config, err := sshAuthConfig(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	config.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	return config, nil
+}
+
+// ScanHostKey 连接 SFTP 服务器并完成登录，返回服务器公钥，
+// 仅用于管理员测试连接时获取公钥，确认后保存到存储策略中
+func ScanHostKey(ctx context.Context, policy *ent.StoragePolicy) (ssh.PublicKey, error) {
+	config, err := sshAuthConfig(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostKey ssh.PublicKey
+	config.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		hostKey = key
+		return nil
+	}
+
+	c, err := dialSftp(ctx, withDefaultPort(policy.Server, "22"), config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Close()
+	return hostKey, nil
+}
+
+// sshAuthConfig 返回仅包含登录凭证的 SSH 连接配置
+func sshAuthConfig(policy *ent.StoragePolicy) (*ssh.ClientConfig, error) {
+	config := &ssh.ClientConfig{
+		User:    policy.AccessKey,
+		Timeout: dialTimeout,
+	}
+
+	// 优先使用私钥登录，此时 SecretKey 为私钥密码
+	if policy.Settings.SftpPrivateKey != "" {
+		var (
+			signer ssh.Signer
+			err    error
+		)
+		if policy.SecretKey != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(policy.Settings.SftpPrivateKey), []byte(policy.SecretKey))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(policy.Settings.SftpPrivateKey))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+
+		config.Auth = []ssh.AuthMethod{ssh.PublicKeys(signer)}
+	} else {
+		config.Auth = []ssh.AuthMethod{ssh.Password(policy.SecretKey)}
+	}
+
+	return config, nil
+}
+
+func dialSftp(ctx context.Context, addr string, config *ssh.ClientConfig) (conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %q: %w", addr, err)
+	}
+
+	// 限制握手时间
+	_ = netConn.SetDeadline(time.Now().Add(dialTimeout))
+	c, chans, reqs, err := ssh.NewClientConn(netConn, addr, config)
+	if err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("failed to establish ssh connection: %w", err)
+	}
+	_ = netConn.SetDeadline(time.Time{})
+
+	sshClient := ssh.NewClient(c, chans, reqs)
+	client, err := gosftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("failed to start sftp session: %w", err)
+	}
+
+	return &sftpConn{client: client, closer: sshClient}, nil
+}
+
+// sftpConn SFTP 连接
+type sftpConn struct {
+	client *gosftp.Client
+	closer io.Closer
+}
+
+func (c *sftpConn) Stat(path string) (os.FileInfo, error) {
+	return c.client.Stat(path)
+}
+
+func (c *sftpConn) ReadDir(path string) ([]os.FileInfo, error) {
+	return c.client.ReadDir(path)
+}
+
+func (c *sftpConn) MkdirAll(path string) error {
+	return c.client.MkdirAll(path)
+}
+
+func (c *sftpConn) WriteAt(path string, r io.Reader, offset int64) error {
+	flags := os.O_WRONLY | os.O_CREATE
+	if offset == 0 {
+		flags |= os.O_TRUNC
+	}
+
+	f, err := c.client.OpenFile(path, flags)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to seek to offset %d: %w", offset, err)
+	}
+
+	if _, err := f.ReadFrom(r); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func (c *sftpConn) OpenAt(path string, offset int64) (io.ReadCloser, error) {
+	f, err := c.client.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to seek to offset %d: %w", offset, err)
+		}
+	}
+
+	return f, nil
+}
+
+func (c *sftpConn) Remove(path string) error {
+	return c.client.Remove(path)
+}
+
+func (c *sftpConn) Close() error {
+	err := c.client.Close()
+	if c.closer != nil {
+		if closeErr := c.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func dialFtp(ctx context.Context, addr, user, password string, opts []ftp.DialOption) (conn, error) {
+	client, err := ftp.Dial(addr, append([]ftp.DialOption{ftp.DialWithContext(ctx)}, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %q: %w", addr, err)
+	}
+
+	if user == "" {
+		user = "anonymous"
+	}
+
+	if err := client.Login(user, password); err != nil {
+		client.Quit()
+		return nil, fmt.Errorf("failed to login: %w", err)
+	}
+
+	return &ftpConn{client: client}, nil
+}
+
+// ftpConn FTP 连接
+type ftpConn struct {
+	client *ftp.ServerConn
+}
+
+// fileInfo 将 FTP 列表项转换为 os.FileInfo
+type fileInfo struct {
+	entry *ftp.Entry
+}
+
+func (i fileInfo) Name() string       { return i.entry.Name }
+func (i fileInfo) Size() int64        { return int64(i.entry.Size) }
+func (i fileInfo) ModTime() time.Time { return i.entry.Time }
+func (i fileInfo) IsDir() bool        { return i.entry.Type == ftp.EntryTypeFolder }
+func (i fileInfo) Sys() any           { return i.entry }
+func (i fileInfo) Mode() os.FileMode {
+	if i.IsDir() {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+func (c *ftpConn) Stat(p string) (os.FileInfo, error) {
+	dir, name := path.Split(strings.TrimSuffix(p, "/"))
+	if name == "" {
+		// 根目录
+		return fileInfo{entry: &ftp.Entry{Name: "/", Type: ftp.EntryTypeFolder}}, nil
+	}
+
+	if dir == "" {
+		dir = "."
+	}
+
+	// 并非所有服务器都支持 MLST，从父目录的列表中查找
+	entries, err := c.ReadDir(dir)
+	if err != nil {
+		if isFtpNotFound(err) {
+			return nil, &os.PathError{Op: "stat", Path: p, Err: os.ErrNotExist}
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == name {
+			return entry, nil
+		}
+	}
+
+	return nil, &os.PathError{Op: "stat", Path: p, Err: os.ErrNotExist}
+}
+
+func (c *ftpConn) ReadDir(p string) ([]os.FileInfo, error) {
+	entries, err := c.client.List(p)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]os.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+		res = append(res, fileInfo{entry: entry})
+	}
+
+	return res, nil
+}
+
+func (c *ftpConn) MkdirAll(p string) error {
+	names := strings.Split(strings.Trim(p, "/"), "/")
+	current := ""
+	if strings.HasPrefix(p, "/") {
+		current = "/"
+	}
+
+	for _, name := range names {
+		if name == "" || name == "." {
+			continue
+		}
+
+		current = path.Join(current, name)
+		if err := c.client.MakeDir(current); err != nil {
+			// 目录可能已存在
+			info, statErr := c.Stat(current)
+			if statErr != nil || !info.IsDir() {
+				return fmt.Errorf("failed to create directory %q: %w", current, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *ftpConn) WriteAt(path string, r io.Reader, offset int64) error {
+	return c.client.StorFrom(path, r, uint64(offset))
+}
+
+func (c *ftpConn) OpenAt(path string, offset int64) (io.ReadCloser, error) {
+	res, err := c.client.RetrFrom(path, uint64(offset))
+	if err != nil {
+		if isFtpNotFound(err) {
+			return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ftpConn) Remove(path string) error {
+	if err := c.client.Delete(path); err != nil {
+		// 550 也可能由权限不足引起，需要确认文件是否存在
+		if _, statErr := c.Stat(path); errors.Is(statErr, os.ErrNotExist) {
+			return statErr
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *ftpConn) Close() error {
+	return c.client.Quit()
+}
+
+func isFtpNotFound(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code == ftp.StatusFileUnavailable
+}
@@ -0,0 +1,190 @@
+package sftp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+)
+
+const (
+	defaultMaxConn = 4
+	// idleTimeout 空闲超过此时间的连接可能已被服务端断开，不再复用
+	idleTimeout = 5 * time.Minute
+)
+
+var (
+	poolsMu sync.Mutex
+	// pools 每个存储策略对应的连接池
+	pools = make(map[int]*pool)
+)
+
+type (
+	// pool 连接池，限制同一存储策略的最大连接数
+	pool struct {
+		dial        dialFunc
+		fingerprint string
+		sem         chan struct{}
+
+		mu     sync.Mutex
+		idle   []idleConn
+		closed bool
+	}
+
+	idleConn struct {
+		conn
+		since time.Time
+	}
+)
+
+func newPool(dial dialFunc, maxConn int, fingerprint string) *pool {
+	if maxConn <= 0 {
+		maxConn = defaultMaxConn
+	}
+
+	return &pool{
+		dial:        dial,
+		fingerprint: fingerprint,
+		sem:         make(chan struct{}, maxConn),
+	}
+}
+
+// getPool 获取存储策略对应的连接池，连接设置变更后会替换旧的连接池
+func getPool(policy *ent.StoragePolicy) (*pool, error) {
+	fingerprint := poolFingerprint(policy)
+
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	existing, ok := pools[policy.ID]
+	if ok && existing.fingerprint == fingerprint {
+		return existing, nil
+	}
+
+	dial, err := newDialer(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		existing.close()
+	}
+
+	p := newPool(dial, policy.Settings.SftpMaxConn, fingerprint)
+	pools[policy.ID] = p
+	return p, nil
+}
+
+func poolFingerprint(policy *ent.StoragePolicy) string {
+	h := sha256.New()
+	for _, field := range []string{
+		policy.Server,
+		policy.AccessKey,
+		policy.SecretKey,
+		policy.Settings.SftpProtocol,
+		policy.Settings.SftpPrivateKey,
+		policy.Settings.SftpHostKey,
+		fmt.Sprint(policy.Settings.SftpMaxConn),
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// acquire 获取一个可用连接，使用完毕后必须调用 release 归还
+func (p *pool) acquire(ctx context.Context) (conn, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mu.Lock()
+	for len(p.idle) > 0 {
+		c := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if time.Since(c.since) < idleTimeout {
+			p.mu.Unlock()
+			return c.conn, nil
+		}
+
+		c.Close()
+	}
+	p.mu.Unlock()
+
+	c, err := p.dial(ctx)
+	if err != nil {
+		<-p.sem
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// release 归还连接，操作出错时连接可能已不可用，直接关闭
+func (p *pool) release(c conn, err error) {
+	defer func() { <-p.sem }()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed || (err != nil && !isFileError(err)) {
+		c.Close()
+		return
+	}
+
+	p.idle = append(p.idle, idleConn{conn: c, since: time.Now()})
+}
+
+// do 使用连接池中的连接执行操作
+func (p *pool) do(ctx context.Context, f func(c conn) error) error {
+	c, err := p.acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	err = f(c)
+	p.release(c, err)
+	return err
+}
+
+// close 关闭所有空闲连接，使用中的连接归还时关闭
+func (p *pool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, c := range p.idle {
+		c.Close()
+	}
+	p.idle = nil
+}
+
+// isFileError 错误是否仅与文件本身有关，此时连接仍然可用
+func isFileError(err error) bool {
+	return errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrExist) || errors.Is(err, os.ErrPermission)
+}
+
+// pooledReader 读取流关闭时归还连接
+type pooledReader struct {
+	io.ReadCloser
+	once    sync.Once
+	release func(err error)
+}
+
+func (r *pooledReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(func() {
+		r.release(err)
+	})
+	return err
+}
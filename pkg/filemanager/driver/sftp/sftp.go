@@ -0,0 +1,273 @@
+package sftp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	defaultChunkSize = 25 << 20 // 25MB
+	chunkRetrySleep  = time.Second * 5
+)
+
+// Driver SFTP / FTP 适配器
+type Driver struct {
+	policy    *ent.StoragePolicy
+	pool      *pool
+	settings  setting.Provider
+	config    conf.ConfigProvider
+	l         logging.Logger
+	chunkSize int64
+}
+
+var (
+	features = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityStreamGet: true,
+	}, features)
+}
+
+// New 从存储策略初始化新的Driver实例，同一存储策略的实例共用连接池
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger) (*Driver, error) {
+	p, err := getPool(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize connection pool: %w", err)
+	}
+
+	chunkSize := policy.Settings.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	return &Driver{
+		policy:    policy,
+		pool:      p,
+		settings:  settings,
+		config:    config,
+		l:         l,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// remotePath 获取文件在存储端的完整路径
+func (handler *Driver) remotePath(p string) string {
+	if handler.policy.Settings.SftpRoot == "" {
+		return p
+	}
+
+	return path.Join(handler.policy.Settings.SftpRoot, p)
+}
+
+// List 列取项目
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	if base == "" {
+		base = "."
+	}
+
+	var res []fs.PhysicalObject
+	err := handler.pool.do(ctx, func(c conn) error {
+		// 待列取的目录，相对于 base
+		pending := []string{""}
+		for len(pending) > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			rel := pending[0]
+			pending = pending[1:]
+			entries, err := c.ReadDir(handler.remotePath(path.Join(base, rel)))
+			if err != nil {
+				if rel == "" {
+					return err
+				}
+
+				handler.l.Warning("Failed to list folder %q: %s", path.Join(base, rel), err)
+				continue
+			}
+
+			for _, entry := range entries {
+				relPath := path.Join(rel, entry.Name())
+				res = append(res, fs.PhysicalObject{
+					Name:         entry.Name(),
+					RelativePath: relPath,
+					Source:       path.Join(base, relPath),
+					Size:         entry.Size(),
+					IsDir:        entry.IsDir(),
+					LastModify:   entry.ModTime(),
+				})
+				onProgress(1)
+
+				if recursive && entry.IsDir() {
+					pending = append(pending, relPath)
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+// OpenStream 从 offset 处开始读取文件，读取流关闭前占用一个连接
+func (handler *Driver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	c, err := handler.pool.acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	stream, err := c.OpenAt(handler.remotePath(path), offset)
+	if err != nil {
+		handler.pool.release(c, err)
+		return nil, err
+	}
+
+	return &pooledReader{
+		ReadCloser: stream,
+		release: func(err error) {
+			handler.pool.release(c, err)
+		},
+	}, nil
+}
+
+// Put 将文件流保存到指定目录
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+	dst := handler.remotePath(file.Props.SavePath)
+	overwrite := file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+
+	// 如果非 Overwrite，则检查是否有重名冲突
+	var existed bool
+	err := handler.pool.do(ctx, func(c conn) error {
+		if !overwrite {
+			_, err := c.Stat(dst)
+			if err == nil {
+				existed = true
+				return nil
+			}
+
+			if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+
+		return c.MkdirAll(path.Dir(dst))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to prepare file directory: %w", err)
+	}
+
+	if existed {
+		handler.l.Warning("File with the same name existed or unavailable: %s", dst)
+		return fs.ErrFileExisted
+	}
+
+	// Initial chunk groups
+	chunks := chunk.NewChunkGroup(file, handler.chunkSize, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		return handler.pool.do(ctx, func(c conn) error {
+			return c.WriteAt(dst, content, current.Start())
+		})
+	}
+
+	// upload chunks
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+		}
+	}
+
+	return nil
+}
+
+// Delete 删除一个或多个文件，
+// 返回未删除的文件，及遇到的最后一个错误
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var lastErr error
+
+	for _, file := range files {
+		err := handler.pool.do(ctx, func(c conn) error {
+			return c.Remove(handler.remotePath(file))
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			handler.l.Warning("Failed to delete file %q: %s", file, err)
+			failed = append(failed, file)
+			lastErr = err
+		}
+	}
+
+	return failed, lastErr
+}
+
+// Thumb 获取文件缩略图
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source 获取文件外链，文件内容只能由 Cloudreve 中转
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Token 获取上传凭证，客户端无法直接上传至存储端，只支持中转上传
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	return nil, serializer.NewError(serializer.CodePolicyNotAllowed, "Direct upload is not supported, please enable relay upload for this storage policy", nil)
+}
+
+// CancelToken 取消上传凭证
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures:         features,
+		ThumbProxy:             handler.policy.Settings.ThumbGeneratorProxy,
+		ThumbMaxSize:           handler.policy.Settings.ThumbMaxSize,
+		MediaMetaProxy:         handler.policy.Settings.MediaMetaGeneratorProxy,
+		BrowserRelayedDownload: handler.policy.Settings.StreamSaver,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
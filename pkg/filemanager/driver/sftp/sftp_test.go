@@ -0,0 +1,251 @@
+package sftp
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/internal/drivertest"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	gosftp "github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+)
+
+type pipeConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+// memServer 内存中的 SFTP 服务端，所有连接共用同一文件系统
+type memServer struct {
+	handlers gosftp.Handlers
+	dialed   atomic.Int32
+}
+
+func (s *memServer) dial(ctx context.Context) (conn, error) {
+	s.dialed.Add(1)
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server := gosftp.NewRequestServer(&pipeConn{Reader: serverReader, WriteCloser: serverWriter}, s.handlers)
+	go func() {
+		// 客户端关闭后结束服务，并关闭写入端使客户端的接收循环退出
+		_ = server.Serve()
+		_ = serverWriter.Close()
+	}()
+
+	client, err := gosftp.NewClientPipe(clientReader, clientWriter)
+	if err != nil {
+		server.Close()
+		return nil, err
+	}
+
+	return &sftpConn{client: client, closer: server}, nil
+}
+
+func newTestDriver(t *testing.T, maxConn int) (*Driver, *memServer) {
+	s := &memServer{handlers: gosftp.InMemHandler()}
+	handler := &Driver{
+		policy: &ent.StoragePolicy{
+			Type:     types.PolicyTypeSftp,
+			Settings: &types.PolicySetting{SftpRoot: "/data"},
+		},
+		pool:      newPool(s.dial, maxConn, ""),
+		settings:  drivertest.Settings{},
+		l:         logging.NewConsoleLogger(logging.LevelError),
+		chunkSize: 4,
+	}
+	t.Cleanup(handler.pool.close)
+
+	return handler, s
+}
+
+func TestDriver_Put(t *testing.T) {
+	asserts := assert.New(t)
+	handler, _ := newTestDriver(t, 2)
+	content := []byte("0123456789")
+
+	// 分片上传
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "a/b/file.txt", content, 0))
+		asserts.Equal(content, drivertest.ReadAll(t, handler.OpenStream, "a/b/file.txt", 0))
+	}
+
+	// 文件已存在
+	{
+		err := drivertest.Upload(handler.Put, "a/b/file.txt", []byte("new"), 0)
+		asserts.ErrorIs(err, fs.ErrFileExisted)
+		asserts.Equal(content, drivertest.ReadAll(t, handler.OpenStream, "a/b/file.txt", 0))
+	}
+
+	// 覆盖时清空原有内容
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "a/b/file.txt", []byte("new"), fs.ModeOverwrite))
+		asserts.Equal([]byte("new"), drivertest.ReadAll(t, handler.OpenStream, "a/b/file.txt", 0))
+	}
+
+	// 空文件
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "empty", nil, 0))
+		asserts.Empty(drivertest.ReadAll(t, handler.OpenStream, "empty", 0))
+	}
+}
+
+func TestDriver_OpenStream(t *testing.T) {
+	asserts := assert.New(t)
+	handler, _ := newTestDriver(t, 1)
+	asserts.NoError(drivertest.Upload(handler.Put, "file.txt", []byte("0123456789"), 0))
+
+	// 从指定位置读取
+	asserts.Equal([]byte("6789"), drivertest.ReadAll(t, handler.OpenStream, "file.txt", 6))
+
+	// 文件不存在，连接归还后仍可使用
+	_, err := handler.OpenStream(context.Background(), "not_exist", 0)
+	asserts.ErrorIs(err, os.ErrNotExist)
+	asserts.Equal([]byte("0123456789"), drivertest.ReadAll(t, handler.OpenStream, "file.txt", 0))
+}
+
+func TestDriver_List(t *testing.T) {
+	asserts := assert.New(t)
+	handler, _ := newTestDriver(t, 2)
+	asserts.NoError(drivertest.Upload(handler.Put, "dir/1.txt", []byte("1"), 0))
+	asserts.NoError(drivertest.Upload(handler.Put, "dir/sub/2.txt", []byte("22"), 0))
+
+	// 递归列取
+	{
+		progress := 0
+		objects, err := handler.List(context.Background(), "dir", func(i int) {
+			progress += i
+		}, true)
+		asserts.NoError(err)
+		asserts.Equal(3, progress)
+		asserts.Len(objects, 3)
+
+		paths := make(map[string]fs.PhysicalObject)
+		for _, o := range objects {
+			paths[o.RelativePath] = o
+		}
+		asserts.Contains(paths, "1.txt")
+		asserts.True(paths["sub"].IsDir)
+		asserts.Equal("dir/sub/2.txt", paths["sub/2.txt"].Source)
+		asserts.EqualValues(2, paths["sub/2.txt"].Size)
+	}
+
+	// 非递归列取
+	{
+		objects, err := handler.List(context.Background(), "dir", func(i int) {}, false)
+		asserts.NoError(err)
+		asserts.Len(objects, 2)
+	}
+
+	// 目录不存在
+	{
+		_, err := handler.List(context.Background(), "not_exist", func(i int) {}, true)
+		asserts.Error(err)
+	}
+}
+
+func TestDriver_Delete(t *testing.T) {
+	asserts := assert.New(t)
+	handler, _ := newTestDriver(t, 2)
+	asserts.NoError(drivertest.Upload(handler.Put, "1.txt", []byte("1"), 0))
+	asserts.NoError(drivertest.Upload(handler.Put, "2.txt", []byte("2"), 0))
+
+	failed, err := handler.Delete(context.Background(), "1.txt", "2.txt", "not_exist")
+	asserts.NoError(err)
+	asserts.Empty(failed)
+
+	_, err = handler.OpenStream(context.Background(), "1.txt", 0)
+	asserts.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestPool(t *testing.T) {
+	asserts := assert.New(t)
+	handler, s := newTestDriver(t, 1)
+
+	// 连接被复用
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "1.txt", []byte("1"), 0))
+		asserts.NoError(drivertest.Upload(handler.Put, "2.txt", []byte("2"), 0))
+		asserts.EqualValues(1, s.dialed.Load())
+	}
+
+	// 读取流未关闭时连接数已满
+	{
+		stream, err := handler.OpenStream(context.Background(), "1.txt", 0)
+		asserts.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = handler.List(ctx, "", func(i int) {}, false)
+		asserts.ErrorIs(err, context.DeadlineExceeded)
+
+		asserts.NoError(stream.Close())
+		objects, err := handler.List(context.Background(), "", func(i int) {}, false)
+		asserts.NoError(err)
+		asserts.Len(objects, 2)
+		asserts.EqualValues(1, s.dialed.Load())
+	}
+
+	// 连接池关闭后不再复用连接
+	{
+		handler.pool.close()
+		_, err := handler.List(context.Background(), "", func(i int) {}, false)
+		asserts.NoError(err)
+		_, err = handler.List(context.Background(), "", func(i int) {}, false)
+		asserts.NoError(err)
+		asserts.EqualValues(3, s.dialed.Load())
+	}
+}
+
+func TestWithDefaultPort(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.Equal("example.com:22", withDefaultPort("example.com", "22"))
+	asserts.Equal("example.com:2222", withDefaultPort(" example.com:2222 ", "22"))
+	asserts.Equal("[::1]:21", withDefaultPort("::1", "21"))
+	asserts.Equal("[::1]:990", withDefaultPort("[::1]:990", "21"))
+}
+
+func TestPoolFingerprint(t *testing.T) {
+	asserts := assert.New(t)
+	policy := &ent.StoragePolicy{Server: "example.com", AccessKey: "user", SecretKey: "pass", Settings: &types.PolicySetting{}}
+	fingerprint := poolFingerprint(policy)
+
+	policy.Settings.SftpRoot = "/data"
+	asserts.Equal(fingerprint, poolFingerprint(policy), "root does not affect connections")
+
+	policy.SecretKey = "changed"
+	asserts.NotEqual(fingerprint, poolFingerprint(policy))
+}
+
+func TestSSHConfig(t *testing.T) {
+	asserts := assert.New(t)
+	policy := &ent.StoragePolicy{AccessKey: "user", SecretKey: "pass", Settings: &types.PolicySetting{}}
+
+	// 未设置服务器公钥时拒绝连接
+	{
+		_, err := sshConfig(policy)
+		asserts.ErrorIs(err, ErrHostKeyRequired)
+	}
+
+	// 服务器公钥格式错误
+	{
+		policy.Settings.SftpHostKey = "invalid"
+		_, err := sshConfig(policy)
+		asserts.Error(err)
+	}
+
+	// 校验服务器公钥
+	{
+		policy.Settings.SftpHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+		config, err := sshConfig(policy)
+		asserts.NoError(err)
+		asserts.NotNil(config.HostKeyCallback)
+	}
+}
@@ -0,0 +1,375 @@
+package webdav
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+var (
+	// ErrObjectNotExist 路径对应的文件不存在
+	ErrObjectNotExist = errors.New("object not exist")
+)
+
+const (
+	depthInfinity = "infinity"
+	// maxErrorMessage 错误信息中保留的响应正文最大长度
+	maxErrorMessage = 512
+)
+
+// client WebDAV 客户端
+type client struct {
+	policy     *ent.StoragePolicy
+	endpoint   *url.URL
+	httpClient request.Client
+	l          logging.Logger
+}
+
+// newClient 根据存储策略获取新的client，存储策略的 Server 为存储根目录的地址
+func newClient(policy *ent.StoragePolicy, httpClient request.Client, l logging.Logger) (*client, error) {
+	endpoint, err := url.Parse(strings.TrimSpace(policy.Server))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse server URL: %w", err)
+	}
+
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported server URL %q", policy.Server)
+	}
+
+	endpoint.Path = path.Clean("/" + endpoint.Path)
+	endpoint.RawPath = ""
+
+	return &client{
+		policy:     policy,
+		endpoint:   endpoint,
+		httpClient: httpClient,
+		l:          l,
+	}, nil
+}
+
+// url 获取存储路径对应的请求地址，目录地址以 / 结尾
+func (c *client) url(p string, dir bool) string {
+	u := *c.endpoint
+	u.Path = path.Join(c.endpoint.Path, p)
+	if dir && !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	return u.String()
+}
+
+// relPath 将响应中的 href 转换为相对于存储根目录的路径
+func (c *client) relPath(href string) (string, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+
+	p := path.Clean("/" + u.Path)
+	if c.endpoint.Path == "/" {
+		return strings.TrimPrefix(p, "/"), nil
+	}
+
+	if p == c.endpoint.Path {
+		return "", nil
+	}
+
+	if !strings.HasPrefix(p, c.endpoint.Path+"/") {
+		return "", fmt.Errorf("%q is outside of storage root", p)
+	}
+
+	return strings.TrimPrefix(p, c.endpoint.Path+"/"), nil
+}
+
+// List 列取目录下的资源，recursive 为 true 时列取所有子孙资源。
+// 服务端拒绝 Depth: infinity 请求时，逐级列取子目录。
+func (c *client) List(ctx context.Context, dir string, recursive bool, fn func(*Object)) error {
+	dir = strings.Trim(dir, "/")
+	if recursive {
+		err := c.listWithDepth(ctx, dir, depthInfinity, fn)
+		if !isDepthRejected(err) {
+			return err
+		}
+
+		c.l.Debug("WebDAV server rejects infinite depth PROPFIND, fallback to list folders one by one: %s", err)
+	}
+
+	pending := []string{dir}
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		current := pending[0]
+		pending = pending[1:]
+		err := c.listWithDepth(ctx, current, "1", func(object *Object) {
+			fn(object)
+			if recursive && object.IsDir {
+				pending = append(pending, object.Path)
+			}
+		})
+		if err != nil {
+			if current == dir {
+				return err
+			}
+
+			c.l.Warning("Failed to list folder %q: %s", current, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) listWithDepth(ctx context.Context, dir, depth string, fn func(*Object)) error {
+	return c.propfind(ctx, dir, true, depth, func(object *Object) error {
+		// 响应中包含目录自身
+		if object.Path != dir {
+			fn(object)
+		}
+		return nil
+	})
+}
+
+// Stat 获取资源信息
+func (c *client) Stat(ctx context.Context, p string) (*Object, error) {
+	var res *Object
+	err := c.propfind(ctx, p, false, "0", func(object *Object) error {
+		if res == nil {
+			res = object
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrObjectNotExist
+	}
+
+	return res, nil
+}
+
+// MkdirAll 逐级创建目录
+func (c *client) MkdirAll(ctx context.Context, dir string) error {
+	dir = strings.Trim(dir, "/")
+	if dir == "" || dir == "." {
+		return nil
+	}
+
+	// 大部分情况下目录已存在，无需逐级创建
+	if object, err := c.Stat(ctx, dir); err == nil && object.IsDir {
+		return nil
+	}
+
+	current := ""
+	for _, name := range strings.Split(dir, "/") {
+		current = path.Join(current, name)
+		err := c.request(ctx, "MKCOL", current, true, nil, request.WithContentLength(0))
+
+		// 405 Method Not Allowed 表示目录已存在
+		var respErr *RespError
+		if errors.As(err, &respErr) && respErr.Code == http.StatusMethodNotAllowed {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to create folder %q: %w", current, err)
+		}
+	}
+
+	return nil
+}
+
+// Put 上传文件内容，contentRange 不为空时只写入文件的指定范围
+func (c *client) Put(ctx context.Context, p string, content io.Reader, size int64, contentRange string) error {
+	header := http.Header{}
+	if contentRange != "" {
+		header.Set("Content-Range", contentRange)
+	}
+
+	return c.request(ctx, "PUT", p, false, content,
+		request.WithContentLength(size),
+		request.WithHeader(header),
+		request.WithTimeout(0),
+	)
+}
+
+// Delete 删除文件
+func (c *client) Delete(ctx context.Context, p string) error {
+	return c.request(ctx, "DELETE", p, false, nil, request.WithContentLength(0))
+}
+
+// Open 从 offset 处开始读取文件内容
+func (c *client) Open(ctx context.Context, p string, offset int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.do(ctx, "GET", p, false, nil, request.WithHeader(header), request.WithTimeout(0))
+	if err != nil {
+		return nil, err
+	}
+
+	// 服务端不支持 Range 请求时返回完整内容，跳过 offset 之前的部分
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to skip to offset %d: %w", offset, err)
+		}
+	}
+
+	return resp.Body, nil
+}
+
+// propfind 获取资源属性，逐个解析响应中的资源并交由 fn 处理
+func (c *client) propfind(ctx context.Context, p string, dir bool, depth string, fn func(*Object) error) error {
+	resp, err := c.do(ctx, "PROPFIND", p, dir, strings.NewReader(propfindBody),
+		request.WithContentLength(int64(len(propfindBody))),
+		request.WithHeader(http.Header{
+			"Depth":        {depth},
+			"Content-Type": {"application/xml; charset=utf-8"},
+		}),
+		request.WithTimeout(0),
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return &RespError{Code: resp.StatusCode, Message: "expect Multi-Status response for PROPFIND"}
+	}
+
+	decoder := xml.NewDecoder(resp.Body)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to parse PROPFIND response: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Space != "DAV:" || start.Name.Local != "response" {
+			continue
+		}
+
+		var r response
+		if err := decoder.DecodeElement(&r, &start); err != nil {
+			return fmt.Errorf("failed to parse PROPFIND response: %w", err)
+		}
+
+		object, err := c.toObject(&r)
+		if err != nil {
+			c.l.Warning("Skip invalid resource %q in PROPFIND response: %s", r.Href, err)
+			continue
+		}
+
+		if err := fn(object); err != nil {
+			return err
+		}
+	}
+}
+
+// toObject 将 PROPFIND 响应中的资源转换为 Object
+func (c *client) toObject(r *response) (*Object, error) {
+	rel, err := c.relPath(r.Href)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ps := range r.Propstat {
+		if !ps.statusOK() {
+			continue
+		}
+
+		object := &Object{
+			Path:  rel,
+			Size:  ps.Prop.ContentLength,
+			IsDir: ps.Prop.ResourceType.Collection != nil,
+		}
+		if modified, err := http.ParseTime(ps.Prop.LastModified); err == nil {
+			object.LastModify = modified
+		}
+
+		return object, nil
+	}
+
+	return nil, errors.New("no available properties")
+}
+
+func (c *client) do(ctx context.Context, method, p string, dir bool, body io.Reader, opts ...request.Option) (*http.Response, error) {
+	header := http.Header{}
+	if c.policy.AccessKey != "" || c.policy.SecretKey != "" {
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.policy.AccessKey+":"+c.policy.SecretKey)))
+	}
+
+	res := c.httpClient.Request(method, c.url(p, dir), body, append([]request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(header),
+		request.WithTPSLimit(
+			fmt.Sprintf("policy_%d", c.policy.ID),
+			c.policy.Settings.TPSLimit,
+			c.policy.Settings.TPSLimitBurst,
+		),
+	}, opts...)...)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	status := res.Response.StatusCode
+	if status < 200 || status >= 300 {
+		respBody, _ := res.GetResponseIgnoreErr()
+		respErr := &RespError{Code: status, Message: strings.TrimSpace(respBody)}
+		if len(respErr.Message) > maxErrorMessage {
+			respErr.Message = respErr.Message[:maxErrorMessage]
+		}
+
+		if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+			c.l.Warning("WebDAV request is throttled.")
+			return nil, backoff.NewRetryableErrorFromHeader(respErr, res.Response.Header)
+		}
+
+		return nil, respErr
+	}
+
+	return res.Response, nil
+}
+
+// request 发送请求并丢弃响应正文
+func (c *client) request(ctx context.Context, method, p string, dir bool, body io.Reader, opts ...request.Option) error {
+	resp, err := c.do(ctx, method, p, dir, body, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+// isDepthRejected 服务端是否拒绝了 Depth: infinity 请求
+func isDepthRejected(err error) bool {
+	var respErr *RespError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+
+	return respErr.Code == http.StatusForbidden || respErr.Code == http.StatusBadRequest ||
+		respErr.Code == http.StatusNotImplemented
+}
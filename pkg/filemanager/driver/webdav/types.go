@@ -0,0 +1,71 @@
+package webdav
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// propfindBody PROPFIND 请求正文，只获取需要的属性
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<D:propfind xmlns:D="DAV:"><D:prop><D:resourcetype/><D:getcontentlength/><D:getlastmodified/></D:prop></D:propfind>`
+
+// Object 存储端的文件或目录
+type Object struct {
+	// Path 相对于存储根目录的路径
+	Path       string
+	Size       int64
+	IsDir      bool
+	LastModify time.Time
+}
+
+// response Multi-Status 响应中的单个资源
+type response struct {
+	Href     string     `xml:"DAV: href"`
+	Propstat []propstat `xml:"DAV: propstat"`
+}
+
+type propstat struct {
+	Prop   prop   `xml:"DAV: prop"`
+	Status string `xml:"DAV: status"`
+}
+
+type prop struct {
+	ResourceType struct {
+		Collection *struct{} `xml:"DAV: collection"`
+	} `xml:"DAV: resourcetype"`
+	ContentLength int64  `xml:"DAV: getcontentlength"`
+	LastModified  string `xml:"DAV: getlastmodified"`
+}
+
+// RespError 存储端返回的错误
+type RespError struct {
+	Code    int
+	Message string
+}
+
+// Error 实现error接口
+func (e *RespError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("WebDAV server returns unexpected status code %d", e.Code)
+	}
+
+	return fmt.Sprintf("WebDAV server returns unexpected status code %d: %s", e.Code, e.Message)
+}
+
+// Unwrap 将 404 错误转换为 ErrObjectNotExist
+func (e *RespError) Unwrap() error {
+	if e.Code == http.StatusNotFound {
+		return ErrObjectNotExist
+	}
+
+	return nil
+}
+
+// statusOK propstat 中的状态是否为 200
+func (p *propstat) statusOK() bool {
+	// 格式为 HTTP/1.1 200 OK
+	fields := strings.Fields(p.Status)
+	return len(fields) >= 2 && fields[1] == "200"
+}
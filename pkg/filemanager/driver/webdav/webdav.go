@@ -0,0 +1,226 @@
+package webdav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	defaultChunkSize = 25 << 20 // 25MB
+	chunkRetrySleep  = time.Second * 5
+)
+
+// Driver WebDAV 适配器
+type Driver struct {
+	policy    *ent.StoragePolicy
+	client    *client
+	settings  setting.Provider
+	config    conf.ConfigProvider
+	l         logging.Logger
+	chunkSize int64
+}
+
+var (
+	features = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityStreamGet: true,
+	}, features)
+}
+
+// New 从存储策略初始化新的Driver实例
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger) (*Driver, error) {
+	c, err := newClient(policy, request.NewClient(config, request.WithLogger(l)), l)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未开启分片 PUT 时，使用单个请求上传整个文件
+	var chunkSize int64
+	if policy.Settings.WebdavRangePut {
+		chunkSize = policy.Settings.ChunkSize
+		if chunkSize == 0 {
+			chunkSize = defaultChunkSize
+		}
+	}
+
+	return &Driver{
+		policy:    policy,
+		client:    c,
+		settings:  settings,
+		config:    config,
+		l:         l,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// List 列取项目
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	base = path.Clean("/" + base)[1:]
+
+	var res []fs.PhysicalObject
+	err := handler.client.List(ctx, base, recursive, func(object *Object) {
+		rel := object.Path
+		if base != "" {
+			rel = strings.TrimPrefix(object.Path, base+"/")
+		}
+
+		res = append(res, fs.PhysicalObject{
+			Name:         path.Base(object.Path),
+			RelativePath: rel,
+			Source:       object.Path,
+			Size:         object.Size,
+			IsDir:        object.IsDir,
+			LastModify:   object.LastModify,
+		})
+		onProgress(1)
+	})
+
+	return res, err
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+// OpenStream 从 offset 处开始读取文件
+func (handler *Driver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	return handler.client.Open(ctx, path, offset)
+}
+
+// Put 将文件流保存到指定目录
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+	dst := file.Props.SavePath
+	overwrite := file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+
+	// 如果非 Overwrite，则检查是否有重名冲突
+	if !overwrite {
+		_, err := handler.client.Stat(ctx, dst)
+		if err == nil {
+			handler.l.Warning("File with the same name existed or unavailable: %s", dst)
+			return fs.ErrFileExisted
+		}
+
+		if !errors.Is(err, ErrObjectNotExist) {
+			return fmt.Errorf("failed to check file existence: %w", err)
+		}
+	}
+
+	if err := handler.client.MkdirAll(ctx, path.Dir(dst)); err != nil {
+		return err
+	}
+
+	// Initial chunk groups
+	chunks := chunk.NewChunkGroup(file, handler.chunkSize, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	// 分片写入不会截断原有文件，覆盖前需要删除
+	if overwrite && handler.chunkSize > 0 && file.Props.Size > handler.chunkSize {
+		if err := handler.client.Delete(ctx, dst); err != nil && !errors.Is(err, ErrObjectNotExist) {
+			return fmt.Errorf("failed to delete existing file: %w", err)
+		}
+	}
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		// 只有一个分片时使用普通 PUT 请求
+		contentRange := ""
+		if current.Length() < current.Total() {
+			contentRange = current.RangeHeader()
+		}
+
+		return handler.client.Put(ctx, dst, content, current.Length(), contentRange)
+	}
+
+	// upload chunks
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+		}
+	}
+
+	return nil
+}
+
+// Delete 删除一个或多个文件，
+// 返回未删除的文件，及遇到的最后一个错误
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var lastErr error
+
+	for _, file := range files {
+		err := handler.client.Delete(ctx, file)
+		if err != nil && !errors.Is(err, ErrObjectNotExist) {
+			handler.l.Warning("Failed to delete file %q: %s", file, err)
+			failed = append(failed, file)
+			lastErr = err
+		}
+	}
+
+	return failed, lastErr
+}
+
+// Thumb 获取文件缩略图
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source 获取文件外链，访问存储端需要凭证，文件内容只能由 Cloudreve 中转
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Token 获取上传凭证，客户端无法直接上传至存储端，只支持中转上传
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	return nil, serializer.NewError(serializer.CodePolicyNotAllowed, "Direct upload is not supported, please enable relay upload for this storage policy", nil)
+}
+
+// CancelToken 取消上传凭证
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures:         features,
+		ThumbProxy:             handler.policy.Settings.ThumbGeneratorProxy,
+		ThumbMaxSize:           handler.policy.Settings.ThumbMaxSize,
+		MediaMetaProxy:         handler.policy.Settings.MediaMetaGeneratorProxy,
+		BrowserRelayedDownload: handler.policy.Settings.StreamSaver,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
@@ -0,0 +1,365 @@
+package webdav
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/internal/drivertest"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/webdav"
+)
+
+const (
+	testUser     = "user"
+	testPassword = "password"
+)
+
+// stubServer 基于内存文件系统的 WebDAV 服务端，额外支持带 Content-Range 的 PUT 请求
+type stubServer struct {
+	server  *httptest.Server
+	fs      webdav.FileSystem
+	handler *webdav.Handler
+
+	mu             sync.Mutex
+	ranges         []string
+	depths         []string
+	rejectInfinity bool
+	ignoreRange    bool
+}
+
+func newStubServer() *stubServer {
+	s := &stubServer{fs: webdav.NewMemFS()}
+	s.handler = &webdav.Handler{
+		Prefix:     "/dav",
+		FileSystem: s.fs,
+		LockSystem: webdav.NewMemLS(),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *stubServer) serve(w http.ResponseWriter, r *http.Request) {
+	if user, password, ok := r.BasicAuth(); !ok || user != testUser || password != testPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case "PROPFIND":
+		s.depths = append(s.depths, r.Header.Get("Depth"))
+		if s.rejectInfinity && r.Header.Get("Depth") == depthInfinity {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	case "GET":
+		if s.ignoreRange {
+			r.Header.Del("Range")
+		}
+	case "PUT":
+		if contentRange := r.Header.Get("Content-Range"); contentRange != "" {
+			s.ranges = append(s.ranges, contentRange)
+			s.putRange(w, r, contentRange)
+			return
+		}
+	}
+
+	s.handler.ServeHTTP(w, r)
+}
+
+func (s *stubServer) putRange(w http.ResponseWriter, r *http.Request, contentRange string) {
+	var start, end, total int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	f, err := s.fs.OpenFile(r.Context(), r.URL.Path[len(s.handler.Prefix):], os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(start, io.SeekStart); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := io.Copy(f, r.Body); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubServer) content(t *testing.T, p string) []byte {
+	f, err := s.fs.OpenFile(context.Background(), "/"+p, os.O_RDONLY, 0)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	return content
+}
+
+func newTestDriver(t *testing.T, s *stubServer, settings *types.PolicySetting) *Driver {
+	handler, err := New(context.Background(), &ent.StoragePolicy{
+		Type:      types.PolicyTypeWebdav,
+		Server:    s.server.URL + "/dav/",
+		AccessKey: testUser,
+		SecretKey: testPassword,
+		Settings:  settings,
+	}, drivertest.Settings{}, drivertest.Config{}, logging.NewConsoleLogger(logging.LevelError))
+	assert.NoError(t, err)
+	return handler
+}
+
+func TestNew(t *testing.T) {
+	asserts := assert.New(t)
+	l := logging.NewConsoleLogger(logging.LevelError)
+
+	// 无效的服务端地址
+	{
+		_, err := New(context.Background(), &ent.StoragePolicy{
+			Server:   "ftp://example.com/dav",
+			Settings: &types.PolicySetting{},
+		}, drivertest.Settings{}, drivertest.Config{}, l)
+		asserts.Error(err)
+	}
+
+	// 未开启分片 PUT
+	{
+		handler, err := New(context.Background(), &ent.StoragePolicy{
+			Server:   "https://example.com/dav",
+			Settings: &types.PolicySetting{ChunkSize: 1024},
+		}, drivertest.Settings{}, drivertest.Config{}, l)
+		asserts.NoError(err)
+		asserts.EqualValues(0, handler.chunkSize)
+	}
+
+	// 开启分片 PUT
+	{
+		handler, err := New(context.Background(), &ent.StoragePolicy{
+			Server:   "https://example.com/dav",
+			Settings: &types.PolicySetting{WebdavRangePut: true},
+		}, drivertest.Settings{}, drivertest.Config{}, l)
+		asserts.NoError(err)
+		asserts.EqualValues(defaultChunkSize, handler.chunkSize)
+	}
+}
+
+func TestClient_Path(t *testing.T) {
+	asserts := assert.New(t)
+	c, err := newClient(&ent.StoragePolicy{Server: "https://example.com/remote.php/dav/files/alice/"}, nil, nil)
+	asserts.NoError(err)
+
+	asserts.Equal("https://example.com/remote.php/dav/files/alice/a%20b/%E6%96%87%E4%BB%B6%23.txt", c.url("a b/文件#.txt", false))
+	asserts.Equal("https://example.com/remote.php/dav/files/alice/dir/", c.url("dir", true))
+	asserts.Equal("https://example.com/remote.php/dav/files/alice/", c.url("", true))
+
+	rel, err := c.relPath("/remote.php/dav/files/alice/a%20b/%E6%96%87%E4%BB%B6%23.txt")
+	asserts.NoError(err)
+	asserts.Equal("a b/文件#.txt", rel)
+
+	rel, err = c.relPath("https://example.com/remote.php/dav/files/alice/dir/")
+	asserts.NoError(err)
+	asserts.Equal("dir", rel)
+
+	rel, err = c.relPath("/remote.php/dav/files/alice/")
+	asserts.NoError(err)
+	asserts.Equal("", rel)
+
+	_, err = c.relPath("/remote.php/dav/files/bob/1.txt")
+	asserts.Error(err)
+}
+
+func TestDriver_Put(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{})
+	content := []byte("0123456789")
+
+	// 单个请求上传
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "a/b/file.txt", content, 0))
+		asserts.Equal(content, s.content(t, "a/b/file.txt"))
+		asserts.Empty(s.ranges)
+	}
+
+	// 文件已存在
+	{
+		err := drivertest.Upload(handler.Put, "a/b/file.txt", []byte("new"), 0)
+		asserts.ErrorIs(err, fs.ErrFileExisted)
+		asserts.Equal(content, s.content(t, "a/b/file.txt"))
+	}
+
+	// 覆盖
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "a/b/file.txt", []byte("new"), fs.ModeOverwrite))
+		asserts.Equal([]byte("new"), s.content(t, "a/b/file.txt"))
+	}
+
+	// 空文件
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "a/empty", nil, 0))
+		asserts.Empty(s.content(t, "a/empty"))
+	}
+}
+
+func TestDriver_PutRange(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{WebdavRangePut: true, ChunkSize: 4})
+	content := []byte("0123456789")
+
+	// 分片上传
+	{
+		asserts.NoError(drivertest.Upload(handler.Put, "dir/file.txt", content, 0))
+		asserts.Equal(content, s.content(t, "dir/file.txt"))
+		asserts.Equal([]string{"bytes 0-3/10", "bytes 4-7/10", "bytes 8-9/10"}, s.ranges)
+	}
+
+	// 覆盖较长的文件
+	{
+		s.ranges = nil
+		asserts.NoError(drivertest.Upload(handler.Put, "dir/file.txt", []byte("abcdef"), fs.ModeOverwrite))
+		asserts.Equal([]byte("abcdef"), s.content(t, "dir/file.txt"))
+		asserts.Equal([]string{"bytes 0-3/6", "bytes 4-5/6"}, s.ranges)
+	}
+
+	// 只有一个分片时使用普通 PUT
+	{
+		s.ranges = nil
+		asserts.NoError(drivertest.Upload(handler.Put, "dir/small.txt", []byte("abc"), 0))
+		asserts.Equal([]byte("abc"), s.content(t, "dir/small.txt"))
+		asserts.Empty(s.ranges)
+	}
+}
+
+func TestDriver_OpenStream(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{})
+	asserts.NoError(drivertest.Upload(handler.Put, "文件 1.txt", []byte("0123456789"), 0))
+
+	asserts.Equal([]byte("0123456789"), drivertest.ReadAll(t, handler.OpenStream, "文件 1.txt", 0))
+	asserts.Equal([]byte("6789"), drivertest.ReadAll(t, handler.OpenStream, "文件 1.txt", 6))
+
+	// 服务端不支持 Range
+	s.ignoreRange = true
+	asserts.Equal([]byte("6789"), drivertest.ReadAll(t, handler.OpenStream, "文件 1.txt", 6))
+
+	// 文件不存在
+	_, err := handler.OpenStream(context.Background(), "not_exist", 0)
+	asserts.ErrorIs(err, ErrObjectNotExist)
+}
+
+func TestDriver_List(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{})
+	asserts.NoError(drivertest.Upload(handler.Put, "dir/1.txt", []byte("1"), 0))
+	asserts.NoError(drivertest.Upload(handler.Put, "dir/sub dir/2.txt", []byte("22"), 0))
+	asserts.NoError(drivertest.Upload(handler.Put, "other.txt", []byte("3"), 0))
+
+	assertObjects := func(objects []fs.PhysicalObject) {
+		asserts.Len(objects, 3)
+		paths := make(map[string]fs.PhysicalObject)
+		for _, o := range objects {
+			paths[o.RelativePath] = o
+		}
+		asserts.Contains(paths, "1.txt")
+		asserts.True(paths["sub dir"].IsDir)
+		asserts.Equal("sub dir", paths["sub dir"].Name)
+		asserts.Equal("dir/sub dir/2.txt", paths["sub dir/2.txt"].Source)
+		asserts.EqualValues(2, paths["sub dir/2.txt"].Size)
+		asserts.False(paths["sub dir/2.txt"].LastModify.IsZero())
+	}
+
+	// 递归列取
+	{
+		progress := 0
+		objects, err := handler.List(context.Background(), "/dir", func(i int) {
+			progress += i
+		}, true)
+		asserts.NoError(err)
+		asserts.Equal(3, progress)
+		assertObjects(objects)
+	}
+
+	// 服务端拒绝 Depth: infinity
+	{
+		s.depths = nil
+		s.rejectInfinity = true
+		progress := 0
+		objects, err := handler.List(context.Background(), "dir", func(i int) {
+			progress += i
+		}, true)
+		asserts.NoError(err)
+		asserts.Equal(3, progress)
+		asserts.Equal([]string{depthInfinity, "1", "1"}, s.depths)
+		assertObjects(objects)
+	}
+
+	// 非递归列取根目录
+	{
+		objects, err := handler.List(context.Background(), "", func(i int) {}, false)
+		asserts.NoError(err)
+		asserts.Len(objects, 2)
+	}
+
+	// 目录不存在
+	{
+		_, err := handler.List(context.Background(), "not_exist", func(i int) {}, true)
+		asserts.ErrorIs(err, ErrObjectNotExist)
+	}
+}
+
+func TestDriver_Delete(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{})
+	asserts.NoError(drivertest.Upload(handler.Put, "1.txt", []byte("1"), 0))
+	asserts.NoError(drivertest.Upload(handler.Put, "dir/2.txt", []byte("2"), 0))
+
+	failed, err := handler.Delete(context.Background(), "1.txt", "dir/2.txt", "not_exist")
+	asserts.NoError(err)
+	asserts.Empty(failed)
+
+	_, err = handler.client.Stat(context.Background(), "1.txt")
+	asserts.ErrorIs(err, ErrObjectNotExist)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	asserts := assert.New(t)
+	s := newStubServer()
+	defer s.server.Close()
+	handler := newTestDriver(t, s, &types.PolicySetting{})
+	handler.client.policy.SecretKey = "wrong"
+
+	_, err := handler.List(context.Background(), "", func(i int) {}, false)
+	var respErr *RespError
+	asserts.ErrorAs(err, &respErr)
+	asserts.Equal(http.StatusUnauthorized, respErr.Code)
+}
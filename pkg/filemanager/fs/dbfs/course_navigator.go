@@ -0,0 +1,398 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+var (
+	// 教师、助教可以管理课程空间内的全部文件
+	courseManagerNavigatorCapability = &boolset.BooleanSet{}
+	// 学生只能浏览和下载，在自己的作业提交目录中可以上传和修改文件
+	courseStudentNavigatorCapability = &boolset.BooleanSet{}
+
+	// errSubmissionFolderNotCreated 学生尚未提交过该作业，提交目录需在首次上传时创建
+	errSubmissionFolderNotCreated = fmt.Errorf("submission folder not created")
+)
+
+// NewCourseNavigator creates a navigator for a course space. Files under course space are owned
+// by the course account, the requester operates on them according to its role in the course.
+func NewCourseNavigator(u *ent.User, course *ent.Course, role coursemember.Role, fileClient inventory.FileClient,
+	l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) Navigator {
+	account := course.Edges.Account
+	return &courseNavigator{
+		user:          u,
+		course:        course,
+		account:       account,
+		role:          role,
+		l:             l,
+		fileClient:    fileClient,
+		config:        config,
+		hasher:        hasher,
+		baseNavigator: newBaseNavigator(fileClient, defaultFilter, account, hasher, config),
+		assignments: lo.SliceToMap(course.Edges.Assignments, func(item *ent.Assignment) (int, *ent.Assignment) {
+			return item.FolderID, item
+		}),
+	}
+}
+
+type courseNavigator struct {
+	l          logging.Logger
+	user       *ent.User
+	course     *ent.Course
+	account    *ent.User
+	role       coursemember.Role
+	fileClient inventory.FileClient
+	config     *setting.DBFS
+	hasher     hashid.Encoder
+	// 作业提交箱，以提交目录的文件 ID 为键
+	assignments map[int]*ent.Assignment
+
+	*baseNavigator
+	root           *File
+	disableRecycle bool
+	persist        func()
+}
+
+func (n *courseNavigator) Recycle() {
+	if n.persist != nil {
+		n.persist()
+		n.persist = nil
+	}
+	if n.root != nil && !n.disableRecycle {
+		n.root.Recycle()
+	}
+}
+
+func (n *courseNavigator) PersistState(kv cache.Driver, key string) {
+	n.disableRecycle = true
+	n.persist = func() {
+		if n.root != nil {
+			revokeDelegates(n.root)
+		}
+		kv.Set(key, n.root, ContextHintTTL)
+	}
+}
+
+func (n *courseNavigator) RestoreState(s State) error {
+	n.disableRecycle = true
+	if state, ok := s.(*File); ok {
+		n.root = state
+		return nil
+	}
+
+	return fmt.Errorf("invalid state type: %T", s)
+}
+
+func (n *courseNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	if n.root == nil {
+		rootFile, err := n.fileClient.Root(ctx, n.account)
+		if err != nil {
+			return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("course root folder not found: %w", err))
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := newCourseUri(hashid.EncodeCourseID(n.hasher, n.course.ID))
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = n.account
+		n.root.IsUserRoot = true
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	// 管理权限在每次导航时重新授予，恢复的导航状态中不包含授权
+	if n.isManager() {
+		uid := n.user.ID
+		n.root.delegate = func(u *ent.User) bool {
+			return u.ID == uid
+		}
+	}
+
+	current, lastAncestor := n.root, n.root
+	elements := path.Elements()
+	var err error
+	for index, element := range elements {
+		lastAncestor = current
+		current, err = n.walkNext(ctx, current, element, index == len(elements)-1)
+		if err != nil {
+			return lastAncestor, fmt.Errorf("failed to walk into %q: %w", element, err)
+		}
+	}
+
+	return current, nil
+}
+
+func (n *courseNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	if n.isManager() {
+		return n.baseNavigator.children(ctx, parent, args)
+	}
+
+	// 学生搜索会遍历整个课程空间，可能看到其他同学的提交
+	if args.Search != nil && len(n.assignments) > 0 {
+		return nil, ErrPermissionDenied.WithError(fmt.Errorf("search is not available in course with assignments"))
+	}
+
+	res, err := n.baseNavigator.children(ctx, parent, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// 学生在作业目录下只能看到自己的提交目录
+	if parent != nil {
+		if _, ok := n.assignments[parent.ID()]; ok {
+			res.Files = lo.Filter(res.Files, func(item *File, index int) bool {
+				return item.Name() == n.submissionFolderName()
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (n *courseNavigator) walkNext(ctx context.Context, root *File, next string, isLeaf bool) (*File, error) {
+	if n.isManager() || root == nil {
+		return n.baseNavigator.walkNext(ctx, root, next, isLeaf)
+	}
+
+	a, ok := n.assignments[root.ID()]
+	if !ok {
+		return n.baseNavigator.walkNext(ctx, root, next, isLeaf)
+	}
+
+	// 学生只能进入自己的提交目录
+	if next != n.submissionFolderName() {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("submission of other student is not accessible"))
+	}
+
+	child, err := n.baseNavigator.walkNext(ctx, root, next, isLeaf)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("%w: %w", errSubmissionFolderNotCreated, err))
+		}
+
+		return nil, err
+	}
+
+	// 截止时间在每次操作时判断，截止后学生无法再修改已提交的内容
+	uid := n.user.ID
+	child.delegate = func(u *ent.User) bool {
+		accepting, _ := AssignmentAccepting(a, time.Now())
+		return accepting && u.ID == uid
+	}
+
+	return child, nil
+}
+
+// createSubmissionFolder creates the submission folder of current student under given assignment folder.
+// It is only called on the first upload to the assignment.
+func (n *courseNavigator) createSubmissionFolder(ctx context.Context, assignmentFolder *File) error {
+	if n.isManager() || assignmentFolder == nil {
+		return ErrPermissionDenied
+	}
+
+	a, ok := n.assignments[assignmentFolder.ID()]
+	if !ok {
+		return ErrPermissionDenied
+	}
+
+	if accepting, _ := AssignmentAccepting(a, time.Now()); !accepting {
+		return ErrAssignmentClosed
+	}
+
+	if _, err := n.fileClient.CreateFolder(ctx, assignmentFolder.Model, &inventory.CreateFolderParameters{
+		Owner: n.account.ID,
+		Name:  n.submissionFolderName(),
+	}); err != nil {
+		return fmt.Errorf("failed to create submission folder: %w", err)
+	}
+
+	return nil
+}
+
+func (n *courseNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	res := &fs.NavigatorProps{
+		Capability:            courseStudentNavigatorCapability,
+		OrderDirectionOptions: fullOrderDirectionOption,
+		OrderByOptions:        fullOrderByOption,
+		MaxPageSize:           n.config.MaxPageSize,
+	}
+	if n.isManager() {
+		res.Capability = courseManagerNavigatorCapability
+	}
+	if isSearching {
+		res.OrderByOptions = nil
+		res.OrderDirectionOptions = nil
+	}
+
+	return res
+}
+
+func (n *courseNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	return n.baseNavigator.walk(ctx, levelFiles, limit, depth, f)
+}
+
+func (n *courseNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient := n.fileClient
+	revert := func() {
+		n.fileClient = oldFileClient
+		n.baseNavigator.fileClient = oldFileClient
+	}
+
+	n.fileClient = newFileClient
+	n.baseNavigator.fileClient = newFileClient
+	return revert, nil
+}
+
+func (n *courseNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	switch hookType {
+	case fs.HookTypeBeforeUpload:
+		a := n.assignmentOf(file)
+		if a == nil || n.isManager() {
+			return nil
+		}
+
+		if accepting, _ := AssignmentAccepting(a, time.Now()); !accepting {
+			return ErrAssignmentClosed
+		}
+
+		if req, ok := ctx.Value(UploadRequestCtx{}).(*fs.UploadRequest); ok {
+			return validateSubmission(req.Props.Uri.Name(), req.Props.Size, a.Settings)
+		}
+	case fs.HookTypeAfterUpload:
+		a := n.assignmentOf(file)
+		if a == nil || n.isManager() {
+			return nil
+		}
+
+		// 以服务器时间记录提交时间，避免争议；上传会话可能在截止前创建，完成时需再次校验
+		now := time.Now()
+		accepting, late := AssignmentAccepting(a, now)
+		if !accepting {
+			return ErrAssignmentClosed
+		}
+
+		metadata := map[string]string{
+			MetadataSubmittedAt: now.UTC().Format(time.RFC3339),
+			MetadataSubmittedBy: hashid.EncodeUserID(n.hasher, n.user.ID),
+		}
+		if late {
+			metadata[MetadataSubmissionLate] = "true"
+		}
+
+		if err := n.fileClient.UpsertMetadata(ctx, file.Model, metadata, nil); err != nil {
+			return fmt.Errorf("failed to record submission time: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (n *courseNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	return file.View()
+}
+
+// assignmentOf returns the assignment that given file belongs to, or nil if not under any drop-box.
+func (n *courseNavigator) assignmentOf(file *File) *ent.Assignment {
+	if len(n.assignments) == 0 {
+		return nil
+	}
+
+	for _, ancestor := range file.AncestorsChain() {
+		if a, ok := n.assignments[ancestor.ID()]; ok {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// revokeDelegates 清除导航树中授予的管理权限
+func revokeDelegates(f *File) {
+	f.delegate = nil
+	for _, child := range f.Children {
+		revokeDelegates(child)
+	}
+}
+
+// submissionFolderName 学生的提交目录以其用户 ID 命名
+func (n *courseNavigator) submissionFolderName() string {
+	return hashid.EncodeUserID(n.hasher, n.user.ID)
+}
+
+// isManager 教师与助教可以管理课程文件。站点管理员在 DBFS.courseMembership 中按教师身份处理
+func (n *courseNavigator) isManager() bool {
+	return n.role == coursemember.RoleTeacher || n.role == coursemember.RoleTa
+}
+
+// AssignmentAccepting returns whether the assignment accepts submissions at given time,
+// and whether the submission should be marked as late.
+func AssignmentAccepting(a *ent.Assignment, now time.Time) (bool, bool) {
+	if !now.After(a.Deadline) {
+		return true, false
+	}
+
+	switch a.LatePolicy {
+	case assignment.LatePolicyAllow:
+		return true, true
+	case assignment.LatePolicyUntil:
+		if a.LateDeadline != nil && !now.After(*a.LateDeadline) {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// validateSubmission validates the submitted file against assignment rules.
+func validateSubmission(name string, size int64, setting *types.AssignmentSetting) error {
+	if setting == nil {
+		return nil
+	}
+
+	if setting.MaxSize > 0 && size > setting.MaxSize {
+		return fs.ErrFileSizeTooBig
+	}
+
+	if len(setting.FileType) > 0 {
+		inList := util.IsInExtensionList(setting.FileType, name)
+		if (setting.IsFileTypeDenyList && inList) || (!setting.IsFileTypeDenyList && !inList) {
+			return fs.ErrIllegalObjectName.WithError(fmt.Errorf("file extension is not allowed"))
+		}
+	}
+
+	if setting.NameRegexp != "" {
+		match, err := regexp.MatchString(setting.NameRegexp, name)
+		if err != nil {
+			return fmt.Errorf("invalid file name regexp: %s", err)
+		}
+
+		if (setting.IsNameRegexpDenyList && match) || (!setting.IsNameRegexpDenyList && !match) {
+			return fs.ErrIllegalObjectName.WithError(fmt.Errorf("file name is not allowed by regexp"))
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
@@ -39,12 +40,15 @@ const (
 type (
 	ContextHintCtxKey      struct{}
 	ByPassOwnerCheckCtxKey struct{}
+	// UploadRequestCtx 在上传前钩子中传递上传请求
+	UploadRequestCtx struct{}
 )
 
 func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
 	l logging.Logger, ls lock.LockSystem, settingClient setting.Provider,
 	storagePolicyClient inventory.StoragePolicyClient, hasher hashid.Encoder, userClient inventory.UserClient,
-	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, encryptorFactory encrypt.CryptorFactory) fs.FileSystem {
+	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, encryptorFactory encrypt.CryptorFactory,
+	courseClient inventory.CourseClient) fs.FileSystem {
 	return &DBFS{
 		user:                u,
 		navigators:          make(map[string]Navigator),
@@ -60,6 +64,7 @@ func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inv
 		stateKv:             stateKv,
 		directLinkClient:    directLinkClient,
 		encryptorFactory:    encryptorFactory,
+		courseClient:        courseClient,
 	}
 }
 
@@ -71,6 +76,7 @@ type DBFS struct {
 	storagePolicyClient inventory.StoragePolicyClient
 	shareClient         inventory.ShareClient
 	directLinkClient    inventory.DirectLinkClient
+	courseClient        inventory.CourseClient
 	l                   logging.Logger
 	ls                  lock.LockSystem
 	settingClient       setting.Provider
@@ -233,8 +239,15 @@ func (f *DBFS) Capacity(ctx context.Context, u *ent.User) (*fs.Capacity, error)
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user's group", err)
 	}
 
+	// 课程空间的用量计入课程配额
+	if course, err := u.Edges.CourseOrErr(); err == nil && course != nil {
+		res.Used = u.Storage
+		res.Total = course.MaxStorage
+		return res, nil
+	}
+
 	res.Used = f.user.Storage
-	res.Total = requesterGroup.MaxStorage
+	res.Total = requesterGroup.MaxStorage + u.ExtraStorage
 	return res, nil
 }
 
@@ -448,7 +461,7 @@ func (f *DBFS) Get(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.Fil
 
 	// Calculate folder summary if requested
 	if o.loadFolderSummary && target != nil && target.Type() == types.FileTypeFolder {
-		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.OwnerID() != f.user.ID {
+		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.ManagedBy(f.user) {
 			return nil, fs.ErrOwnerOnly
 		}
 
@@ -544,7 +557,7 @@ func (f *DBFS) Walk(ctx context.Context, path *fs.URI, depth int, walk fs.WalkFu
 	}
 
 	// Require Read permission
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.OwnerID() != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.ManagedBy(f.user) {
 		return fs.ErrOwnerOnly
 	}
 
@@ -732,6 +745,12 @@ func (f *DBFS) getNavigator(ctx context.Context, path *fs.URI, requiredCapabilit
 			n = NewTrashNavigator(f.user, f.fileClient, f.l, config, f.hasher)
 		case constants.FileSystemSharedWithMe:
 			n = NewSharedWithMeNavigator(f.user, f.fileClient, f.l, config, f.hasher)
+		case constants.FileSystemCourse:
+			course, role, err := f.courseMembership(ctx, path)
+			if err != nil {
+				return nil, err
+			}
+			n = NewCourseNavigator(f.user, course, role, f.fileClient, f.l, config, f.hasher)
 		default:
 			return nil, fmt.Errorf("unknown file system %q", pathFs)
 		}
@@ -775,6 +794,8 @@ func (f *DBFS) navigatorId(path *fs.URI) string {
 		return fmt.Sprintf("%s/%s/%d", constants.FileSystemShare, path.ID(uidHashed), f.user.ID)
 	case constants.FileSystemTrash:
 		return fmt.Sprintf("%s/%s", constants.FileSystemTrash, path.ID(uidHashed))
+	case constants.FileSystemCourse:
+		return fmt.Sprintf("%s/%s/%d", constants.FileSystemCourse, path.ID(""), f.user.ID)
 	default:
 		return fmt.Sprintf("%s/%s/%d", path.FileSystem(), path.ID(uidHashed), f.user.ID)
 	}
@@ -797,7 +818,50 @@ func generateSavePath(policy *ent.StoragePolicy, req *fs.UploadRequest, user *en
 	return path.Join(path.Clean(dirRule), nameRule)
 }
 
+// courseMembership loads the course of given course URI, and the role of current user in it.
+// Site administrators are treated as teachers of all courses.
+func (f *DBFS) courseMembership(ctx context.Context, path *fs.URI) (*ent.Course, coursemember.Role, error) {
+	if inventory.IsAnonymousUser(f.user) {
+		return nil, "", ErrLoginRequired
+	}
+
+	courseID, err := f.hasher.Decode(path.ID(""), hashid.CourseID)
+	if err != nil {
+		return nil, "", fs.ErrPathNotExist.WithError(fmt.Errorf("invalid course id"))
+	}
+
+	course, err := f.courseClient.GetByID(context.WithValue(ctx, inventory.LoadCourseAccount{}, true), courseID)
+	if err != nil {
+		return nil, "", fs.ErrPathNotExist.WithError(fmt.Errorf("course not found: %w", err))
+	}
+
+	// 课程账号需要携带课程信息以便计算课程配额
+	course.Edges.Account.Edges.Course = course
+
+	assignments, err := f.courseClient.ListAssignments(ctx, courseID)
+	if err != nil {
+		return nil, "", serializer.NewError(serializer.CodeDBError, "Failed to list assignments", err)
+	}
+	course.Edges.Assignments = assignments
+
+	if f.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return course, coursemember.RoleTeacher, nil
+	}
+
+	member, err := f.courseClient.GetMember(ctx, courseID, f.user.ID)
+	if err != nil {
+		return nil, "", ErrPermissionDenied.WithError(fmt.Errorf("not a member of course %d", courseID))
+	}
+
+	return course, member.Role, nil
+}
+
 func canMoveOrCopyTo(src, dst *fs.URI, isCopy bool) bool {
+	// 课程空间内的文件只能在同一课程中移动或复制
+	if src.FileSystem() == constants.FileSystemCourse || dst.FileSystem() == constants.FileSystemCourse {
+		return src.FileSystem() == dst.FileSystem() && src.ID("") == dst.ID("")
+	}
+
 	if isCopy {
 		return src.FileSystem() == dst.FileSystem() && src.FileSystem() == constants.FileSystemMy
 	} else {
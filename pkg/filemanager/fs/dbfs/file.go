@@ -51,6 +51,8 @@ type (
 
 		disableView bool
 		mu          *sync.Mutex
+		// 课程导航器授予的管理权限，每次操作时判断，不随导航状态持久化
+		delegate func(u *ent.User) bool
 	}
 )
 
@@ -62,6 +64,9 @@ const (
 	MetadataRestoreUri          = MetadataSysPrefix + "restore_uri"
 	MetadataExpectedCollectTime = MetadataSysPrefix + "expected_collect_time"
 	MetadataSharedOwner         = MetadataSysPrefix + "shared_owner"
+	MetadataSubmittedAt         = MetadataSysPrefix + "submitted_at"
+	MetadataSubmittedBy         = MetadataSysPrefix + "submitted_by"
+	MetadataSubmissionLate      = MetadataSysPrefix + "submission_late"
 
 	ThumbMetadataPrefix = "thumb:"
 	ThumbDisabledKey    = ThumbMetadataPrefix + "disabled"
@@ -145,6 +150,37 @@ func (f *File) Owner() *ent.User {
 	return nil
 }
 
+// ManagedBy returns true if the given user owns the file, or the user root of the file
+// is delegated to the user (e.g. teachers of a course space).
+func (f *File) ManagedBy(u *ent.User) bool {
+	if u == nil {
+		return false
+	}
+
+	if owner := f.Owner(); owner != nil && owner.ID == u.ID {
+		return true
+	}
+
+	return f.DelegatedTo(u)
+}
+
+// DelegatedTo returns true if the file is reached through course navigator and the user is
+// granted to manage it, i.e. teachers of the course or students in their own submission folder.
+func (f *File) DelegatedTo(u *ent.User) bool {
+	if u == nil {
+		return false
+	}
+
+	// 课程空间中，教师对整个空间、学生对自己的提交目录拥有管理权限
+	for _, ancestor := range f.AncestorsChain() {
+		if ancestor.delegate != nil && ancestor.delegate(u) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (f *File) OwnerID() int {
 	return f.Model.OwnerID
 }
@@ -362,6 +398,7 @@ func (f *File) Recycle() {
 	f.Path[1] = nil
 	f.Parent = nil
 	f.OwnerModel = nil
+	f.delegate = nil
 	f.IsUserRoot = false
 	f.mu = nil
 
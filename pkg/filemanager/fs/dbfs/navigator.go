@@ -27,6 +27,7 @@ var (
 	ErrFileCountLimitedReached = serializer.NewError(serializer.CodeFileCountLimitedReached, "Walked file count reached limit", nil)
 	ErrSymbolicFolderFound     = serializer.NewError(serializer.CodeNoPermissionErr, "Symbolic folder cannot be walked into", nil)
 	ErrLoginRequired           = serializer.NewError(serializer.CodeCheckLogin, "Login required", nil)
+	ErrAssignmentClosed        = serializer.NewError(serializer.CodeAssignmentClosed, "Assignment is no longer accepting submissions", nil)
 
 	fullOrderByOption          = []string{"name", "size", "updated_at", "created_at"}
 	searchLimitedOrderByOption = []string{"created_at"}
@@ -147,6 +148,33 @@ func init() {
 		NavigatorCapabilityDownloadFile: true,
 		NavigatorCapabilityEnterFolder:  true,
 	}, sharedWithMeNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityCreateFile:     true,
+		NavigatorCapabilityRenameFile:     true,
+		NavigatorCapabilityUploadFile:     true,
+		NavigatorCapabilityDownloadFile:   true,
+		NavigatorCapabilityUpdateMetadata: true,
+		NavigatorCapabilityListChildren:   true,
+		NavigatorCapabilityGenerateThumb:  true,
+		NavigatorCapabilityDeleteFile:     true,
+		NavigatorCapabilityLockFile:       true,
+		NavigatorCapabilityInfo:           true,
+		NavigatorCapabilityVersionControl: true,
+		NavigatorCapabilityEnterFolder:    true,
+		NavigatorCapabilityModifyProps:    true,
+	}, courseManagerNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityCreateFile:    true,
+		NavigatorCapabilityRenameFile:    true,
+		NavigatorCapabilityUploadFile:    true,
+		NavigatorCapabilityDownloadFile:  true,
+		NavigatorCapabilityListChildren:  true,
+		NavigatorCapabilityGenerateThumb: true,
+		NavigatorCapabilityDeleteFile:    true,
+		NavigatorCapabilityLockFile:      true,
+		NavigatorCapabilityInfo:          true,
+		NavigatorCapabilityEnterFolder:   true,
+	}, courseStudentNavigatorCapability)
 }
 
 // ==================== Base Navigator ====================
@@ -586,3 +614,8 @@ func newSharedWithMeUri(id string) *fs.URI {
 	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemSharedWithMe))
 	return res.Join(id)
 }
+
+func newCourseUri(id string) *fs.URI {
+	res, _ := fs.NewUriFromString(fs.NewCourseUri(id))
+	return res
+}
@@ -0,0 +1,124 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+func (f *DBFS) PrepareRelocate(ctx context.Context, fileID int, dst *ent.StoragePolicy, d time.Duration) (*fs.PrepareRelocateRes, error) {
+	traversed, err := f.TraverseFile(context.WithValue(ctx, inventory.LoadFileEntity{}, true), fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := traversed.(*File)
+	if target.Type() != types.FileTypeFile {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("only files can be relocated"))
+	}
+
+	owner, err := f.userClient.GetByID(ctx, target.OwnerID())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get file owner", err)
+	}
+
+	// Traversed file tree is not built by navigator, complete the root so that lock conflicts can be resolved.
+	root := target.Root()
+	root.OwnerModel = owner
+	root.IsUserRoot = true
+
+	res := &fs.PrepareRelocateRes{
+		Entities: make(map[int]*fs.RelocateEntity),
+		Policy:   dst,
+	}
+
+	// Entities still being uploaded are skipped, they will be stored in the policy chosen by upload session.
+	ids := lo.FilterMap(target.Model.Edges.Entities, func(e *ent.Entity, index int) (int, bool) {
+		return e.ID, e.StoragePolicyEntities != dst.ID && e.ReferenceCount > 0 && e.UploadSessionID == nil
+	})
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	// Load all files linked to the entities, an entity can be shared by copies of the file.
+	entityCtx := context.WithValue(ctx, inventory.LoadFileEntity{}, false)
+	entityCtx = context.WithValue(entityCtx, inventory.LoadEntityFile{}, true)
+	uri := target.Uri(false)
+	req := &fs.UploadRequest{Props: &fs.UploadProps{Uri: uri}}
+	for page := 0; page >= 0; {
+		entities, next, err := f.fileClient.GetEntitiesByIDs(entityCtx, ids, page)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to get entities", err)
+		}
+
+		for _, e := range entities {
+			savePath := generateSavePath(dst, req, owner)
+			if types.EntityType(e.Type) == types.EntityTypeThumbnail {
+				savePath = path.Clean(util.ReplaceMagicVar(f.settingClient.ThumbEntitySuffix(ctx), fs.Separator, true, true, time.Now(), owner.ID, uri.Name(), uri.Path(), savePath))
+			}
+
+			res.Entities[e.ID] = &fs.RelocateEntity{
+				SrcEntity:   e,
+				FileUri:     uri,
+				NewSavePath: savePath,
+				ParentFiles: lo.Map(e.Edges.File, func(item *ent.File, index int) int {
+					return item.ID
+				}),
+				PrimaryEntityParentFiles: lo.FilterMap(e.Edges.File, func(item *ent.File, index int) (int, bool) {
+					return item.ID, item.PrimaryEntity == e.ID
+				}),
+			}
+		}
+
+		page = next
+	}
+
+	// Lock the file until relocation is committed, the lock will be released by caller.
+	ls, err := f.acquireByPath(ctx, d, f.user, true, fs.LockApp(fs.ApplicationRelocate),
+		&LockByPath{target.Uri(true), target, target.Type(), ""})
+	if err != nil {
+		_ = f.Release(ctx, ls)
+		return nil, err
+	}
+
+	res.LockToken = ls.LastToken()
+	return res, nil
+}
+
+func (f *DBFS) Relocate(ctx context.Context, res *fs.PrepareRelocateRes) ([]fs.Entity, error) {
+	fc, tx, ctx, err := inventory.WithTx(ctx, f.fileClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	stale := make([]fs.Entity, 0, len(res.Entities))
+	for _, e := range res.Entities {
+		old, err := fc.RelocateEntity(ctx, res.Policy.ID, &inventory.RelocateEntityParameter{
+			Entity:                   e.SrcEntity,
+			NewSource:                e.NewSavePath,
+			ParentFiles:              e.ParentFiles,
+			PrimaryEntityParentFiles: e.PrimaryEntityParentFiles,
+		})
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to relocate entity", err)
+		}
+
+		stale = append(stale, fs.NewEntity(old))
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit relocate change", err)
+	}
+
+	return stale, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
@@ -18,8 +19,9 @@ import (
 )
 
 var (
-	ErrShareNotFound = serializer.NewError(serializer.CodeNotFound, "Shared file does not exist", nil)
-	ErrNotPurchased  = serializer.NewError(serializer.CodePurchaseRequired, "You need to purchased this share", nil)
+	ErrShareNotFound     = serializer.NewError(serializer.CodeNotFound, "Shared file does not exist", nil)
+	ErrNotPurchased      = serializer.NewError(serializer.CodePurchaseRequired, "You need to purchased this share", nil)
+	ErrShareIPNotAllowed = serializer.NewError(serializer.CodeIPNotAllowed, "Access from current network is not allowed", nil)
 )
 
 const (
@@ -126,6 +128,18 @@ func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error)
 
 	n.owner = share.Edges.User
 
+	// 分享者本人不受分享的网络限制
+	if n.owner.ID != n.user.ID {
+		var ip string
+		if info := requestinfo.RequestInfoFromContext(ctx); info != nil {
+			ip = info.IP
+		}
+
+		if !inventory.IsShareIPAllowed(share, ip) {
+			return nil, ErrShareIPNotAllowed
+		}
+	}
+
 	// Check password
 	if share.Password != "" && share.Password != path.Password() {
 		return nil, ErrShareIncorrectPassword
@@ -2,6 +2,7 @@ package dbfs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"path"
@@ -23,7 +24,7 @@ func (f *DBFS) PreValidateUpload(ctx context.Context, dst *fs.URI, files ...fs.P
 		return err
 	}
 
-	dstFile, err := f.getFileByPath(ctx, navigator, dst)
+	dstFile, err := f.getUploadAncestor(ctx, navigator, dst)
 	if err != nil {
 		return fmt.Errorf("failed to get destination folder: %w", err)
 	}
@@ -33,15 +34,16 @@ func (f *DBFS) PreValidateUpload(ctx context.Context, dst *fs.URI, files ...fs.P
 	}
 
 	// check ownership and upload permission
-	// 只有管理员可以上传，普通用户不能上传
+	// 只有管理员可以上传，普通用户不能上传（通过课程空间访问、被授权管理的目录除外）
 	isAdmin := f.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
-	if !isAdmin {
+	courseDelegated := dstFile.DelegatedTo(f.user)
+	if !isAdmin && !courseDelegated {
 		return serializer.NewError(serializer.CodeNoPermissionErr, "您没有上传权限，只有管理员可以上传文件", nil)
 	}
 	
 	// 管理员可以上传到自己的文件夹或公共文件夹（管理员ID=1的公共文件夹）
 	// 如果上传到其他用户的文件夹，需要检查权限
-	if f.user.ID != dstFile.OwnerID() && dstFile.OwnerID() != 1 {
+	if f.user.ID != dstFile.OwnerID() && dstFile.OwnerID() != 1 && !courseDelegated {
 		return serializer.NewError(serializer.CodeNoPermissionErr, "您只能上传到自己的文件夹或公共文件夹", nil)
 	}
 
@@ -86,7 +88,7 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 
 	// Get most recent ancestor or target file
 	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
-	ancestor, err := f.getFileByPath(ctx, navigator, req.Props.Uri)
+	ancestor, err := f.getUploadAncestor(ctx, navigator, req.Props.Uri)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, fmt.Errorf("failed to get ancestor: %w", err)
 	}
@@ -113,11 +115,16 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 	// 检查上传权限：只有管理员可以上传，或者上传到自己的文件夹
 	isAdmin := f.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
 	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok {
-		if ancestor.OwnerID() != f.user.ID && !isAdmin {
+		if ancestor.OwnerID() != f.user.ID && !isAdmin && !ancestor.DelegatedTo(f.user) {
 			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "您没有上传权限，只有管理员可以上传文件", nil)
 		}
 	}
 
+	// 作业提交箱需要校验截止时间及文件规则
+	if err := navigator.ExecuteHook(context.WithValue(ctx, UploadRequestCtx{}, req), fs.HookTypeBeforeUpload, ancestor); err != nil {
+		return nil, err
+	}
+
 	// Lock target
 	lockedPath := ancestor.RootUri().JoinRaw(req.Props.Uri.PathTrimmed())
 	lr := &LockByPath{lockedPath, ancestor, types.FileTypeFile, ""}
@@ -356,6 +363,12 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 		tx.AppendStorageDiff(diff)
 	}
 
+	// 作业提交在完成时需再次校验截止时间并记录提交时间，失败则放弃本次上传
+	if err := f.executeNavigatorHooksInTx(ctx, fs.HookTypeAfterUpload, filePrivate); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
 	if err := inventory.CommitWithStorageDiff(ctx, tx, f.l, f.userClient); err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit file change", err)
 	}
@@ -402,7 +415,7 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 		}
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && filePrivate.OwnerID() != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !filePrivate.ManagedBy(f.user) {
 		return nil, fs.ErrOwnerOnly
 	}
 
@@ -459,3 +472,39 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 
 	return nil, nil
 }
+
+// getUploadAncestor is similar to getFileByPath, except that the submission folder of a student
+// is created when uploading to a course assignment for the first time.
+func (f *DBFS) getUploadAncestor(ctx context.Context, navigator Navigator, path *fs.URI) (*File, error) {
+	file, err := f.getFileByPath(ctx, navigator, path)
+	if err != nil && errors.Is(err, errSubmissionFolderNotCreated) {
+		n, ok := navigator.(*courseNavigator)
+		if !ok {
+			return file, err
+		}
+
+		if err := n.createSubmissionFolder(ctx, file); err != nil {
+			return nil, err
+		}
+
+		return navigator.To(ctx, path)
+	}
+
+	return file, err
+}
+
+// executeNavigatorHooksInTx executes navigator hooks within the transaction in context.
+func (f *DBFS) executeNavigatorHooksInTx(ctx context.Context, hookType fs.HookType, file *File) error {
+	navigator, err := f.getNavigator(ctx, file.Uri(false))
+	if err != nil {
+		return err
+	}
+
+	reset, err := navigator.FollowTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer reset()
+	return navigator.ExecuteHook(ctx, hookType, file)
+}
@@ -66,6 +66,11 @@ type (
 		SharedAddressTranslation(ctx context.Context, path *URI, opts ...Option) (File, *URI, error)
 		// ExecuteNavigatorHooks executes hooks of given type on a file for navigator based custom hooks.
 		ExecuteNavigatorHooks(ctx context.Context, hookType HookType, file File) error
+		// PrepareRelocate locks a file for duration d, returns its entities that are not stored in dst policy
+		// and their new save paths. Caller should unlock the file with returned lock token.
+		PrepareRelocate(ctx context.Context, fileID int, dst *ent.StoragePolicy, d time.Duration) (*PrepareRelocateRes, error)
+		// Relocate points prepared entities to their new save paths, returns stale entities holding the old blobs.
+		Relocate(ctx context.Context, res *PrepareRelocateRes) ([]Entity, error)
 	}
 
 	FileManager interface {
@@ -417,6 +422,10 @@ const (
 
 const (
 	HookTypeBeforeDownload = HookType(iota)
+	// HookTypeBeforeUpload is executed on the target file or its closest ancestor before upload session is created.
+	HookTypeBeforeUpload
+	// HookTypeAfterUpload is executed on the uploaded file after upload is completed.
+	HookTypeAfterUpload
 )
 
 func (p *UploadProps) Copy() *UploadProps {
@@ -361,6 +361,10 @@ func NewShareUri(id, password string) string {
 	return fmt.Sprintf("%s://%s@%s", constants.CloudreveScheme, id, constants.FileSystemShare)
 }
 
+func NewCourseUri(id string) string {
+	return fmt.Sprintf("%s://%s@%s", constants.CloudreveScheme, id, constants.FileSystemCourse)
+}
+
 func NewMyUri(id string) string {
 	if id == "" {
 		return fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemMy)
@@ -46,6 +46,9 @@ type (
 		ListPhysical(ctx context.Context, path string, policyID int, recursive bool, progress driver.ListProgressFunc) ([]fs.PhysicalObject, error)
 		// ImportPhysical imports a physical file to a Cloudreve file
 		ImportPhysical(ctx context.Context, dst *fs.URI, policyId int, src fs.PhysicalObject, completeHook bool) error
+		// Relocate copies entities of given file to dst storage policy and switches the file to use them,
+		// returns stale entities holding the old blobs to be recycled.
+		Relocate(ctx context.Context, fileID int, dst *ent.StoragePolicy) ([]fs.Entity, error)
 	}
 	DirectLink struct {
 		File fs.File
@@ -168,7 +171,7 @@ func (m *manager) GetUrlForRedirectedDirectLink(ctx context.Context, dl *ent.Dir
 	)
 
 	// Try to read from cache.
-	cacheKey := entityUrlCacheKey(primaryEntity.ID(), int64(dl.Speed), dl.Name, o.IsDownload,
+	cacheKey := entityUrlCacheKey(primaryEntity, int64(dl.Speed), dl.Name, o.IsDownload,
 		m.settings.SiteURL(ctx).String())
 	if cached, ok := m.kv.Get(cacheKey); ok {
 		cachedItem := cached.(EntityUrlCache)
@@ -265,7 +268,7 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 		}
 
 		// Try to read from cache.
-		cacheKey := entityUrlCacheKey(target.ID(), o.DownloadSpeed, getEntityDisplayName(file, target), o.IsDownload,
+		cacheKey := entityUrlCacheKey(target, o.DownloadSpeed, getEntityDisplayName(file, target), o.IsDownload,
 			m.settings.SiteURL(ctx).String())
 		if cached, ok := m.kv.Get(cacheKey); ok && !o.NoCache {
 			cachedItem := cached.(EntityUrlCache)
@@ -405,9 +408,11 @@ func (l *manager) ImportPhysical(ctx context.Context, dst *fs.URI, policyId int,
 	return nil
 }
 
-func entityUrlCacheKey(id int, speed int64, displayName string, download bool, siteUrl string) string {
+// entityUrlCacheKey returns the cache key of entity URL. Storage policy and source of the entity are
+// included, so that cached URLs of relocated entities are no longer used.
+func entityUrlCacheKey(e fs.Entity, speed int64, displayName string, download bool, siteUrl string) string {
 	hash := sha1.New()
-	hash.Write([]byte(fmt.Sprintf("%d_%d_%s_%t_%s", id,
+	hash.Write([]byte(fmt.Sprintf("%d_%d_%s_%d_%s_%t_%s", e.ID(), e.PolicyID(), e.Source(),
 		speed, displayName, download, siteUrl)))
 	hashRes := hex.EncodeToString(hash.Sum(nil))
 
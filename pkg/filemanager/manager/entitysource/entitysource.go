@@ -263,6 +263,11 @@ func (f *entitySource) IsLocal() bool {
 	return f.handler.Capabilities().StaticFeatures.Enabled(int(driver.HandlerCapabilityInboundGet))
 }
 
+// isStream returns true if the content can only be read as a stream from the handler.
+func (f *entitySource) isStream() bool {
+	return f.handler.Capabilities().StaticFeatures.Enabled(int(driver.HandlerCapabilityStreamGet))
+}
+
 func (f *entitySource) LocalPath(ctx context.Context) string {
 	return f.handler.LocalPath(ctx, f.e.Source())
 }
@@ -276,8 +281,8 @@ func (f *entitySource) Serve(w http.ResponseWriter, r *http.Request, opts ...Ent
 		opt.Apply(f.o)
 	}
 
-	if f.IsLocal() {
-		// For local files, validate file existence by resetting rsc
+	if f.IsLocal() || f.isStream() {
+		// For local and stream files, validate file existence by resetting rsc
 		if err := f.resetRequest(); err != nil {
 			f.l.Warning("Failed to serve local entity %q: %s", err, f.e.Source())
 			http.Error(w, "Entity data does not exist.", http.StatusNotFound)
@@ -311,7 +316,7 @@ func (f *entitySource) Serve(w http.ResponseWriter, r *http.Request, opts ...Ent
 		return
 	}
 
-	if !f.IsLocal() {
+	if !f.IsLocal() && !f.isStream() {
 		// for non-local file, reverse-proxy the request
 		expire := time.Now().Add(defaultUrlExpire)
 		u, err := f.Url(driver.WithForcePublicEndpoint(f.o.Ctx, false), WithNoInternalProxy(), WithExpire(&expire))
@@ -581,6 +586,7 @@ func (f *entitySource) ShouldInternalProxy(opts ...EntitySourceOption) bool {
 	}
 	handlerCapability := f.handler.Capabilities()
 	return f.e.ID() == 0 || handlerCapability.StaticFeatures.Enabled(int(driver.HandlerCapabilityProxyRequired)) ||
+		handlerCapability.StaticFeatures.Enabled(int(driver.HandlerCapabilityStreamGet)) ||
 		(f.policy.Settings.InternalProxy || f.e.Encrypted()) && !f.o.NoInternalProxy
 }
 
@@ -606,7 +612,7 @@ func (f *entitySource) Url(ctx context.Context, opts ...EntitySourceOption) (*En
 	}
 
 	// Use internal proxy URL if:
-	// 1. Internal proxy is required by driver's definition
+	// 1. Internal proxy is required by driver's definition, or content can only be read as a stream
 	// 2. Internal proxy is enabled in Policy setting and not disabled by option
 	// 3. It's an empty entity.
 	// 4. The entity is encrypted and internal proxy not disabled by option
@@ -703,6 +709,23 @@ func (f *entitySource) getRsc(pos int64) (io.ReadCloser, error) {
 		} else {
 			rsc = file
 		}
+	} else if f.isStream() {
+		opener, ok := f.handler.(driver.StreamOpener)
+		if !ok {
+			return nil, fmt.Errorf("handler does not implement stream opener")
+		}
+
+		stream, err := opener.OpenStream(f.o.Ctx, f.e.Source(), pos)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open stream: %w", err)
+		}
+
+		if f.o.SpeedLimit > 0 {
+			bucket := ratelimit.NewBucketWithRate(float64(f.o.SpeedLimit), f.o.SpeedLimit)
+			rsc = lrs{stream, ratelimit.Reader(stream, bucket)}
+		} else {
+			rsc = stream
+		}
 	} else {
 		var urlStr string
 		now := time.Now()
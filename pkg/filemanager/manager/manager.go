@@ -119,6 +119,8 @@ type (
 		Expire          *time.Time
 		ShareView       bool
 		ShowReadMe      bool
+		IPAllowList     []string
+		IPDenyList      []string
 	}
 )
 
@@ -148,7 +150,7 @@ func NewFileManager(dep dependency.Dep, u *ent.User) FileManager {
 		settings: dep.SettingProvider(),
 		fs: dbfs.NewDatabaseFS(u, dep.FileClient(), dep.ShareClient(), dep.Logger(), dep.LockSystem(),
 			dep.SettingProvider(), dep.StoragePolicyClient(), dep.HashIDEncoder(), dep.UserClient(), dep.KV(), dep.NavigatorStateKV(),
-			dep.DirectLinkClient(), dep.EncryptorFactory(context.TODO())),
+			dep.DirectLinkClient(), dep.EncryptorFactory(context.TODO()), dep.CourseClient()),
 		kv:           dep.KV(),
 		config:       config,
 		auth:         dep.GeneralAuth(),
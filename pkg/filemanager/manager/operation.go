@@ -268,8 +268,10 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 	}
 
 	props := &types.ShareProps{
-		ShareView:  args.ShareView,
-		ShowReadMe: args.ShowReadMe,
+		ShareView:   args.ShareView,
+		ShowReadMe:  args.ShowReadMe,
+		IPAllowList: args.IPAllowList,
+		IPDenyList:  args.IPDenyList,
 	}
 
 	share, err := shareClient.Upsert(ctx, &inventory.CreateShareParams{
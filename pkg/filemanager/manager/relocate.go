@@ -0,0 +1,88 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+)
+
+// relocateLockDuration is how long a file is locked for copying its entities. Relocation is still
+// safe if copying takes longer, since entities modified in between will not be switched.
+const relocateLockDuration = time.Hour
+
+func (m *manager) Relocate(ctx context.Context, fileID int, dst *ent.StoragePolicy) ([]fs.Entity, error) {
+	res, err := m.fs.PrepareRelocate(ctx, fileID, dst, relocateLockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Entities) == 0 {
+		return nil, nil
+	}
+
+	defer func() {
+		if err := m.fs.Unlock(ctx, res.LockToken); err != nil {
+			m.l.Warning("Failed to unlock file %d after relocation: %s", fileID, err)
+		}
+	}()
+
+	d, err := m.GetStorageDriver(ctx, m.CastStoragePolicyOnSlave(ctx, dst))
+	if err != nil {
+		return nil, err
+	}
+
+	copied := make([]string, 0, len(res.Entities))
+	for _, e := range res.Entities {
+		m.l.Debug("Copying entity %d of file %d to %q in storage policy %d", e.SrcEntity.ID, fileID, e.NewSavePath, dst.ID)
+		if err = m.copyEntity(ctx, e, dst); err != nil {
+			err = fmt.Errorf("failed to copy entity %d: %w", e.SrcEntity.ID, err)
+			break
+		}
+
+		copied = append(copied, e.NewSavePath)
+	}
+
+	var stale []fs.Entity
+	if err == nil {
+		stale, err = m.fs.Relocate(ctx, res)
+	}
+
+	if err != nil {
+		// Delete copied blobs since the file still uses the old ones
+		if len(copied) > 0 {
+			if _, deleteErr := d.Delete(context.Background(), copied...); deleteErr != nil {
+				m.l.Warning("Failed to delete copied blobs %v: %s", copied, deleteErr)
+			}
+		}
+
+		return nil, err
+	}
+
+	return stale, nil
+}
+
+// copyEntity copies the blob of an entity to its new save path in dst policy.
+func (m *manager) copyEntity(ctx context.Context, e *fs.RelocateEntity, dst *ent.StoragePolicy) error {
+	src, err := m.GetEntitySource(ctx, 0, fs.WithEntity(fs.NewEntity(e.SrcEntity)))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer src.Close()
+
+	// Encrypted blobs are copied as is, encrypt metadata is kept in the entity.
+	src.Apply(entitysource.WithDisableCryptor())
+
+	return m.Upload(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:      e.FileUri,
+			Size:     e.SrcEntity.Size,
+			SavePath: e.NewSavePath,
+		},
+		File:   src,
+		Seeker: src,
+	}, dst, nil)
+}
@@ -0,0 +1,299 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	RelocateTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *RelocateTaskState
+		progress queue.Progresses
+	}
+	RelocateTaskState struct {
+		// Uris of files/folders selected by user, empty if all entities in SrcPolicyID are relocated.
+		Uris        []string          `json:"uris,omitempty"`
+		SrcPolicyID int               `json:"src_policy_id,omitempty"`
+		DstPolicyID int               `json:"dst_policy_id"`
+		Files       []int             `json:"files,omitempty"`
+		Processed   int               `json:"processed,omitempty"`
+		Failed      int               `json:"failed,omitempty"`
+		Phase       RelocateTaskPhase `json:"phase,omitempty"`
+	}
+	RelocateTaskPhase string
+)
+
+const (
+	RelocateTaskPhaseNotStarted RelocateTaskPhase = "not_started"
+	RelocateTaskPhaseTransfer   RelocateTaskPhase = "transfer"
+
+	// relocateBatchSize is the number of files relocated before the task is suspended and its state persisted.
+	relocateBatchSize    = 100
+	relocateListPageSize = 1000
+
+	SummaryKeySrcPolicyID = "src_policy_id"
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.RelocateTaskType, NewRelocateTaskFromModel)
+}
+
+// NewRelocateTask creates a task to relocate all files under given uris to dst storage policy.
+func NewRelocateTask(ctx context.Context, uris []string, dstPolicyID int) (queue.Task, error) {
+	return newRelocateTask(ctx, &RelocateTaskState{
+		Uris:        uris,
+		DstPolicyID: dstPolicyID,
+	})
+}
+
+// NewPolicyRelocateTask creates a task to relocate all entities stored in src storage policy to dst storage policy.
+func NewPolicyRelocateTask(ctx context.Context, srcPolicyID, dstPolicyID int) (queue.Task, error) {
+	return newRelocateTask(ctx, &RelocateTaskState{
+		SrcPolicyID: srcPolicyID,
+		DstPolicyID: dstPolicyID,
+	})
+}
+
+func newRelocateTask(ctx context.Context, state *RelocateTaskState) (queue.Task, error) {
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &RelocateTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.RelocateTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: inventory.UserFromContext(ctx),
+		},
+	}
+	return t, nil
+}
+
+func NewRelocateTaskFromModel(task *ent.Task) queue.Task {
+	return &RelocateTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *RelocateTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &RelocateTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	var (
+		next = task.StatusCompleted
+		err  error
+	)
+	switch m.state.Phase {
+	case RelocateTaskPhaseNotStarted, "":
+		next, err = m.listFiles(ctx, dep)
+	case RelocateTaskPhaseTransfer:
+		next, err = m.transfer(ctx, dep)
+	default:
+		next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+// listFiles collects IDs of files to be relocated.
+func (m *RelocateTask) listFiles(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	var (
+		files []int
+		err   error
+	)
+	if m.state.SrcPolicyID > 0 {
+		files, err = m.listPolicyFiles(ctx, dep)
+	} else {
+		files, err = m.listUriFiles(ctx, dep)
+	}
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	m.state.Files = lo.Uniq(files)
+	m.l.Info("Relocating %d files to storage policy %d", len(m.state.Files), m.state.DstPolicyID)
+	m.state.Phase = RelocateTaskPhaseTransfer
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+func (m *RelocateTask) listUriFiles(ctx context.Context, dep dependency.Dep) ([]int, error) {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	files := make([]int, 0)
+	for _, src := range m.state.Uris {
+		uri, err := fs.NewUriFromString(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse src uri %q: %s (%w)", src, err, queue.CriticalErr)
+		}
+
+		// Files of other users (e.g. in shared folders) are not relocated.
+		if err := fm.Walk(ctx, uri, intsets.MaxInt, func(f fs.File, level int) error {
+			if f.Type() == types.FileTypeFile && f.OwnerID() == user.ID {
+				files = append(files, f.ID())
+			}
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("failed to walk %q: %w", src, err)
+		}
+	}
+
+	return files, nil
+}
+
+func (m *RelocateTask) listPolicyFiles(ctx context.Context, dep dependency.Dep) ([]int, error) {
+	fc := dep.FileClient()
+	files := make([]int, 0)
+	listCtx := context.WithValue(ctx, inventory.LoadEntityFile{}, true)
+	for page := 0; ; page++ {
+		res, err := fc.ListEntities(listCtx, &inventory.ListEntityParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: relocateListPageSize,
+			},
+			StoragePolicyID: m.state.SrcPolicyID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list entities: %w", err)
+		}
+
+		for _, e := range res.Entities {
+			files = append(files, lo.Map(e.Edges.File, func(f *ent.File, index int) int {
+				return f.ID
+			})...)
+		}
+
+		if len(res.Entities) < relocateListPageSize {
+			break
+		}
+	}
+
+	return files, nil
+}
+
+// transfer relocates next batch of files.
+func (m *RelocateTask) transfer(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	dst, err := dep.StoragePolicyClient().GetPolicyByID(ctx, m.state.DstPolicyID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get dst storage policy: %s (%w)", err, queue.CriticalErr)
+	}
+
+	m.Lock()
+	m.progress[ProgressTypeRelocateTransferCount] = &queue.Progress{
+		Total:   int64(len(m.state.Files)),
+		Current: int64(m.state.Processed),
+	}
+	m.Unlock()
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	defer fm.Recycle()
+
+	end := min(m.state.Processed+relocateBatchSize, len(m.state.Files))
+	stale := make([]int, 0)
+	for _, fileID := range m.state.Files[m.state.Processed:end] {
+		entities, err := fm.Relocate(ctx, fileID, dst)
+		if err != nil {
+			m.l.Warning("Failed to relocate file %d: %s, skipping", fileID, err)
+			m.state.Failed++
+		}
+
+		for _, e := range entities {
+			stale = append(stale, e.ID())
+		}
+
+		m.state.Processed++
+		atomic.AddInt64(&m.progress[ProgressTypeRelocateTransferCount].Current, 1)
+	}
+
+	if len(stale) > 0 {
+		// Cached URLs of relocated entities are keyed by their old source and expire on their own.
+		if err := fm.RecycleEntities(ctx, false, stale...); err != nil {
+			m.l.Warning("Failed to recycle stale entities %v: %s, they will be collected by entity recycle routine", stale, err)
+		}
+	}
+
+	if m.state.Processed < len(m.state.Files) {
+		m.ResumeAfter(0)
+		return task.StatusSuspending, nil
+	}
+
+	m.l.Info("Relocation finished, %d files failed", m.state.Failed)
+	return task.StatusCompleted, nil
+}
+
+func (m *RelocateTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *RelocateTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	// unmarshal state
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	props := map[string]any{
+		SummaryKeySrcMultiple:    m.state.Uris,
+		SummaryKeySrcDstPolicyID: hashid.EncodePolicyID(hasher, m.state.DstPolicyID),
+		SummaryKeyFailed:         m.state.Failed,
+	}
+	if m.state.SrcPolicyID > 0 {
+		props[SummaryKeySrcPolicyID] = hashid.EncodePolicyID(hasher, m.state.SrcPolicyID)
+	}
+
+	return &queue.Summary{
+		Phase: string(m.state.Phase),
+		Props: props,
+	}
+}
@@ -22,6 +22,8 @@ const (
 	TaskID
 	DavAccountID
 	PaymentID
+	CourseID     // 课程
+	AssignmentID // 作业
 )
 
 var (
@@ -141,6 +143,18 @@ func EncodeDavAccountID(encoder Encoder, id int) string {
 	return res
 }
 
+// EncodeCourseID encode course id to hash id
+func EncodeCourseID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, CourseID})
+	return res
+}
+
+// EncodeAssignmentID encode assignment id to hash id
+func EncodeAssignmentID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, AssignmentID})
+	return res
+}
+
 // EncodeSourceLinkID encode source link id to hash id
 func EncodeSourceLinkID(encoder Encoder, id int) string {
 	res, _ := encoder.Encode([]int{id, SourceLinkID})
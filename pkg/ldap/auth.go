@@ -0,0 +1,198 @@
+package ldap
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid LDAP credentials")
+	ErrAmbiguousUser      = errors.New("multiple LDAP entries match the login name")
+	ErrInvalidConfig      = errors.New("invalid LDAP settings")
+)
+
+const (
+	// IdentityProvider is the provider ID of LDAP accounts in linked external identities.
+	IdentityProvider = "ldap"
+
+	usernamePlaceholder = "{username}"
+)
+
+// Validate checks required settings of the LDAP backend.
+func Validate(config *setting.LDAP) error {
+	if config.URL == "" {
+		return fmt.Errorf("%w: server URL is empty", ErrInvalidConfig)
+	}
+
+	if config.BaseDN == "" {
+		return fmt.Errorf("%w: base DN is empty", ErrInvalidConfig)
+	}
+
+	if !strings.Contains(config.UserFilter, usernamePlaceholder) {
+		return fmt.Errorf("%w: user filter must contain %s", ErrInvalidConfig, usernamePlaceholder)
+	}
+
+	if _, err := compileFilter(strings.ReplaceAll(config.UserFilter, usernamePlaceholder, "x")); err != nil {
+		return fmt.Errorf("%w: invalid user filter: %s", ErrInvalidConfig, err)
+	}
+
+	return nil
+}
+
+// Authenticate searches the user by login name with the service account, then binds as the user to verify password.
+// ErrInvalidCredentials is returned if user is not found or password is wrong.
+func Authenticate(ctx context.Context, config *setting.LDAP, username, password string) (*Entry, error) {
+	return AuthenticateWith(ctx, config, username, password, nil)
+}
+
+// AuthenticateWith is similar to Authenticate, except that beforeBind is called with the found entry before
+// binding as the user. Password is not verified if beforeBind returns an error.
+func AuthenticateWith(ctx context.Context, config *setting.LDAP, username, password string, beforeBind func(*Entry) error) (*Entry, error) {
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	conn, err := Dial(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if config.BindDN != "" {
+		if err := conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			return nil, fmt.Errorf("failed to bind service account: %w", err)
+		}
+	}
+
+	entries, err := conn.Search(&SearchRequest{
+		BaseDN:     config.BaseDN,
+		Scope:      ScopeWholeSubtree,
+		Filter:     strings.ReplaceAll(config.UserFilter, usernamePlaceholder, EscapeFilter(username)),
+		Attributes: requestedAttributes(config),
+		SizeLimit:  2,
+	})
+	if err != nil && !IsResultCode(err, ResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("failed to search user: %w", err)
+	}
+
+	switch {
+	case len(entries) == 0:
+		return nil, ErrInvalidCredentials
+	case len(entries) > 1 || err != nil:
+		return nil, ErrAmbiguousUser
+	}
+
+	if beforeBind != nil {
+		if err := beforeBind(entries[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := conn.Bind(entries[0].DN, password); err != nil {
+		if IsResultCode(err, ResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to bind user: %w", err)
+	}
+
+	return entries[0], nil
+}
+
+// TestConnection connects and binds with the service account.
+func TestConnection(ctx context.Context, config *setting.LDAP) error {
+	if err := Validate(config); err != nil {
+		return err
+	}
+
+	conn, err := Dial(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if config.BindDN != "" {
+		if err := conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			return fmt.Errorf("failed to bind service account: %w", err)
+		}
+	}
+
+	_, err = conn.Search(&SearchRequest{
+		BaseDN:    config.BaseDN,
+		Scope:     ScopeBaseObject,
+		Filter:    "(objectClass=*)",
+		SizeLimit: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read base DN: %w", err)
+	}
+
+	return nil
+}
+
+// Subject returns the value uniquely identifies the entry. Binary values like objectGUID are hex encoded.
+func Subject(config *setting.LDAP, entry *Entry) string {
+	if config.SubjectAttr == "" {
+		return strings.ToLower(entry.DN)
+	}
+
+	values := entry.Attr(config.SubjectAttr)
+	if len(values) == 0 {
+		return ""
+	}
+
+	if !utf8.ValidString(values[0]) {
+		return hex.EncodeToString([]byte(values[0]))
+	}
+	return values[0]
+}
+
+// MapGroup returns the group ID of the first mapping rule matching groups of entry, or 0 if none matches.
+func MapGroup(config *setting.LDAP, entry *Entry) int {
+	if config.Attributes.Group == "" {
+		return 0
+	}
+
+	groups := entry.Attr(config.Attributes.Group)
+	for _, rule := range config.GroupMapping {
+		for _, group := range groups {
+			if strings.EqualFold(strings.TrimSpace(group), strings.TrimSpace(rule.LDAPGroup)) {
+				return rule.GroupID
+			}
+		}
+	}
+
+	return 0
+}
+
+func requestedAttributes(config *setting.LDAP) []string {
+	var res []string
+	for _, attr := range []string{
+		config.SubjectAttr,
+		config.Attributes.StudentID,
+		config.Attributes.University,
+		config.Attributes.Major,
+		config.Attributes.Nick,
+		config.Attributes.Email,
+		config.Attributes.Group,
+	} {
+		if attr != "" && !strings.EqualFold(attr, "dn") {
+			res = append(res, attr)
+		}
+	}
+
+	// "1.1" requests no attributes (RFC 4511 section 4.5.1.8)
+	if len(res) == 0 {
+		return []string{"1.1"}
+	}
+	return res
+}
@@ -0,0 +1,258 @@
+package ldap
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BER identifier classes and universal tags used by LDAPv3 (RFC 4511).
+const (
+	classUniversal   byte = 0x00
+	classApplication byte = 0x40
+	classContext     byte = 0x80
+
+	tagBoolean     byte = 0x01
+	tagInteger     byte = 0x02
+	tagOctetString byte = 0x04
+	tagNull        byte = 0x05
+	tagEnumerated  byte = 0x0a
+	tagSequence    byte = 0x10
+	tagSet         byte = 0x11
+
+	// maxPacketSize limits the size of a single message read from server.
+	maxPacketSize = 16 << 20
+)
+
+var errMalformedPacket = errors.New("malformed BER packet")
+
+// packet is a decoded BER element.
+type packet struct {
+	class       byte
+	constructed bool
+	tag         byte
+	// value is the content of primitive element.
+	value []byte
+	// children are the elements of constructed element.
+	children []*packet
+}
+
+func newPrimitive(class, tag byte, value []byte) *packet {
+	return &packet{class: class, tag: tag, value: value}
+}
+
+func newConstructed(class, tag byte, children ...*packet) *packet {
+	return &packet{class: class, constructed: true, tag: tag, children: children}
+}
+
+func newSequence(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSequence, children...)
+}
+
+func newOctetString(s string) *packet {
+	return newPrimitive(classUniversal, tagOctetString, []byte(s))
+}
+
+func newInteger(v int64) *packet {
+	return newPrimitive(classUniversal, tagInteger, encodeInt(v))
+}
+
+func newEnumerated(v int64) *packet {
+	return newPrimitive(classUniversal, tagEnumerated, encodeInt(v))
+}
+
+func newBoolean(v bool) *packet {
+	if v {
+		return newPrimitive(classUniversal, tagBoolean, []byte{0xff})
+	}
+	return newPrimitive(classUniversal, tagBoolean, []byte{0x00})
+}
+
+func (p *packet) is(class, tag byte) bool {
+	return p.class == class && p.tag == tag
+}
+
+// child returns the i-th child, or nil if not exist.
+func (p *packet) child(i int) *packet {
+	if i < 0 || i >= len(p.children) {
+		return nil
+	}
+	return p.children[i]
+}
+
+func (p *packet) str() string {
+	return string(p.value)
+}
+
+func (p *packet) int() (int64, error) {
+	if len(p.value) == 0 || len(p.value) > 8 {
+		return 0, errMalformedPacket
+	}
+
+	// Sign extension of two's complement
+	var v int64
+	if p.value[0]&0x80 != 0 {
+		v = -1
+	}
+	for _, b := range p.value {
+		v = v<<8 | int64(b)
+	}
+	return v, nil
+}
+
+func (p *packet) encode() []byte {
+	content := p.value
+	if p.constructed {
+		content = nil
+		for _, c := range p.children {
+			content = append(content, c.encode()...)
+		}
+	}
+
+	identifier := p.class | p.tag
+	if p.constructed {
+		identifier |= 0x20
+	}
+
+	res := append([]byte{identifier}, encodeLength(len(content))...)
+	return append(res, content...)
+}
+
+func encodeInt(v int64) []byte {
+	res := []byte{byte(v)}
+	for v > 0x7f || v < -0x80 {
+		v >>= 8
+		res = append([]byte{byte(v)}, res...)
+	}
+	return res
+}
+
+func encodeLength(l int) []byte {
+	if l < 0x80 {
+		return []byte{byte(l)}
+	}
+
+	var res []byte
+	for ; l > 0; l >>= 8 {
+		res = append([]byte{byte(l)}, res...)
+	}
+	return append([]byte{0x80 | byte(len(res))}, res...)
+}
+
+// readPacket reads and decodes one complete BER element from r.
+func readPacket(r *bufio.Reader) (*packet, error) {
+	identifier, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+
+	content := make([]byte, l)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return nil, err
+	}
+
+	return decodeContent(identifier, content)
+}
+
+func readLength(r io.ByteReader) (int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+
+	if b&0x80 == 0 {
+		return int(b), nil
+	}
+
+	// Indefinite length form is not allowed in LDAP
+	n := int(b & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, errMalformedPacket
+	}
+
+	l := 0
+	for i := 0; i < n; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		l = l<<8 | int(b)
+	}
+
+	if l > maxPacketSize {
+		return 0, fmt.Errorf("packet of %d bytes exceeds size limit", l)
+	}
+
+	return l, nil
+}
+
+// parsePacket decodes one BER element from the beginning of b, returning the element and its total length.
+func parsePacket(b []byte) (*packet, int, error) {
+	if len(b) < 2 {
+		return nil, 0, errMalformedPacket
+	}
+
+	r := &byteReader{b: b[1:]}
+	l, err := readLength(r)
+	if err != nil {
+		return nil, 0, errMalformedPacket
+	}
+
+	start := 1 + r.pos
+	if l > len(b)-start {
+		return nil, 0, errMalformedPacket
+	}
+
+	p, err := decodeContent(b[0], b[start:start+l])
+	if err != nil {
+		return nil, 0, err
+	}
+	return p, start + l, nil
+}
+
+func decodeContent(identifier byte, content []byte) (*packet, error) {
+	// High tag numbers are not used in LDAP
+	if identifier&0x1f == 0x1f {
+		return nil, errMalformedPacket
+	}
+
+	p := &packet{
+		class:       identifier & 0xc0,
+		constructed: identifier&0x20 != 0,
+		tag:         identifier & 0x1f,
+	}
+	if !p.constructed {
+		p.value = content
+		return p, nil
+	}
+
+	for len(content) > 0 {
+		child, n, err := parsePacket(content)
+		if err != nil {
+			return nil, err
+		}
+		p.children = append(p.children, child)
+		content = content[n:]
+	}
+
+	return p, nil
+}
+
+type byteReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *byteReader) ReadByte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.pos++
+	return r.b[r.pos-1], nil
+}
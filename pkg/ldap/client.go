@@ -0,0 +1,362 @@
+package ldap
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+// Protocol operation tags defined in RFC 4511 section 4.2.
+const (
+	opBindRequest          byte = 0
+	opBindResponse         byte = 1
+	opUnbindRequest        byte = 2
+	opSearchRequest        byte = 3
+	opSearchResultEntry    byte = 4
+	opSearchResultDone     byte = 5
+	opSearchResultRef      byte = 19
+	opExtendedRequest      byte = 23
+	opExtendedResponse     byte = 24
+	opIntermediateResponse byte = 25
+
+	startTLSOID    = "1.3.6.1.4.1.1466.20037"
+	defaultTimeout = 10 * time.Second
+)
+
+type Scope int64
+
+const (
+	ScopeBaseObject   = Scope(0)
+	ScopeSingleLevel  = Scope(1)
+	ScopeWholeSubtree = Scope(2)
+)
+
+// Result codes used by this package, see RFC 4511 appendix A.
+const (
+	ResultSuccess            = 0
+	ResultSizeLimitExceeded  = 4
+	ResultInvalidCredentials = 49
+)
+
+// ResultError is a non-success LDAPResult returned by server.
+type ResultError struct {
+	Code    int64
+	Message string
+}
+
+func (e *ResultError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("LDAP result code %d", e.Code)
+	}
+	return fmt.Sprintf("LDAP result code %d: %s", e.Code, e.Message)
+}
+
+// IsResultCode returns true if err is a ResultError with given code.
+func IsResultCode(err error, code int64) bool {
+	var resErr *ResultError
+	return errors.As(err, &resErr) && resErr.Code == code
+}
+
+// Entry is a search result entry. Attribute names are stored in lower case.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Attr returns values of given attribute, names are case-insensitive. "dn" returns the entry DN.
+func (e *Entry) Attr(name string) []string {
+	if strings.EqualFold(name, "dn") {
+		return []string{e.DN}
+	}
+	return e.Attributes[strings.ToLower(name)]
+}
+
+// First returns the first value of given attribute, or empty string if name is empty or not found.
+func (e *Entry) First(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	if values := e.Attr(name); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// SearchRequest is the parameters of a search operation.
+type SearchRequest struct {
+	BaseDN     string
+	Scope      Scope
+	Filter     string
+	Attributes []string
+	SizeLimit  int64
+}
+
+// Conn is a connection to LDAP server. It is not safe for concurrent use.
+type Conn struct {
+	conn  net.Conn
+	r     *bufio.Reader
+	msgID int64
+}
+
+// Dial connects to the server configured in settings, upgrading to TLS with StartTLS if required.
+func Dial(ctx context.Context, config *setting.LDAP) (*Conn, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP URL: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+
+	var conn net.Conn
+	switch strings.ToLower(u.Scheme) {
+	case "ldap":
+		conn, err = dialer.DialContext(ctx, "tcp", hostPort(u, "389"))
+	case "ldaps":
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", hostPort(u, "636"))
+	default:
+		return nil, fmt.Errorf("unsupported LDAP URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+	conn.SetDeadline(deadline)
+
+	c := &Conn{conn: conn, r: bufio.NewReader(conn)}
+	if config.StartTLS && strings.EqualFold(u.Scheme, "ldap") {
+		if err := c.startTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+		c.conn.SetDeadline(deadline)
+	}
+
+	return c, nil
+}
+
+func hostPort(u *url.URL, defaultPort string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), defaultPort)
+}
+
+// Close sends an unbind request and closes the connection.
+func (c *Conn) Close() error {
+	c.send(newPrimitive(classApplication, opUnbindRequest, nil))
+	return c.conn.Close()
+}
+
+// Bind performs a simple bind. Empty password is rejected to avoid unauthenticated binds (RFC 4513 section 5.1.2).
+func (c *Conn) Bind(dn, password string) error {
+	if password == "" {
+		return &ResultError{Code: ResultInvalidCredentials, Message: "empty password"}
+	}
+
+	op := newConstructed(classApplication, opBindRequest,
+		newInteger(3),
+		newOctetString(dn),
+		newPrimitive(classContext, 0, []byte(password)),
+	)
+	resp, err := c.roundTrip(op, opBindResponse)
+	if err != nil {
+		return err
+	}
+
+	return resultError(resp)
+}
+
+// Search performs a search operation, search result references are ignored.
+func (c *Conn) Search(req *SearchRequest) ([]*Entry, error) {
+	filter, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	attributes := newSequence()
+	for _, attr := range req.Attributes {
+		attributes.children = append(attributes.children, newOctetString(attr))
+	}
+
+	msgID := c.send(newConstructed(classApplication, opSearchRequest,
+		newOctetString(req.BaseDN),
+		newEnumerated(int64(req.Scope)),
+		newEnumerated(0), // neverDerefAliases
+		newInteger(req.SizeLimit),
+		newInteger(0),
+		newBoolean(false),
+		filter,
+		attributes,
+	))
+	if msgID < 0 {
+		return nil, errors.New("failed to send search request")
+	}
+
+	var entries []*Entry
+	for {
+		op, err := c.receive(msgID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case op.is(classApplication, opSearchResultEntry):
+			entry, err := parseEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		case op.is(classApplication, opSearchResultRef):
+		case op.is(classApplication, opSearchResultDone):
+			return entries, resultError(op)
+		default:
+			return nil, fmt.Errorf("unexpected response tag %d", op.tag)
+		}
+	}
+}
+
+func (c *Conn) startTLS(config *tls.Config) error {
+	op := newConstructed(classApplication, opExtendedRequest,
+		newPrimitive(classContext, 0, []byte(startTLSOID)),
+	)
+	resp, err := c.roundTrip(op, opExtendedResponse)
+	if err != nil {
+		return err
+	}
+
+	if err := resultError(resp); err != nil {
+		return err
+	}
+
+	tlsConn := tls.Client(c.conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+
+	c.conn = tlsConn
+	c.r = bufio.NewReader(tlsConn)
+	return nil
+}
+
+func (c *Conn) roundTrip(op *packet, respTag byte) (*packet, error) {
+	msgID := c.send(op)
+	if msgID < 0 {
+		return nil, errors.New("failed to send request")
+	}
+
+	for {
+		resp, err := c.receive(msgID)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.is(classApplication, opIntermediateResponse) {
+			continue
+		}
+
+		if !resp.is(classApplication, respTag) {
+			return nil, fmt.Errorf("unexpected response tag %d", resp.tag)
+		}
+		return resp, nil
+	}
+}
+
+// send writes op in a new LDAPMessage, returning the message ID or -1 on failure.
+func (c *Conn) send(op *packet) int64 {
+	c.msgID++
+	if _, err := c.conn.Write(newSequence(newInteger(c.msgID), op).encode()); err != nil {
+		return -1
+	}
+	return c.msgID
+}
+
+// receive reads the next message of msgID and returns its protocol operation.
+func (c *Conn) receive(msgID int64) (*packet, error) {
+	for {
+		msg, err := readPacket(c.r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read LDAP response: %w", err)
+		}
+
+		if !msg.is(classUniversal, tagSequence) || len(msg.children) < 2 {
+			return nil, errMalformedPacket
+		}
+
+		id, err := msg.child(0).int()
+		if err != nil {
+			return nil, err
+		}
+
+		// Unsolicited notification, usually notice of disconnection
+		if id == 0 {
+			if err := resultError(msg.child(1)); err != nil {
+				return nil, fmt.Errorf("server closed connection: %w", err)
+			}
+			continue
+		}
+
+		if id == msgID {
+			return msg.child(1), nil
+		}
+	}
+}
+
+// resultError converts an LDAPResult into error if it's not successful.
+func resultError(result *packet) error {
+	code := result.child(0)
+	if code == nil {
+		return errMalformedPacket
+	}
+
+	v, err := code.int()
+	if err != nil {
+		return err
+	}
+
+	if v == ResultSuccess {
+		return nil
+	}
+
+	resErr := &ResultError{Code: v}
+	if msg := result.child(2); msg != nil {
+		resErr.Message = msg.str()
+	}
+	return resErr
+}
+
+func parseEntry(op *packet) (*Entry, error) {
+	if len(op.children) < 2 {
+		return nil, errMalformedPacket
+	}
+
+	entry := &Entry{DN: op.child(0).str(), Attributes: make(map[string][]string)}
+	for _, attr := range op.child(1).children {
+		if len(attr.children) < 2 {
+			return nil, errMalformedPacket
+		}
+
+		name := strings.ToLower(attr.child(0).str())
+		for _, v := range attr.child(1).children {
+			entry.Attributes[name] = append(entry.Attributes[name], v.str())
+		}
+	}
+
+	return entry, nil
+}
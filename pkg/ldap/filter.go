@@ -0,0 +1,213 @@
+package ldap
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Filter choice tags defined in RFC 4511 section 4.5.1.
+const (
+	filterAnd            byte = 0
+	filterOr             byte = 1
+	filterNot            byte = 2
+	filterEqualityMatch  byte = 3
+	filterSubstrings     byte = 4
+	filterGreaterOrEqual byte = 5
+	filterLessOrEqual    byte = 6
+	filterPresent        byte = 7
+	filterApproxMatch    byte = 8
+
+	substringInitial byte = 0
+	substringAny     byte = 1
+	substringFinal   byte = 2
+)
+
+// EscapeFilter escapes special characters of an assertion value as defined in RFC 4515.
+func EscapeFilter(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\', '*', '(', ')', 0:
+			fmt.Fprintf(&b, "\\%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// compileFilter compiles a string filter (RFC 4515) into BER packet.
+func compileFilter(filter string) (*packet, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, fmt.Errorf("empty filter")
+	}
+
+	// Outermost parentheses are optional
+	if filter[0] != '(' {
+		filter = "(" + filter + ")"
+	}
+
+	p, n, err := parseFilter(filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != len(filter) {
+		return nil, fmt.Errorf("unexpected %q at position %d of filter", filter[n:], n)
+	}
+
+	return p, nil
+}
+
+// parseFilter parses the filter starting with '(' at pos, returning the packet and the position after ')'.
+func parseFilter(filter string, pos int) (*packet, int, error) {
+	if pos >= len(filter) || filter[pos] != '(' {
+		return nil, 0, fmt.Errorf("expected '(' at position %d of filter", pos)
+	}
+	pos++
+	if pos >= len(filter) {
+		return nil, 0, fmt.Errorf("unexpected end of filter")
+	}
+
+	switch filter[pos] {
+	case '&', '|':
+		tag := filterAnd
+		if filter[pos] == '|' {
+			tag = filterOr
+		}
+
+		set := newConstructed(classContext, tag)
+		pos++
+		for pos < len(filter) && filter[pos] == '(' {
+			child, next, err := parseFilter(filter, pos)
+			if err != nil {
+				return nil, 0, err
+			}
+			set.children = append(set.children, child)
+			pos = next
+		}
+
+		if len(set.children) == 0 {
+			return nil, 0, fmt.Errorf("empty filter list at position %d of filter", pos)
+		}
+		return closeFilter(filter, pos, set)
+	case '!':
+		child, next, err := parseFilter(filter, pos+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		return closeFilter(filter, next, newConstructed(classContext, filterNot, child))
+	default:
+		end := strings.IndexByte(filter[pos:], ')')
+		if end < 0 {
+			return nil, 0, fmt.Errorf("unclosed filter at position %d", pos)
+		}
+
+		item, err := parseItem(filter[pos : pos+end])
+		if err != nil {
+			return nil, 0, err
+		}
+		return item, pos + end + 1, nil
+	}
+}
+
+func closeFilter(filter string, pos int, p *packet) (*packet, int, error) {
+	if pos >= len(filter) || filter[pos] != ')' {
+		return nil, 0, fmt.Errorf("expected ')' at position %d of filter", pos)
+	}
+	return p, pos + 1, nil
+}
+
+// parseItem parses simple, present and substring items like "attr=value".
+func parseItem(item string) (*packet, error) {
+	eq := strings.IndexByte(item, '=')
+	if eq <= 0 {
+		return nil, fmt.Errorf("invalid filter item %q", item)
+	}
+
+	attr, value := item[:eq], item[eq+1:]
+	tag := filterEqualityMatch
+	switch attr[len(attr)-1] {
+	case '>':
+		tag = filterGreaterOrEqual
+	case '<':
+		tag = filterLessOrEqual
+	case '~':
+		tag = filterApproxMatch
+	case ':':
+		return nil, fmt.Errorf("extensible match is not supported")
+	}
+	if tag != filterEqualityMatch {
+		attr = attr[:len(attr)-1]
+	}
+
+	if attr == "" || strings.ContainsAny(attr, "()*\\ ") {
+		return nil, fmt.Errorf("invalid attribute in filter item %q", item)
+	}
+
+	if tag == filterEqualityMatch && strings.Contains(value, "*") {
+		if value == "*" {
+			return newPrimitive(classContext, filterPresent, []byte(attr)), nil
+		}
+		return parseSubstrings(attr, value)
+	}
+
+	unescaped, err := unescapeValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return newConstructed(classContext, tag, newOctetString(attr), newOctetString(unescaped)), nil
+}
+
+func parseSubstrings(attr, value string) (*packet, error) {
+	parts := strings.Split(value, "*")
+	substrings := newSequence()
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		unescaped, err := unescapeValue(part)
+		if err != nil {
+			return nil, err
+		}
+
+		tag := substringAny
+		if i == 0 {
+			tag = substringInitial
+		} else if i == len(parts)-1 {
+			tag = substringFinal
+		}
+		substrings.children = append(substrings.children, newPrimitive(classContext, tag, []byte(unescaped)))
+	}
+
+	return newConstructed(classContext, filterSubstrings, newOctetString(attr), substrings), nil
+}
+
+func unescapeValue(value string) (string, error) {
+	if !strings.Contains(value, "\\") {
+		return value, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			b.WriteByte(value[i])
+			continue
+		}
+
+		if i+3 > len(value) {
+			return "", fmt.Errorf("invalid escape sequence in filter value %q", value)
+		}
+		decoded, err := hex.DecodeString(value[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence in filter value %q", value)
+		}
+		b.Write(decoded)
+		i += 2
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,255 @@
+package ldap
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// mockServer is a minimal LDAP server supporting simple bind and search with and/equality/present filters.
+type mockServer struct {
+	listener net.Listener
+	entries  []mockEntry
+}
+
+func newMockServer(t *testing.T, entries ...mockEntry) *mockServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	s := &mockServer{listener: l, entries: entries}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *mockServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *mockServer) Close() {
+	s.listener.Close()
+}
+
+func (s *mockServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		msg, err := readPacket(r)
+		if err != nil {
+			return
+		}
+
+		id := msg.child(0)
+		op := msg.child(1)
+		reply := func(p *packet) {
+			conn.Write(newSequence(id, p).encode())
+		}
+		result := func(tag byte, code int64) *packet {
+			return newConstructed(classApplication, tag, newEnumerated(code), newOctetString(""), newOctetString(""))
+		}
+
+		switch {
+		case op.is(classApplication, opBindRequest):
+			dn, password := op.child(1).str(), op.child(2).str()
+			code := int64(ResultInvalidCredentials)
+			for _, e := range s.entries {
+				if e.dn == dn && e.password == password {
+					code = ResultSuccess
+				}
+			}
+			reply(result(opBindResponse, code))
+		case op.is(classApplication, opSearchRequest):
+			var matched []mockEntry
+			if scope, _ := op.child(1).int(); scope == int64(ScopeBaseObject) {
+				matched = []mockEntry{{dn: op.child(0).str()}}
+			} else {
+				for _, e := range s.entries {
+					if matchFilter(op.child(6), e) {
+						matched = append(matched, e)
+					}
+				}
+			}
+
+			code := int64(ResultSuccess)
+			if sizeLimit, _ := op.child(3).int(); sizeLimit > 0 && int64(len(matched)) > sizeLimit {
+				matched = matched[:sizeLimit]
+				code = ResultSizeLimitExceeded
+			}
+
+			for _, e := range matched {
+				attrs := newSequence()
+				for k, values := range e.attrs {
+					set := newConstructed(classUniversal, tagSet)
+					for _, v := range values {
+						set.children = append(set.children, newOctetString(v))
+					}
+					attrs.children = append(attrs.children, newSequence(newOctetString(k), set))
+				}
+				reply(newConstructed(classApplication, opSearchResultEntry, newOctetString(e.dn), attrs))
+			}
+			reply(result(opSearchResultDone, code))
+		case op.is(classApplication, opUnbindRequest):
+			return
+		}
+	}
+}
+
+func matchFilter(filter *packet, e mockEntry) bool {
+	switch filter.tag {
+	case filterAnd:
+		for _, c := range filter.children {
+			if !matchFilter(c, e) {
+				return false
+			}
+		}
+		return true
+	case filterPresent:
+		return strings.EqualFold(filter.str(), "objectClass") || len(e.attrs[filter.str()]) > 0
+	case filterEqualityMatch:
+		// All entries are considered as persons
+		if strings.EqualFold(filter.child(0).str(), "objectClass") {
+			return true
+		}
+		for _, v := range e.attrs[filter.child(0).str()] {
+			if strings.EqualFold(v, filter.child(1).str()) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func TestEscapeFilter(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.Equal(`zhangsan`, EscapeFilter("zhangsan"))
+	asserts.Equal(`\2a\29\28uid=\2a\5c`, EscapeFilter(`*)(uid=*\`))
+}
+
+func TestCompileFilter(t *testing.T) {
+	asserts := assert.New(t)
+
+	p, err := compileFilter(`(&(objectClass=person)(cn=Zhang \2a)(!(mail=*))(sn=Z*a*n))`)
+	asserts.NoError(err)
+	asserts.Equal(filterAnd, p.tag)
+	asserts.Len(p.children, 4)
+	asserts.Equal("Zhang *", p.child(1).child(1).str())
+	asserts.Equal(filterNot, p.child(2).tag)
+	asserts.Equal(filterPresent, p.child(2).child(0).tag)
+	asserts.Equal(filterSubstrings, p.child(3).tag)
+	asserts.Len(p.child(3).child(1).children, 3)
+
+	// Encoded filter can be decoded again
+	decoded, n, err := parsePacket(p.encode())
+	asserts.NoError(err)
+	asserts.Equal(len(p.encode()), n)
+	asserts.Equal("Zhang *", decoded.child(1).child(1).str())
+
+	// Outer parentheses are optional
+	_, err = compileFilter("uid=zhangsan")
+	asserts.NoError(err)
+
+	for _, invalid := range []string{"", "(uid=a", "(&)", "(uid=a))", "(=a)", `(uid=\2)`, "(uid:=a)"} {
+		_, err = compileFilter(invalid)
+		asserts.Error(err, invalid)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	asserts := assert.New(t)
+	server := newMockServer(t,
+		mockEntry{dn: "cn=svc,dc=example,dc=edu", password: "svc-pass"},
+		mockEntry{
+			dn:       "CN=Zhang San,OU=Staff,DC=example,DC=edu",
+			password: "secret",
+			attrs: map[string][]string{
+				"sAMAccountName": {"zhangsan"},
+				"displayName":    {"张三"},
+				"mail":           {"zhangsan@example.edu"},
+				"memberOf":       {"CN=Staff,OU=Groups,DC=example,DC=edu", "CN=Teachers,OU=Groups,DC=example,DC=edu"},
+			},
+		},
+		mockEntry{dn: "cn=dup1,dc=example,dc=edu", password: "p", attrs: map[string][]string{"sAMAccountName": {"dup"}}},
+		mockEntry{dn: "cn=dup2,dc=example,dc=edu", password: "p", attrs: map[string][]string{"sAMAccountName": {"dup"}}},
+	)
+	defer server.Close()
+
+	config := &setting.LDAP{
+		URL:          server.URL(),
+		BindDN:       "cn=svc,dc=example,dc=edu",
+		BindPassword: "svc-pass",
+		BaseDN:       "dc=example,dc=edu",
+		UserFilter:   "(&(objectClass=person)(sAMAccountName={username}))",
+		Attributes: setting.SSOAttributeMapping{
+			Nick:  "displayName",
+			Email: "mail",
+			Group: "memberOf",
+		},
+		GroupMapping: []setting.LDAPGroupMapping{
+			{LDAPGroup: "cn=teachers,ou=groups,dc=example,dc=edu", GroupID: 4},
+			{LDAPGroup: "cn=staff,ou=groups,dc=example,dc=edu", GroupID: 3},
+		},
+	}
+	ctx := context.Background()
+
+	asserts.NoError(TestConnection(ctx, config))
+
+	entry, err := Authenticate(ctx, config, "zhangsan", "secret")
+	asserts.NoError(err)
+	asserts.Equal("张三", entry.First("displayName"))
+	asserts.Equal("zhangsan@example.edu", entry.First("MAIL"))
+	asserts.Equal("cn=zhang san,ou=staff,dc=example,dc=edu", Subject(config, entry))
+	asserts.Equal(4, MapGroup(config, entry))
+
+	_, err = Authenticate(ctx, config, "zhangsan", "wrong")
+	asserts.ErrorIs(err, ErrInvalidCredentials)
+
+	_, err = Authenticate(ctx, config, "zhangsan", "")
+	asserts.ErrorIs(err, ErrInvalidCredentials)
+
+	_, err = Authenticate(ctx, config, "lisi", "secret")
+	asserts.ErrorIs(err, ErrInvalidCredentials)
+
+	// Filter injection is escaped
+	_, err = Authenticate(ctx, config, "*", "secret")
+	asserts.ErrorIs(err, ErrInvalidCredentials)
+
+	_, err = Authenticate(ctx, config, "dup", "p")
+	asserts.ErrorIs(err, ErrAmbiguousUser)
+
+	// Password is not verified if rejected before bind
+	errLocked := errors.New("locked")
+	_, err = AuthenticateWith(ctx, config, "zhangsan", "secret", func(entry *Entry) error {
+		asserts.Equal("张三", entry.First("displayName"))
+		return errLocked
+	})
+	asserts.ErrorIs(err, errLocked)
+
+	config.BindPassword = "wrong"
+	_, err = Authenticate(ctx, config, "zhangsan", "secret")
+	asserts.True(IsResultCode(err, ResultInvalidCredentials))
+	asserts.NotErrorIs(err, ErrInvalidCredentials)
+
+	config.UserFilter = "(uid=zhangsan)"
+	asserts.ErrorIs(TestConnection(ctx, config), ErrInvalidConfig)
+}
@@ -0,0 +1,274 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+type Action string
+
+const (
+	ActionCreated   = Action("created")
+	ActionUpdated   = Action("updated")
+	ActionUnchanged = Action("unchanged")
+	ActionFailed    = Action("failed")
+)
+
+type (
+	// Importer creates or updates user accounts from roster rows. Rows are matched to existing
+	// users by phone or student ID, so importing the same roster again is safe.
+	Importer struct {
+		userClient        inventory.UserClient
+		institutionClient inventory.InstitutionClient
+		groups            map[string]*ent.Group
+		defaultGroup      int
+		strictDirectory   bool
+		dryRun            bool
+	}
+
+	// RowResult is the import result of a single roster row.
+	RowResult struct {
+		Line      int    `json:"line"`
+		StudentID string `json:"student_id,omitempty"`
+		Name      string `json:"name,omitempty"`
+		Phone     string `json:"phone,omitempty"`
+		Action    Action `json:"action"`
+		UserID    int    `json:"-"`
+		Error     string `json:"error,omitempty"`
+	}
+
+	// Report summarizes an import.
+	Report struct {
+		Total     int          `json:"total"`
+		Created   int          `json:"created"`
+		Updated   int          `json:"updated"`
+		Unchanged int          `json:"unchanged"`
+		Failed    int          `json:"failed"`
+		DryRun    bool         `json:"dry_run"`
+		Rows      []*RowResult `json:"rows"`
+	}
+)
+
+// NewImporter creates an importer. Rows without group column are assigned to defaultGroup.
+// University and major are mapped to canonical names in directory, rows with unknown values are
+// rejected if strictDirectory is true. If dryRun is true, no change will be written into database.
+func NewImporter(ctx context.Context, userClient inventory.UserClient, groupClient inventory.GroupClient,
+	institutionClient inventory.InstitutionClient, defaultGroup int, strictDirectory, dryRun bool) (*Importer, error) {
+	groups, err := groupClient.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	// 用户组列可以填写用户组 ID 或名称
+	groupMap := make(map[string]*ent.Group, len(groups)*2)
+	for _, g := range groups {
+		groupMap[strconv.Itoa(g.ID)] = g
+		groupMap[g.Name] = g
+	}
+
+	return &Importer{
+		userClient:        userClient,
+		institutionClient: institutionClient,
+		groups:            groupMap,
+		defaultGroup:      defaultGroup,
+		strictDirectory:   strictDirectory,
+		dryRun:            dryRun,
+	}, nil
+}
+
+// Import imports all rows and returns a per-row report. Failure of a single row does not abort the import.
+func (i *Importer) Import(ctx context.Context, rows []Row) *Report {
+	report := &Report{
+		Total:  len(rows),
+		DryRun: i.dryRun,
+		Rows:   make([]*RowResult, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res := &RowResult{
+			Line:      row.Line,
+			StudentID: row.StudentID,
+			Name:      row.Name,
+			Phone:     row.Phone,
+		}
+
+		action, uid, err := i.importRow(ctx, row)
+		if err != nil {
+			action = ActionFailed
+			res.Error = err.Error()
+		}
+		res.Action = action
+		res.UserID = uid
+
+		switch action {
+		case ActionCreated:
+			report.Created++
+		case ActionUpdated:
+			report.Updated++
+		case ActionUnchanged:
+			report.Unchanged++
+		case ActionFailed:
+			report.Failed++
+		}
+		report.Rows = append(report.Rows, res)
+	}
+
+	return report
+}
+
+func (i *Importer) importRow(ctx context.Context, row Row) (Action, int, error) {
+	phone := util.NormalizePhone(row.Phone)
+	if row.Phone != "" && !util.ValidatePhone(phone) {
+		return "", 0, fmt.Errorf("invalid phone number %q", row.Phone)
+	}
+
+	if phone == "" && row.StudentID == "" {
+		return "", 0, errors.New("phone or student ID is required")
+	}
+
+	if row.StudentID != "" && row.University == "" {
+		return "", 0, errors.New("university is required for student ID")
+	}
+
+	university, majorName, err := i.institutionClient.Canonicalize(ctx, row.University, row.Major, i.strictDirectory)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid university or major: %w", err)
+	}
+	row.University, row.Major = university, majorName
+
+	mapping, err := i.institutionClient.Mapping(ctx, row.University, row.Major)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to query directory mapping: %w", err)
+	}
+
+	// 未填写用户组时按院校、专业映射分配
+	groupID := i.defaultGroup
+	mappedGroup := mapping != nil && mapping.GroupID != 0
+	if mappedGroup {
+		groupID = mapping.GroupID
+	}
+	if row.Group != "" {
+		g, ok := i.groups[row.Group]
+		if !ok {
+			return "", 0, fmt.Errorf("group %q not found", row.Group)
+		}
+
+		// 禁止通过花名册授予管理员权限或分配到匿名用户组
+		if g.ID == inventory.AnonymousGroupID || g.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			return "", 0, fmt.Errorf("group %q cannot be assigned by roster", row.Group)
+		}
+		groupID = g.ID
+	}
+
+	existing, err := i.findUser(ctx, phone, row.University, row.StudentID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	args := &inventory.NewUserArgs{
+		Phone:      phone,
+		Nick:       row.Name,
+		Status:     user.StatusActive,
+		GroupID:    groupID,
+		University: row.University,
+		Major:      row.Major,
+		StudentID:  row.StudentID,
+	}
+	if mapping != nil {
+		args.ExtraStorage = mapping.ExtraStorage
+	}
+
+	if existing == nil {
+		if i.dryRun {
+			return ActionCreated, 0, nil
+		}
+
+		newUser, err := i.userClient.Create(ctx, args)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to create user: %w", err)
+		}
+
+		return ActionCreated, newUser.ID, nil
+	}
+
+	if existing.ID == 1 {
+		return "", existing.ID, errors.New("default admin user cannot be modified by roster")
+	}
+
+	// 花名册不能降级管理员
+	if row.Group != "" && i.isAdminGroup(existing.GroupUsers) {
+		return "", existing.ID, errors.New("group of administrator cannot be changed by roster")
+	}
+
+	// 未填写用户组且没有映射时保留现有用户组，映射也不会降级管理员
+	if row.Group == "" && (!mappedGroup || i.isAdminGroup(existing.GroupUsers)) {
+		args.GroupID = 0
+	}
+
+	if !profileChanged(existing, args) {
+		return ActionUnchanged, existing.ID, nil
+	}
+
+	if i.dryRun {
+		return ActionUpdated, existing.ID, nil
+	}
+
+	if _, err := i.userClient.UpdateProfile(ctx, existing, args); err != nil {
+		return "", existing.ID, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return ActionUpdated, existing.ID, nil
+}
+
+func (i *Importer) isAdminGroup(id int) bool {
+	g, ok := i.groups[strconv.Itoa(id)]
+	return ok && g.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
+}
+
+// findUser finds existing user by phone or student ID. Returns error if they point to different users.
+func (i *Importer) findUser(ctx context.Context, phone, university, studentID string) (*ent.User, error) {
+	var byPhone, byStudentID *ent.User
+	if phone != "" {
+		u, err := i.userClient.GetByPhone(ctx, phone)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query user by phone: %w", err)
+		}
+		byPhone = u
+	}
+
+	if studentID != "" {
+		u, err := i.userClient.GetByStudentID(ctx, university, studentID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query user by student ID: %w", err)
+		}
+		byStudentID = u
+	}
+
+	if byPhone != nil && byStudentID != nil && byPhone.ID != byStudentID.ID {
+		return nil, fmt.Errorf("phone and student ID belong to different users (%d and %d)", byPhone.ID, byStudentID.ID)
+	}
+
+	if byPhone != nil {
+		return byPhone, nil
+	}
+
+	return byStudentID, nil
+}
+
+func profileChanged(u *ent.User, args *inventory.NewUserArgs) bool {
+	return (args.Nick != "" && args.Nick != u.Nick) ||
+		(args.Phone != "" && args.Phone != u.Phone) ||
+		(args.University != "" && args.University != u.University) ||
+		(args.Major != "" && args.Major != u.Major) ||
+		(args.StudentID != "" && args.StudentID != u.StudentID) ||
+		(args.GroupID != 0 && args.GroupID != u.GroupUsers) ||
+		(args.ExtraStorage != 0 && args.ExtraStorage != u.ExtraStorage)
+}
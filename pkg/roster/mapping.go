@@ -0,0 +1,189 @@
+package roster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+)
+
+type (
+	// ReapplyMappingTask re-applies group and extra storage mapping of an institution or major
+	// to its existing members.
+	ReapplyMappingTask struct {
+		*queue.DBTask
+
+		state    *ReapplyMappingTaskState
+		progress queue.Progresses
+	}
+	ReapplyMappingTaskState struct {
+		University string `json:"university"`
+		Major      string `json:"major,omitempty"`
+		Updated    int    `json:"updated,omitempty"`
+		Skipped    int    `json:"skipped,omitempty"`
+		Failed     int    `json:"failed,omitempty"`
+	}
+)
+
+const (
+	ProgressTypeReapplied = "reapplied"
+
+	SummaryKeyUniversity = "university"
+	SummaryKeyMajor      = "major"
+	SummaryKeyUpdated    = "updated"
+	SummaryKeySkipped    = "skipped"
+	SummaryKeyFailed     = "failed"
+
+	reapplyBatchSize = 200
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.ReapplyMappingTaskType, NewReapplyMappingTaskFromModel)
+}
+
+// NewReapplyMappingTask creates a task re-applying mapping to users of given university, and major if it is not empty.
+func NewReapplyMappingTask(ctx context.Context, u *ent.User, university, major string) (queue.Task, error) {
+	state := &ReapplyMappingTaskState{
+		University: university,
+		Major:      major,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &ReapplyMappingTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.ReapplyMappingTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewReapplyMappingTaskFromModel(task *ent.Task) queue.Task {
+	return &ReapplyMappingTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *ReapplyMappingTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	institutionClient := dep.InstitutionClient()
+
+	state := &ReapplyMappingTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	total, err := institutionClient.CountUsers(ctx, state.University, state.Major)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	m.Lock()
+	m.progress = queue.Progresses{
+		ProgressTypeReapplied: &queue.Progress{Total: int64(total)},
+	}
+	m.Unlock()
+
+	// 同一专业的用户映射相同，避免重复查询目录
+	mappings := make(map[string]*inventory.DirectoryMapping)
+	lastID := 0
+	for {
+		users, err := institutionClient.ListUsers(ctx, state.University, state.Major, lastID, reapplyBatchSize)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(users) == 0 {
+			break
+		}
+
+		for _, u := range users {
+			lastID = u.ID
+			m.Lock()
+			m.progress[ProgressTypeReapplied].Current++
+			m.Unlock()
+
+			// 不修改默认管理员及管理员组用户
+			if u.ID == 1 || (u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))) {
+				state.Skipped++
+				continue
+			}
+
+			mapping, ok := mappings[u.Major]
+			if !ok {
+				mapping, err = institutionClient.Mapping(ctx, u.University, u.Major)
+				if err != nil {
+					return task.StatusError, fmt.Errorf("failed to query directory mapping: %w", err)
+				}
+				mappings[u.Major] = mapping
+			}
+
+			changed, err := institutionClient.ApplyMapping(ctx, u, mapping)
+			if err != nil {
+				l.Warning("Failed to apply directory mapping to user %d: %s", u.ID, err)
+				state.Failed++
+				continue
+			}
+
+			if changed {
+				state.Updated++
+			}
+		}
+	}
+
+	l.Info("Directory mapping of %q re-applied: %d updated, %d skipped, %d failed.",
+		state.University, state.Updated, state.Skipped, state.Failed)
+
+	newStateStr, err := json.Marshal(state)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return task.StatusCompleted, nil
+}
+
+func (m *ReapplyMappingTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *ReapplyMappingTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeyUniversity: m.state.University,
+			SummaryKeyMajor:      m.state.Major,
+			SummaryKeyUpdated:    m.state.Updated,
+			SummaryKeySkipped:    m.state.Skipped,
+			SummaryKeyFailed:     m.state.Failed,
+		},
+	}
+}
@@ -0,0 +1,222 @@
+package roster
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+type Format string
+
+const (
+	FormatCSV  = Format("csv")
+	FormatXLSX = Format("xlsx")
+)
+
+const (
+	ColumnStudentID  = "student_id"
+	ColumnName       = "name"
+	ColumnPhone      = "phone"
+	ColumnUniversity = "university"
+	ColumnMajor      = "major"
+	ColumnGroup      = "group"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown roster format")
+	ErrEmptyRoster   = errors.New("roster is empty")
+	ErrMissingColumn = errors.New("missing required column")
+
+	// defaultColumns 花名册没有表头时使用的列顺序
+	defaultColumns = []string{ColumnStudentID, ColumnName, ColumnPhone, ColumnUniversity, ColumnMajor, ColumnGroup}
+
+	// headerAlias 表头别名，支持中英文表头
+	headerAlias = map[string]string{
+		"student_id":  ColumnStudentID,
+		"student id":  ColumnStudentID,
+		"student no":  ColumnStudentID,
+		"学号":          ColumnStudentID,
+		"name":        ColumnName,
+		"nick":        ColumnName,
+		"姓名":          ColumnName,
+		"phone":       ColumnPhone,
+		"mobile":      ColumnPhone,
+		"手机":          ColumnPhone,
+		"手机号":         ColumnPhone,
+		"university":  ColumnUniversity,
+		"institution": ColumnUniversity,
+		"院校":          ColumnUniversity,
+		"学校":          ColumnUniversity,
+		"major":       ColumnMajor,
+		"专业":          ColumnMajor,
+		"group":       ColumnGroup,
+		"用户组":         ColumnGroup,
+	}
+)
+
+// record is a raw line in roster file.
+type record struct {
+	Line  int
+	Cells []string
+}
+
+// Row is a single student record in the roster.
+type Row struct {
+	// Line is the 1-based line number in source file, used in import report.
+	Line       int
+	StudentID  string
+	Name       string
+	Phone      string
+	University string
+	Major      string
+	Group      string
+}
+
+// FormatFromName infers roster format from file name or content type.
+func FormatFromName(name string) Format {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".xlsx"), strings.Contains(name, "spreadsheetml"):
+		return FormatXLSX
+	case strings.HasSuffix(name, ".csv"), strings.Contains(name, "csv"), strings.HasPrefix(name, "text/"):
+		return FormatCSV
+	default:
+		return ""
+	}
+}
+
+// Parse reads all rows from roster file in given format.
+func Parse(content []byte, format Format) ([]Row, error) {
+	var (
+		records []record
+		err     error
+	)
+
+	switch format {
+	case FormatCSV:
+		records, err = readCSV(content)
+	case FormatXLSX:
+		records, err = readXLSX(content)
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRecords(records)
+}
+
+func readCSV(content []byte) ([]record, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	// 中文版 Excel 导出的 CSV 默认使用 GBK 编码
+	if !utf8.Valid(content) {
+		decoded, err := simplifiedchinese.GB18030.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode roster: %w", err)
+		}
+		content = decoded
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records := make([]record, 0)
+	for {
+		cells, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		// 空行会被跳过，需要记录实际行号
+		line, _ := reader.FieldPos(0)
+		records = append(records, record{Line: line, Cells: cells})
+	}
+
+	return records, nil
+}
+
+func parseRecords(records []record) ([]Row, error) {
+	if len(records) == 0 {
+		return nil, ErrEmptyRoster
+	}
+
+	columns := defaultColumns
+	start := 0
+	if header, ok := parseHeader(records[0].Cells); ok {
+		columns = header
+		start = 1
+	}
+
+	if !lo.Contains(columns, ColumnPhone) && !lo.Contains(columns, ColumnStudentID) {
+		return nil, fmt.Errorf("%w: %s or %s", ErrMissingColumn, ColumnPhone, ColumnStudentID)
+	}
+
+	rows := make([]Row, 0, len(records)-start)
+	for i := start; i < len(records); i++ {
+		row := Row{Line: records[i].Line}
+		empty := true
+		for j, value := range records[i].Cells {
+			if j >= len(columns) {
+				break
+			}
+
+			value = strings.TrimSpace(value)
+			if value != "" {
+				empty = false
+			}
+
+			switch columns[j] {
+			case ColumnStudentID:
+				row.StudentID = value
+			case ColumnName:
+				row.Name = value
+			case ColumnPhone:
+				row.Phone = value
+			case ColumnUniversity:
+				row.University = value
+			case ColumnMajor:
+				row.Major = value
+			case ColumnGroup:
+				row.Group = value
+			}
+		}
+
+		if !empty {
+			rows = append(rows, row)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmptyRoster
+	}
+
+	return rows, nil
+}
+
+// parseHeader returns column mapping if given record is a header line.
+func parseHeader(record []string) ([]string, bool) {
+	columns := make([]string, len(record))
+	matched := false
+	for i, cell := range record {
+		if column, ok := headerAlias[strings.ToLower(strings.TrimSpace(cell))]; ok {
+			columns[i] = column
+			matched = true
+		}
+	}
+
+	return columns, matched
+}
@@ -0,0 +1,79 @@
+package roster
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSV(t *testing.T) {
+	asserts := assert.New(t)
+
+	// With header in different order
+	rows, err := Parse([]byte("\xef\xbb\xbf姓名,学号,手机号,院校\n张三,2024001,138 0013 8000,北京大学\n\n李四,2024002,,北京大学\n"), FormatCSV)
+	asserts.NoError(err)
+	asserts.Len(rows, 2)
+	asserts.Equal(Row{Line: 2, StudentID: "2024001", Name: "张三", Phone: "138 0013 8000", University: "北京大学"}, rows[0])
+	asserts.Equal(4, rows[1].Line)
+	asserts.Equal("", rows[1].Phone)
+
+	// Without header
+	rows, err = Parse([]byte("2024001,张三,13800138000,北京大学,计算机,学生\n"), FormatCSV)
+	asserts.NoError(err)
+	asserts.Equal(Row{Line: 1, StudentID: "2024001", Name: "张三", Phone: "13800138000", University: "北京大学", Major: "计算机", Group: "学生"}, rows[0])
+
+	// Missing identifier column
+	_, err = Parse([]byte("name,major\n张三,计算机\n"), FormatCSV)
+	asserts.ErrorIs(err, ErrMissingColumn)
+
+	// Empty
+	_, err = Parse([]byte(""), FormatCSV)
+	asserts.ErrorIs(err, ErrEmptyRoster)
+
+	_, err = Parse([]byte("a"), Format("pdf"))
+	asserts.ErrorIs(err, ErrUnknownFormat)
+}
+
+func TestParseXLSX(t *testing.T) {
+	asserts := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	parts := map[string]string{
+		"xl/sharedStrings.xml": `<sst><si><t>phone</t></si><si><t>name</t></si><si><r><t>张</t></r><r><t>三</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>` +
+			// Cells out of column order
+			`<row r="2"><c r="C2" t="s"><v>2</v></c><c r="A2"><v>1.3800138E10</v></c></row>` +
+			`</sheetData></worksheet>`,
+	}
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		asserts.NoError(err)
+		_, err = w.Write([]byte(content))
+		asserts.NoError(err)
+	}
+	asserts.NoError(zw.Close())
+
+	rows, err := Parse(buf.Bytes(), FormatXLSX)
+	asserts.NoError(err)
+	asserts.Len(rows, 1)
+	asserts.Equal("13800138000", rows[0].Phone)
+	asserts.Equal("张三", rows[0].Name)
+}
+
+func TestFormatFromName(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.Equal(FormatCSV, FormatFromName("roster.CSV"))
+	asserts.Equal(FormatXLSX, FormatFromName("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"))
+	asserts.Equal(Format(""), FormatFromName("roster.pdf"))
+}
+
+func TestColumnIndex(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.Equal(0, columnIndex("A1"))
+	asserts.Equal(25, columnIndex("Z9"))
+	asserts.Equal(27, columnIndex("AB12"))
+}
@@ -0,0 +1,199 @@
+package roster
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// 仅支持读取第一个工作表中的文本与数字，足以满足花名册导入
+type (
+	xlsxWorkbook struct {
+		Sheets []struct {
+			RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	xlsxRelationships struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	xlsxRichText struct {
+		T string `xml:"t"`
+		R []struct {
+			T string `xml:"t"`
+		} `xml:"r"`
+	}
+	xlsxSharedStrings struct {
+		Items []xlsxRichText `xml:"si"`
+	}
+	xlsxWorksheet struct {
+		Rows []struct {
+			Ref   int `xml:"r,attr"`
+			Cells []struct {
+				Ref       string       `xml:"r,attr"`
+				Type      string       `xml:"t,attr"`
+				Value     string       `xml:"v"`
+				InlineStr xlsxRichText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+)
+
+const defaultSheetPath = "xl/worksheets/sheet1.xml"
+
+func (t xlsxRichText) String() string {
+	if len(t.R) == 0 {
+		return t.T
+	}
+
+	var sb strings.Builder
+	for _, r := range t.R {
+		sb.WriteString(r.T)
+	}
+	return sb.String()
+}
+
+func readXLSX(content []byte) ([]record, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open xlsx: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var sharedStrings xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXLSXPart(f, &sharedStrings); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, ok := files[firstSheetPath(files)]
+	if !ok {
+		return nil, fmt.Errorf("no worksheet found in xlsx")
+	}
+
+	var ws xlsxWorksheet
+	if err := decodeXLSXPart(sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(ws.Rows))
+	for i, row := range ws.Rows {
+		cells := make([]string, 0, len(row.Cells))
+		for j, cell := range row.Cells {
+			col := j
+			if c := columnIndex(cell.Ref); c >= 0 {
+				col = c
+			}
+
+			var value string
+			switch cell.Type {
+			case "s":
+				idx, err := strconv.Atoi(cell.Value)
+				if err != nil || idx < 0 || idx >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("invalid shared string index %q", cell.Value)
+				}
+				value = sharedStrings.Items[idx].String()
+			case "inlineStr":
+				value = cell.InlineStr.String()
+			case "n", "":
+				value = formatNumber(cell.Value)
+			default:
+				value = cell.Value
+			}
+
+			if col >= len(cells) {
+				cells = append(cells, make([]string, col+1-len(cells))...)
+			}
+			cells[col] = value
+		}
+		line := row.Ref
+		if line == 0 {
+			line = i + 1
+		}
+		records = append(records, record{Line: line, Cells: cells})
+	}
+
+	return records, nil
+}
+
+// firstSheetPath resolves the path of first worksheet from workbook relationships.
+func firstSheetPath(files map[string]*zip.File) string {
+	var (
+		wb   xlsxWorkbook
+		rels xlsxRelationships
+	)
+
+	wbFile, ok := files["xl/workbook.xml"]
+	if !ok || decodeXLSXPart(wbFile, &wb) != nil || len(wb.Sheets) == 0 {
+		return defaultSheetPath
+	}
+
+	relsFile, ok := files["xl/_rels/workbook.xml.rels"]
+	if !ok || decodeXLSXPart(relsFile, &rels) != nil {
+		return defaultSheetPath
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID == wb.Sheets[0].RID {
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/")
+			}
+			return path.Join("xl", rel.Target)
+		}
+	}
+
+	return defaultSheetPath
+}
+
+func decodeXLSXPart(f *zip.File, v interface{}) error {
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", f.Name, err)
+	}
+	defer r.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(r, 64<<20)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %q: %w", f.Name, err)
+	}
+
+	return nil
+}
+
+// columnIndex converts cell reference like "C12" to zero-based column index.
+func columnIndex(ref string) int {
+	col := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+	}
+
+	return col - 1
+}
+
+// formatNumber 手机号、学号在 Excel 中常被存为数字，可能以科学计数法保存
+func formatNumber(v string) string {
+	if !strings.ContainsAny(v, "eE.") {
+		return v
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
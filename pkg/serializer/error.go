@@ -66,6 +66,12 @@ func (err AppError) Unwrap() error {
 	return err.RawError
 }
 
+// DetailedError 携带结构化详情的错误，详情通过响应的 data 字段返回
+type DetailedError interface {
+	error
+	Details() any
+}
+
 // 三位数错误编码为复用http原本含义
 // 五位数错误编码为应用自定义错误
 // 五开头的五位数错误编码为服务器端错误，比如数据库操作失败
@@ -255,6 +261,42 @@ const (
 	CodeDomainNotLicensed = 40087
 	// CodeAnonymouseAccessDenied 匿名用户无法访问分享
 	CodeAnonymouseAccessDenied = 40088
+	// CodeCourseNotFound 课程不存在
+	CodeCourseNotFound = 40089
+	// CodeCourseCodeExisted 课程代码已存在
+	CodeCourseCodeExisted = 40090
+	// CodeAssignmentClosed 作业已截止
+	CodeAssignmentClosed = 40091
+	// CodeAssignmentNotFound 作业不存在
+	CodeAssignmentNotFound = 40092
+	// CodeStudentIDExisted 学号在该院校中已被使用
+	CodeStudentIDExisted = 40093
+	// CodeStudentIDAmbiguous 学号在多个院校中存在，需要指定院校
+	CodeStudentIDAmbiguous = 40094
+	// CodeNotInDirectory 院校或专业不在目录中
+	CodeNotInDirectory = 40095
+	// CodeInstitutionExisted 院校或专业名称已存在
+	CodeInstitutionExisted = 40096
+	// CodeSMSQuotaExceeded 验证码发送次数超出限制
+	CodeSMSQuotaExceeded = 40097
+	// CodeSMSBlocked 手机号被暂时限制接收验证码
+	CodeSMSBlocked = 40098
+	// CodeSSONotLinked 外部账号未关联本站账号
+	CodeSSONotLinked = 40099
+	// CodeSSOIdentityLinked 外部账号已关联其他账号
+	CodeSSOIdentityLinked = 40100
+	// CodeTwoFAEnrollRequired 所在用户组要求启用二步验证
+	CodeTwoFAEnrollRequired = 40101
+	// CodeLoginLocked 登录失败次数过多，账号或 IP 被暂时锁定
+	CodeLoginLocked = 40102
+	// CodeLoginThrottled 登录尝试过于频繁
+	CodeLoginThrottled = 40103
+	// CodeIPNotAllowed 当前网络不在允许访问的范围内
+	CodeIPNotAllowed = 40104
+	// CodePasswordPolicyViolation 密码不满足密码策略
+	CodePasswordPolicyViolation = 40105
+	// CodePasswordExpired 密码已过期，需先修改密码
+	CodePasswordExpired = 40106
 	// CodeDBError 数据库操作失败
 	CodeDBError = 50001
 	// CodeEncryptError 加密失败
@@ -362,6 +404,11 @@ func ErrWithDetails(c context.Context, errCode int, msg string, err error) Respo
 			if errors.As(err, &errs) {
 				res.AggregatedError = errs.Expand(c)
 			}
+		case CodePasswordPolicyViolation:
+			var detailed DetailedError
+			if errors.As(err, &detailed) {
+				res.Data = detailed.Details()
+			}
 		}
 	}
 
@@ -24,6 +24,8 @@ type (
 		PWA(ctx context.Context) *PWASetting
 		// RegisterEnabled returns true if public sign-up is enabled.
 		RegisterEnabled(ctx context.Context) bool
+		// InstitutionDirectoryEnforced returns true if university and major must be chosen from directory.
+		InstitutionDirectoryEnforced(ctx context.Context) bool
 		// AuthnEnabled returns true if Webauthn is enabled.
 		AuthnEnabled(ctx context.Context) bool
 		// RegCaptchaEnabled returns true if registration captcha is enabled.
@@ -48,6 +50,26 @@ type (
 		DefaultGroup(ctx context.Context) int
 		// SMTP returns the SMTP settings.
 		SMTP(ctx context.Context) *SMTP
+		// SMS returns the SMS provider settings.
+		SMS(ctx context.Context) *SMS
+		// SMSLimit returns the abuse limits of SMS verification codes.
+		SMSLimit(ctx context.Context) *SMSLimit
+		// LoginLimit returns the brute force protection settings of password logins.
+		LoginLimit(ctx context.Context) *LoginLimit
+		// PasswordPolicy returns requirements of new passwords.
+		PasswordPolicy(ctx context.Context) *PasswordPolicy
+		// LockoutEmailTemplate returns the email template sent when an account is locked.
+		LockoutEmailTemplate(ctx context.Context) []EmailTemplate
+		// SMSCaptchaEnabled returns true if captcha is required before sending SMS code.
+		SMSCaptchaEnabled(ctx context.Context) bool
+		// SSOProviders returns configured external identity providers.
+		SSOProviders(ctx context.Context) []SSOProvider
+		// LDAP returns the LDAP authentication backend settings.
+		LDAP(ctx context.Context) *LDAP
+		// AuditLogRetention returns how long audit logs are kept, 0 means forever.
+		AuditLogRetention(ctx context.Context) time.Duration
+		// OAuthServer returns settings of the OAuth2 authorization server.
+		OAuthServer(ctx context.Context) *OAuthServer
 		// SiteURL returns the basic URL.
 		SiteURL(ctx context.Context) *url.URL
 		// SecretKey returns the secret key for general signature.
@@ -647,6 +669,16 @@ func (s *settingProvider) ResetEmailTemplate(ctx context.Context) []EmailTemplat
 	return templates
 }
 
+func (s *settingProvider) LockoutEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_lockout_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
 func (s *settingProvider) ActivationEmailTemplate(ctx context.Context) []EmailTemplate {
 	src := s.getString(ctx, "mail_activation_template", "[]")
 	var templates []EmailTemplate
@@ -720,6 +752,120 @@ func (s *settingProvider) SMTP(ctx context.Context) *SMTP {
 	}
 }
 
+func (s *settingProvider) SMS(ctx context.Context) *SMS {
+	return &SMS{
+		Provider: SMSProviderType(s.getString(ctx, "sms_provider", "")),
+		Aliyun: SMSAliyun{
+			AccessKeyID:     s.getString(ctx, "sms_aliyun_access_key_id", ""),
+			AccessKeySecret: s.getString(ctx, "sms_aliyun_access_key_secret", ""),
+			SignName:        s.getString(ctx, "sms_aliyun_sign_name", ""),
+			TemplateCode:    s.getString(ctx, "sms_aliyun_template_code", ""),
+		},
+		Tencent: SMSTencent{
+			SecretID:   s.getString(ctx, "sms_tencent_secret_id", ""),
+			SecretKey:  s.getString(ctx, "sms_tencent_secret_key", ""),
+			SDKAppID:   s.getString(ctx, "sms_tencent_sdk_app_id", ""),
+			SignName:   s.getString(ctx, "sms_tencent_sign_name", ""),
+			TemplateID: s.getString(ctx, "sms_tencent_template_id", ""),
+		},
+		Webhook: SMSWebhook{
+			URL:     s.getString(ctx, "sms_webhook_url", ""),
+			Method:  s.getString(ctx, "sms_webhook_method", "POST"),
+			Headers: s.getString(ctx, "sms_webhook_headers", "{}"),
+			Body:    s.getString(ctx, "sms_webhook_body", ""),
+		},
+	}
+}
+
+func (s *settingProvider) SMSLimit(ctx context.Context) *SMSLimit {
+	return &SMSLimit{
+		MaxAttempts:      s.getInt(ctx, "sms_max_attempts", 5),
+		PhoneDailyQuota:  s.getInt(ctx, "sms_phone_daily_quota", 10),
+		IPDailyQuota:     s.getInt(ctx, "sms_ip_daily_quota", 20),
+		PrefixDailyQuota: s.getInt(ctx, "sms_prefix_daily_quota", 0),
+		PrefixLength:     s.getInt(ctx, "sms_prefix_length", 7),
+		BlockDuration:    time.Duration(s.getInt(ctx, "sms_block_duration", 3600)) * time.Second,
+	}
+}
+
+func (s *settingProvider) LoginLimit(ctx context.Context) *LoginLimit {
+	return &LoginLimit{
+		AccountThreshold: s.getInt(ctx, "login_lock_account_threshold", 10),
+		IPThreshold:      s.getInt(ctx, "login_lock_ip_threshold", 100),
+		DelayBase:        time.Duration(s.getInt(ctx, "login_delay_base", 1)) * time.Second,
+		DelayMax:         time.Duration(s.getInt(ctx, "login_delay_max", 30)) * time.Second,
+		LockDuration:     time.Duration(s.getInt(ctx, "login_lock_duration", 900)) * time.Second,
+		Window:           time.Duration(s.getInt(ctx, "login_failure_window", 3600)) * time.Second,
+		Notify:           s.getBoolean(ctx, "login_lock_notify", true),
+	}
+}
+
+func (s *settingProvider) PasswordPolicy(ctx context.Context) *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:   s.getInt(ctx, "password_min_length", 6),
+		MinClasses:  s.getInt(ctx, "password_min_classes", 0),
+		History:     s.getInt(ctx, "password_history", 0),
+		MaxAge:      time.Duration(s.getInt(ctx, "password_max_age", 0)) * 24 * time.Hour,
+		BreachCheck: s.getBoolean(ctx, "password_breach_check", false),
+	}
+}
+
+func (s *settingProvider) SMSCaptchaEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "sms_captcha", false)
+}
+
+func (s *settingProvider) SSOProviders(ctx context.Context) []SSOProvider {
+	raw := s.getString(ctx, "sso_providers", "[]")
+	var providers []SSOProvider
+	if err := json.Unmarshal([]byte(raw), &providers); err != nil {
+		return []SSOProvider{}
+	}
+
+	return providers
+}
+
+func (s *settingProvider) LDAP(ctx context.Context) *LDAP {
+	var groupMapping []LDAPGroupMapping
+	if err := json.Unmarshal([]byte(s.getString(ctx, "ldap_group_mapping", "[]")), &groupMapping); err != nil {
+		groupMapping = []LDAPGroupMapping{}
+	}
+
+	return &LDAP{
+		Mode:               LDAPMode(s.getString(ctx, "ldap_mode", "")),
+		URL:                s.getString(ctx, "ldap_url", ""),
+		StartTLS:           s.getBoolean(ctx, "ldap_start_tls", false),
+		InsecureSkipVerify: s.getBoolean(ctx, "ldap_insecure_skip_verify", false),
+		BindDN:             s.getString(ctx, "ldap_bind_dn", ""),
+		BindPassword:       s.getString(ctx, "ldap_bind_password", ""),
+		BaseDN:             s.getString(ctx, "ldap_base_dn", ""),
+		UserFilter:         s.getString(ctx, "ldap_user_filter", "(&(objectClass=person)(sAMAccountName={username}))"),
+		SubjectAttr:        s.getString(ctx, "ldap_attr_subject", ""),
+		Attributes: SSOAttributeMapping{
+			StudentID:  s.getString(ctx, "ldap_attr_student_id", ""),
+			University: s.getString(ctx, "ldap_attr_university", ""),
+			Major:      s.getString(ctx, "ldap_attr_major", ""),
+			Nick:       s.getString(ctx, "ldap_attr_nick", "displayName"),
+			Email:      s.getString(ctx, "ldap_attr_email", "mail"),
+			Group:      s.getString(ctx, "ldap_attr_group", "memberOf"),
+		},
+		GroupMapping:      groupMapping,
+		DefaultUniversity: s.getString(ctx, "ldap_default_university", ""),
+		LinkByEmail:       s.getBoolean(ctx, "ldap_link_by_email", false),
+	}
+}
+
+func (s *settingProvider) AuditLogRetention(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "audit_log_retention_days", 180)) * 24 * time.Hour
+}
+
+func (s *settingProvider) OAuthServer(ctx context.Context) *OAuthServer {
+	return &OAuthServer{
+		CodeTTL:         time.Duration(s.getInt(ctx, "oauth_code_ttl", 600)) * time.Second,
+		AccessTokenTTL:  time.Duration(s.getInt(ctx, "oauth_access_token_ttl", 3600)) * time.Second,
+		RefreshTokenTTL: time.Duration(s.getInt(ctx, "oauth_refresh_token_ttl", 2592000)) * time.Second,
+	}
+}
+
 func (s *settingProvider) DefaultGroup(ctx context.Context) int {
 	return s.getInt(ctx, "default_group", 2)
 }
@@ -784,6 +930,10 @@ func (s *settingProvider) RegisterEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "register_enabled", false)
 }
 
+func (s *settingProvider) InstitutionDirectoryEnforced(ctx context.Context) bool {
+	return s.getBoolean(ctx, "institution_directory_enforced", false)
+}
+
 func (s *settingProvider) SiteBasic(ctx context.Context) *SiteBasic {
 	return &SiteBasic{
 		Name:        s.getString(ctx, "siteName", ""),
@@ -67,11 +67,193 @@ type SMTP struct {
 	Keepalive       int
 }
 
+type SMSProviderType string
+
+const (
+	SMSProviderNone    = SMSProviderType("")
+	SMSProviderAliyun  = SMSProviderType("aliyun")
+	SMSProviderTencent = SMSProviderType("tencent")
+	SMSProviderWebhook = SMSProviderType("webhook")
+	// SMSProviderMock only prints codes into log, for development use.
+	SMSProviderMock = SMSProviderType("mock")
+)
+
+type SMS struct {
+	Provider SMSProviderType
+	Aliyun   SMSAliyun
+	Tencent  SMSTencent
+	Webhook  SMSWebhook
+}
+
+type SMSAliyun struct {
+	AccessKeyID     string
+	AccessKeySecret string
+	SignName        string
+	TemplateCode    string
+}
+
+type SMSTencent struct {
+	SecretID   string
+	SecretKey  string
+	SDKAppID   string
+	SignName   string
+	TemplateID string
+}
+
+// SMSWebhook sends SMS through a self-hosted HTTP gateway. {phone}, {code} and {message} in URL
+// and body are replaced with actual values, {message} is only set for notices like account lockout.
+type SMSWebhook struct {
+	URL    string
+	Method string
+	// Headers is a JSON object of extra request headers.
+	Headers string
+	Body    string
+}
+
+// SMSLimit defines abuse limits of SMS verification codes. Zero value disables the limit.
+type SMSLimit struct {
+	// MaxAttempts is the number of wrong guesses after which a code is burned.
+	MaxAttempts int
+	// PhoneDailyQuota is the max number of codes sent to a phone number per day.
+	PhoneDailyQuota int
+	// IPDailyQuota is the max number of codes requested from a client IP per day.
+	IPDailyQuota int
+	// PrefixDailyQuota is the max number of codes sent to numbers sharing the same prefix per day.
+	PrefixDailyQuota int
+	PrefixLength     int
+	// BlockDuration is how long a number is blocked after too many wrong guesses.
+	BlockDuration time.Duration
+}
+
+// LoginLimit defines protection of password logins against password spraying. Zero value disables the limit.
+type LoginLimit struct {
+	// AccountThreshold is the number of failed attempts after which an account is locked.
+	AccountThreshold int
+	// IPThreshold is the number of failed attempts after which a client IP is locked.
+	IPThreshold int
+	// DelayBase is the delay required after the first failed attempt of an account, doubled on each further failure.
+	DelayBase time.Duration
+	DelayMax  time.Duration
+	// LockDuration is how long an account or client IP is locked.
+	LockDuration time.Duration
+	// Window is how long failed attempts are remembered since the last failure.
+	Window time.Duration
+	// Notify sends the owner an email or SMS when the account is locked.
+	Notify bool
+}
+
+// PasswordPolicy defines requirements of new passwords. Zero value disables the corresponding rule.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// MinClasses is the minimum number of character classes (lowercase, uppercase, digit, symbol) used.
+	MinClasses int
+	// History is the number of most recent passwords, including the current one, that cannot be reused.
+	History int
+	// MaxAge is how long a password is valid before the user is forced to change it.
+	MaxAge time.Duration
+	// BreachCheck rejects passwords found in the list of breached passwords.
+	BreachCheck bool
+}
+
+type SSOProviderType string
+
+const (
+	SSOProviderCAS  = SSOProviderType("cas")
+	SSOProviderOIDC = SSOProviderType("oidc")
+)
+
+// SSOProvider is an external identity provider users can sign in with.
+type SSOProvider struct {
+	// ID is the unique key of the provider, referenced by linked identities. It must not be changed once used.
+	ID      string          `json:"id"`
+	Type    SSOProviderType `json:"type"`
+	Name    string          `json:"name"`
+	Enabled bool            `json:"enabled"`
+
+	// CASServerURL is the base URL of CAS server, e.g. https://cas.example.edu/cas
+	CASServerURL string `json:"cas_server_url,omitempty"`
+
+	// Issuer is the OIDC issuer URL used for discovery.
+	Issuer       string   `json:"issuer,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+
+	// AutoProvision creates a new user on first sign-in if no linked account is found.
+	AutoProvision bool `json:"auto_provision"`
+	// LinkByStudentID links the identity to an existing user with the same student ID and university on first sign-in.
+	LinkByStudentID bool `json:"link_by_student_id"`
+	// DefaultUniversity is used when the IdP does not release a university attribute.
+	DefaultUniversity string              `json:"default_university,omitempty"`
+	Attributes        SSOAttributeMapping `json:"attributes"`
+	// GroupMapping maps values of the group attribute to group IDs.
+	GroupMapping map[string]int `json:"group_mapping,omitempty"`
+}
+
+// SSOAttributeMapping defines names of IdP attributes (CAS attributes or OIDC claims) mapped to user profile.
+type SSOAttributeMapping struct {
+	StudentID  string `json:"student_id,omitempty"`
+	University string `json:"university,omitempty"`
+	Major      string `json:"major,omitempty"`
+	Nick       string `json:"nick,omitempty"`
+	Email      string `json:"email,omitempty"`
+	Group      string `json:"group,omitempty"`
+}
+
+type LDAPMode string
+
+const (
+	// LDAPModeDisabled only verifies local passwords.
+	LDAPModeDisabled = LDAPMode("")
+	// LDAPModePrefer verifies passwords against LDAP first, then falls back to local passwords.
+	LDAPModePrefer = LDAPMode("prefer")
+	// LDAPModeExclusive only verifies passwords against LDAP, except for administrators.
+	LDAPModeExclusive = LDAPMode("exclusive")
+)
+
+// LDAP is the LDAP / Active Directory authentication backend.
+type LDAP struct {
+	Mode LDAPMode
+	// URL of LDAP server, e.g. ldaps://dc.example.edu:636
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	// BindDN and BindPassword is the service account used to search users. Anonymous bind is used if empty.
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserFilter is the search filter of users, {username} is replaced with the escaped login name.
+	UserFilter string
+	// SubjectAttr is the attribute uniquely identifies a user, e.g. objectGUID. DN is used if empty.
+	SubjectAttr string
+	Attributes  SSOAttributeMapping
+	// GroupMapping is ordered rules mapping LDAP groups to group IDs, the first match wins.
+	GroupMapping      []LDAPGroupMapping
+	DefaultUniversity string
+	// LinkByEmail links LDAP account to existing user with the same email on first sign-in.
+	LinkByEmail bool
+}
+
+type LDAPGroupMapping struct {
+	// LDAPGroup is the value of group attribute, usually DN of the group. Compared case-insensitively.
+	LDAPGroup string `json:"ldap_group"`
+	GroupID   int    `json:"group_id"`
+}
+
 type TokenAuth struct {
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
 }
 
+// OAuthServer is the settings of OAuth2 authorization server for third-party apps.
+type OAuthServer struct {
+	// CodeTTL is how long an authorization code can be exchanged.
+	CodeTTL         time.Duration
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
 type DBFS struct {
 	UseCursorPagination        bool
 	MaxPageSize                int
@@ -111,6 +293,7 @@ var (
 	CronTypeEntityCollect    = CronType("entity_collect")
 	CronTypeTrashBinCollect  = CronType("trash_bin_collect")
 	CronTypeOauthCredRefresh = CronType("oauth_cred_refresh")
+	CronTypeAuditLogCollect  = CronType("audit_log_collect")
 )
 
 type Theme struct {
@@ -87,12 +87,13 @@ func (a *AliyunSMSProvider) Send(ctx context.Context, phone, code string) error
 		request.WithLogger(a.logger),
 	).CheckHTTPResponse(http.StatusOK)
 
-	if resp.Err != nil {
-		return fmt.Errorf("failed to send SMS: %w", resp.Err)
+	body, err := resp.GetResponse()
+	if err != nil {
+		return fmt.Errorf("failed to send SMS: %w", err)
 	}
 
 	var result map[string]interface{}
-	if err := json.Unmarshal([]byte(resp.Response), &result); err != nil {
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
 		return fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -0,0 +1,78 @@
+package sms
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Purpose 验证码用途，不同用途的验证码不能混用
+type Purpose string
+
+const (
+	PurposeRegister = Purpose("register")
+	PurposeLogin    = Purpose("login")
+	PurposeReset    = Purpose("reset")
+	PurposeBind     = Purpose("bind")
+	// PurposeUnbind 更换手机号时验证原手机号
+	PurposeUnbind = Purpose("unbind")
+)
+
+const (
+	codeLength = 6
+	saltLength = 16
+)
+
+// Valid returns true if purpose is a known one.
+func (p Purpose) Valid() bool {
+	switch p {
+	case PurposeRegister, PurposeLogin, PurposeReset, PurposeBind, PurposeUnbind:
+		return true
+	}
+	return false
+}
+
+// generateCode 使用 crypto/rand 生成 6 位数字验证码
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate code: %w", err)
+	}
+
+	return fmt.Sprintf("%0*d", codeLength, n.Int64()), nil
+}
+
+// hashCode 返回 "salt$hash" 形式的加盐摘要，缓存中不保存验证码明文
+func hashCode(phone string, purpose Purpose, code string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	saltHex := hex.EncodeToString(salt)
+	return saltHex + "$" + digestCode(saltHex, phone, purpose, code), nil
+}
+
+// checkCode 常量时间比较验证码与已保存的摘要
+func checkCode(stored, phone string, purpose Purpose, code string) bool {
+	salt, digest, found := strings.Cut(stored, "$")
+	if !found {
+		return false
+	}
+
+	return hmac.Equal([]byte(digest), []byte(digestCode(salt, phone, purpose, code)))
+}
+
+func digestCode(salt, phone string, purpose Purpose, code string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(string(purpose) + ":" + phone + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func codeKey(phone string, purpose Purpose) string {
+	return fmt.Sprintf("%s%s_%s", smsCodePrefix, purpose, phone)
+}
@@ -0,0 +1,172 @@
+package sms
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+)
+
+const (
+	smsAttemptsPrefix    = "sms_attempts_"
+	smsPhoneQuotaPrefix  = "sms_quota_phone_"
+	smsIPQuotaPrefix     = "sms_quota_ip_"
+	smsPrefixQuotaPrefix = "sms_quota_prefix_"
+	smsBlockPrefix       = "sms_block_"
+	// 被限制号码的索引，cache.Driver 不支持按前缀列出键
+	smsBlockIndexKey = "sms_block_index"
+	smsBlockIndexTTL = 7 * 24 * 3600
+	smsQuotaTTL      = 24 * 3600
+)
+
+type BlockReason string
+
+const (
+	BlockReasonTooManyAttempts = BlockReason("too_many_attempts")
+	BlockReasonDailyQuota      = BlockReason("daily_quota")
+)
+
+// BlockedPhone is a phone number temporarily not allowed to receive codes.
+type BlockedPhone struct {
+	Phone  string      `json:"phone"`
+	Reason BlockReason `json:"reason"`
+	Until  time.Time   `json:"until"`
+}
+
+// 同一进程内串行化被限制号码索引的读改写，计数器由缓存驱动原子递增
+var limitMu sync.Mutex
+
+func init() {
+	gob.Register(BlockedPhone{})
+	gob.Register(map[string]int64{})
+}
+
+// ListBlocked returns all phone numbers currently blocked.
+func ListBlocked(kv cache.Driver) []BlockedPhone {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	index := blockIndex(kv)
+	res := make([]BlockedPhone, 0, len(index))
+	for phone := range index {
+		if b := getBlock(kv, phone); b != nil {
+			res = append(res, *b)
+		} else {
+			delete(index, phone)
+		}
+	}
+	_ = kv.Set(smsBlockIndexKey, index, smsBlockIndexTTL)
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Until.After(res[j].Until)
+	})
+	return res
+}
+
+// Unblock removes the block and resets daily quota and attempt counters of given phone number.
+func Unblock(kv cache.Driver, phone string) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	index := blockIndex(kv)
+	delete(index, phone)
+	if err := kv.Set(smsBlockIndexKey, index, smsBlockIndexTTL); err != nil {
+		return err
+	}
+
+	return kv.Delete("", smsBlockPrefix+phone, smsAttemptsPrefix+phone, phoneQuotaKey(phone, time.Now()))
+}
+
+func getBlock(kv cache.Driver, phone string) *BlockedPhone {
+	v, ok := kv.Get(smsBlockPrefix + phone)
+	if !ok {
+		return nil
+	}
+
+	b, ok := v.(BlockedPhone)
+	if !ok || time.Now().After(b.Until) {
+		return nil
+	}
+
+	return &b
+}
+
+func block(kv cache.Driver, phone string, reason BlockReason, until time.Time) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	ttl := int(time.Until(until).Seconds()) + 1
+	if err := kv.Set(smsBlockPrefix+phone, BlockedPhone{Phone: phone, Reason: reason, Until: until}, ttl); err != nil {
+		return err
+	}
+
+	index := blockIndex(kv)
+	index[phone] = until.Unix()
+	return kv.Set(smsBlockIndexKey, index, smsBlockIndexTTL)
+}
+
+func blockIndex(kv cache.Driver) map[string]int64 {
+	res := make(map[string]int64)
+	if v, ok := kv.Get(smsBlockIndexKey); ok {
+		if index, ok := v.(map[string]int64); ok {
+			now := time.Now().Unix()
+			for phone, until := range index {
+				if until > now {
+					res[phone] = until
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// counter returns current value of a counter.
+func counter(kv cache.Driver, key string) int {
+	v, ok := kv.Get(key)
+	if !ok {
+		return 0
+	}
+
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
+// incr increases a counter and returns the new value. ttl is reset on each increment.
+func incr(kv cache.Driver, key string, ttl int) int {
+	n, err := kv.Incr(key, ttl)
+	if err != nil {
+		// 计数失败时按已超出限制处理
+		return math.MaxInt32
+	}
+
+	return n
+}
+
+func phoneQuotaKey(phone string, now time.Time) string {
+	return fmt.Sprintf("%s%s_%s", smsPhoneQuotaPrefix, now.Format("20060102"), phone)
+}
+
+func ipQuotaKey(ip string, now time.Time) string {
+	return fmt.Sprintf("%s%s_%s", smsIPQuotaPrefix, now.Format("20060102"), ip)
+}
+
+func prefixQuotaKey(prefix string, now time.Time) string {
+	return fmt.Sprintf("%s%s_%s", smsPrefixQuotaPrefix, now.Format("20060102"), prefix)
+}
+
+// endOfDay returns the start of next day in local time zone.
+func endOfDay(now time.Time) time.Time {
+	y, m, d := now.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, now.Location())
+}
@@ -2,16 +2,22 @@ package sms
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/rand"
-	"os"
 	"time"
 
-	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	// ErrNotConfigured 站点未选择短信服务提供商
+	ErrNotConfigured = errors.New("SMS provider is not configured")
+	// ErrInvalidConfig 短信服务配置不完整或无效
+	ErrInvalidConfig = errors.New("invalid SMS provider settings")
 )
 
 const (
@@ -29,76 +35,218 @@ type SMSProvider interface {
 	Send(ctx context.Context, phone, code string) error
 }
 
+// Notifier 可发送任意内容通知的短信服务提供者，阿里云、腾讯云等基于模板的服务仅能发送验证码
+type Notifier interface {
+	// Notify 发送通知短信
+	Notify(ctx context.Context, phone, message string) error
+}
+
 // SMSService 短信验证码服务
 type SMSService struct {
 	kv       cache.Driver
 	logger   logging.Logger
 	provider SMSProvider
+	limit    *setting.SMSLimit
 }
 
 // NewSMSService 创建短信验证码服务
-func NewSMSService(kv cache.Driver, logger logging.Logger, provider SMSProvider) *SMSService {
+func NewSMSService(kv cache.Driver, logger logging.Logger, provider SMSProvider, limit *setting.SMSLimit) *SMSService {
 	return &SMSService{
 		kv:       kv,
 		logger:   logger,
 		provider: provider,
+		limit:    limit,
 	}
 }
 
-// SendCode 发送验证码
-func (s *SMSService) SendCode(ctx context.Context, phone string) error {
-	// 检查发送间隔
+// SendCode 发送指定用途的验证码，ip 为请求方地址，用于按 IP 限制每日发送次数
+func (s *SMSService) SendCode(ctx context.Context, phone, ip string, purpose Purpose) error {
+	if !purpose.Valid() {
+		return serializer.NewError(serializer.CodeParamErr, "未知的验证码用途", nil)
+	}
+
+	if b := getBlock(s.kv, phone); b != nil {
+		return serializer.NewError(serializer.CodeSMSBlocked, "该手机号已被暂时限制接收验证码，请稍后再试", nil)
+	}
+
+	if s.provider == nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "短信服务未配置", ErrNotConfigured)
+	}
+
+	// 占用发送间隔，并发请求中仅有一个能够继续
 	lastSendKey := fmt.Sprintf("%s%s_sent", smsCodePrefix, phone)
-	if _, ok := s.kv.Get(lastSendKey); ok {
+	claimed, err := s.kv.SetNX(lastSendKey, time.Now().Unix(), smsCodeInterval)
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "记录发送时间失败", err)
+	}
+	if !claimed {
 		return serializer.NewError(serializer.CodeParamErr, "验证码发送过于频繁，请稍后再试", nil)
 	}
 
+	reserved, err := s.reserveQuota(phone, ip)
+	if err == nil {
+		if err = s.send(ctx, phone, purpose); err != nil {
+			s.releaseQuota(reserved)
+		}
+	}
+
+	if err != nil {
+		// 未发送验证码时释放发送间隔，允许立即重试
+		_ = s.kv.Delete("", lastSendKey)
+		return err
+	}
+
+	return nil
+}
+
+// send 生成并发送验证码，成功后保存验证码摘要
+func (s *SMSService) send(ctx context.Context, phone string, purpose Purpose) error {
 	// 生成6位随机验证码
-	code := fmt.Sprintf("%06d", rand.Intn(1000000))
+	code, err := generateCode()
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "生成验证码失败", err)
+	}
+
+	digest, err := hashCode(phone, purpose, code)
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "生成验证码失败", err)
+	}
 
 	// 发送短信
-	if s.provider != nil {
-		if err := s.provider.Send(ctx, phone, code); err != nil {
-			s.logger.Warning("Failed to send SMS code to %s: %s", phone, err)
-			return serializer.NewError(serializer.CodeInternalSetting, "发送验证码失败", err)
-		}
-	} else {
-		// 如果没有配置短信服务，直接输出到日志（开发环境）
-		s.logger.Info("SMS Code for %s: %s (SMS provider not configured)", phone, code)
+	if err := s.provider.Send(ctx, phone, code); err != nil {
+		s.logger.Warning("Failed to send SMS code to %s: %s", phone, err)
+		return serializer.NewError(serializer.CodeInternalSetting, "发送验证码失败", err)
 	}
 
-	// 保存验证码到缓存
-	codeKey := fmt.Sprintf("%s%s", smsCodePrefix, phone)
-	if err := s.kv.Set(codeKey, code, smsCodeTTL); err != nil {
+	// 保存验证码摘要到缓存
+	if err := s.kv.Set(codeKey(phone, purpose), digest, smsCodeTTL); err != nil {
 		return serializer.NewError(serializer.CodeInternalSetting, "保存验证码失败", err)
 	}
 
-	// 记录发送时间
-	if err := s.kv.Set(lastSendKey, time.Now().Unix(), smsCodeInterval); err != nil {
-		s.logger.Warning("Failed to record SMS send time: %s", err)
+	// 新验证码重新计算错误次数
+	_ = s.kv.Delete(smsAttemptsPrefix, phone)
+	return nil
+}
+
+// reserveQuota 预占手机号、IP 与号段的每日发送次数，返回已预占的计数器。
+// 任一计数超出限制时归还已预占的次数
+func (s *SMSService) reserveQuota(phone, ip string) ([]string, error) {
+	now := time.Now()
+	reserved := make([]string, 0, 3)
+	reserve := func(key string, quota int) (bool, error) {
+		n, err := s.kv.Incr(key, smsQuotaTTL)
+		if err != nil {
+			s.releaseQuota(reserved)
+			return false, serializer.NewError(serializer.CodeInternalSetting, "记录发送次数失败", err)
+		}
+
+		reserved = append(reserved, key)
+		if n > quota {
+			s.releaseQuota(reserved)
+			return false, nil
+		}
+
+		return true, nil
+	}
+
+	if s.limit.PhoneDailyQuota > 0 {
+		ok, err := reserve(phoneQuotaKey(phone, now), s.limit.PhoneDailyQuota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			if err := block(s.kv, phone, BlockReasonDailyQuota, endOfDay(now)); err != nil {
+				s.logger.Warning("Failed to block phone %s: %s", phone, err)
+			}
+			return nil, serializer.NewError(serializer.CodeSMSQuotaExceeded, "该手机号今日验证码发送次数已达上限", nil)
+		}
+	}
+
+	if s.limit.IPDailyQuota > 0 && ip != "" {
+		ok, err := reserve(ipQuotaKey(ip, now), s.limit.IPDailyQuota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, serializer.NewError(serializer.CodeSMSQuotaExceeded, "今日验证码请求次数已达上限", nil)
+		}
+	}
+
+	if prefix := s.phonePrefix(phone); prefix != "" {
+		ok, err := reserve(prefixQuotaKey(prefix, now), s.limit.PrefixDailyQuota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, serializer.NewError(serializer.CodeSMSQuotaExceeded, "该号段今日验证码发送次数已达上限", nil)
+		}
+	}
+
+	return reserved, nil
+}
+
+// releaseQuota 归还预占的发送次数
+func (s *SMSService) releaseQuota(keys []string) {
+	for _, key := range keys {
+		if _, err := s.kv.Decr(key); err != nil {
+			s.logger.Warning("Failed to release SMS quota %q: %s", key, err)
+		}
 	}
+}
 
+// phonePrefix 返回用于号段限流的号码前缀，未启用号段限制时返回空
+func (s *SMSService) phonePrefix(phone string) string {
+	if s.limit.PrefixDailyQuota <= 0 || s.limit.PrefixLength <= 0 || len(phone) <= s.limit.PrefixLength {
+		return ""
+	}
+
+	return phone[:s.limit.PrefixLength]
+}
+
+// VerifyCode 验证指定用途的验证码，验证成功后验证码失效
+func (s *SMSService) VerifyCode(ctx context.Context, phone, code string, purpose Purpose) error {
+	if err := s.CheckCode(ctx, phone, code, purpose); err != nil {
+		return err
+	}
+
+	s.ConsumeCode(phone, purpose)
 	return nil
 }
 
-// VerifyCode 验证验证码
-func (s *SMSService) VerifyCode(ctx context.Context, phone, code string) error {
-	codeKey := fmt.Sprintf("%s%s", smsCodePrefix, phone)
-	storedCode, ok := s.kv.Get(codeKey)
+// CheckCode 验证指定用途的验证码但不使其失效。需要同时验证多个验证码时，全部通过后再调用 ConsumeCode
+func (s *SMSService) CheckCode(ctx context.Context, phone, code string, purpose Purpose) error {
+	key := codeKey(phone, purpose)
+	storedCode, ok := s.kv.Get(key)
 	if !ok {
 		return serializer.NewError(serializer.CodeParamErr, "验证码已过期或不存在", nil)
 	}
 
-	if storedCode.(string) != code {
+	stored, _ := storedCode.(string)
+	if !checkCode(stored, phone, purpose, code) {
+		attempts := incr(s.kv, smsAttemptsPrefix+phone, smsCodeTTL)
+		if s.limit.MaxAttempts > 0 && attempts >= s.limit.MaxAttempts {
+			// 错误次数过多，作废验证码并暂时限制该号码
+			_ = s.kv.Delete("", key)
+			_ = s.kv.Delete(smsAttemptsPrefix, phone)
+			if s.limit.BlockDuration > 0 {
+				if err := block(s.kv, phone, BlockReasonTooManyAttempts, time.Now().Add(s.limit.BlockDuration)); err != nil {
+					s.logger.Warning("Failed to block phone %s: %s", phone, err)
+				}
+			}
+			return serializer.NewError(serializer.CodeSMSBlocked, "验证码错误次数过多，请重新获取", nil)
+		}
 		return serializer.NewError(serializer.CodeParamErr, "验证码错误", nil)
 	}
 
-	// 验证成功后删除验证码
-	_ = s.kv.Delete(codeKey)
-
 	return nil
 }
 
+// ConsumeCode 删除已验证的验证码及错误次数
+func (s *SMSService) ConsumeCode(phone string, purpose Purpose) {
+	_ = s.kv.Delete("", codeKey(phone, purpose))
+	_ = s.kv.Delete(smsAttemptsPrefix, phone)
+}
+
 // MockSMSProvider 模拟短信服务（用于开发测试）
 type MockSMSProvider struct {
 	logger logging.Logger
@@ -115,73 +263,67 @@ func (m *MockSMSProvider) Send(ctx context.Context, phone, code string) error {
 	return nil
 }
 
-// SMSConfig 短信服务配置（从环境变量或配置文件读取）
-type SMSConfig struct {
-	Provider        string // "aliyun", "tencent", "mock"
-	AliyunAccessKeyID     string
-	AliyunAccessKeySecret string
-	AliyunSignName        string
-	AliyunTemplateCode    string
-	TencentSecretID       string
-	TencentSecretKey      string
-	TencentSDKAppID       string
-	TencentSignName       string
-	TencentTemplateID     string
-}
-
-// GetSMSProvider 根据配置获取短信服务提供商
-func GetSMSProvider(dep dependency.Dep, logger logging.Logger) SMSProvider {
-	// 从环境变量读取配置
-	provider := os.Getenv("SMS_PROVIDER")
-	if provider == "" {
-		provider = "mock" // 默认使用Mock
-	}
-
-	requestClient := dep.RequestClient(
-		request.WithLogger(logger),
-	)
-
-	switch provider {
-	case "aliyun":
-		accessKeyID := os.Getenv("SMS_ALIYUN_ACCESS_KEY_ID")
-		accessKeySecret := os.Getenv("SMS_ALIYUN_ACCESS_KEY_SECRET")
-		signName := os.Getenv("SMS_ALIYUN_SIGN_NAME")
-		templateCode := os.Getenv("SMS_ALIYUN_TEMPLATE_CODE")
-
-		if accessKeyID == "" || accessKeySecret == "" || signName == "" || templateCode == "" {
-			logger.Warning("Aliyun SMS config incomplete, falling back to Mock")
-			return NewMockSMSProvider(logger)
+// Notify 发送通知短信（模拟）
+func (m *MockSMSProvider) Notify(ctx context.Context, phone, message string) error {
+	m.logger.Info("Mock SMS: Sending notice %q to phone %s", message, phone)
+	return nil
+}
+
+// NewProvider 根据站点设置创建短信服务提供商，配置不完整时返回错误
+func NewProvider(config *setting.SMS, logger logging.Logger, requestClient request.Client) (SMSProvider, error) {
+	switch config.Provider {
+	case setting.SMSProviderAliyun:
+		c := config.Aliyun
+		if c.AccessKeyID == "" || c.AccessKeySecret == "" || c.SignName == "" || c.TemplateCode == "" {
+			return nil, fmt.Errorf("%w: incomplete Aliyun SMS settings", ErrInvalidConfig)
 		}
 
 		return NewAliyunSMSProvider(AliyunSMSConfig{
-			AccessKeyID:     accessKeyID,
-			AccessKeySecret: accessKeySecret,
-			SignName:        signName,
-			TemplateCode:    templateCode,
-		}, logger, requestClient)
-
-	case "tencent":
-		secretID := os.Getenv("SMS_TENCENT_SECRET_ID")
-		secretKey := os.Getenv("SMS_TENCENT_SECRET_KEY")
-		sdkAppID := os.Getenv("SMS_TENCENT_SDK_APP_ID")
-		signName := os.Getenv("SMS_TENCENT_SIGN_NAME")
-		templateID := os.Getenv("SMS_TENCENT_TEMPLATE_ID")
-
-		if secretID == "" || secretKey == "" || sdkAppID == "" || signName == "" || templateID == "" {
-			logger.Warning("Tencent SMS config incomplete, falling back to Mock")
-			return NewMockSMSProvider(logger)
+			AccessKeyID:     c.AccessKeyID,
+			AccessKeySecret: c.AccessKeySecret,
+			SignName:        c.SignName,
+			TemplateCode:    c.TemplateCode,
+		}, logger, requestClient), nil
+
+	case setting.SMSProviderTencent:
+		c := config.Tencent
+		if c.SecretID == "" || c.SecretKey == "" || c.SDKAppID == "" || c.SignName == "" || c.TemplateID == "" {
+			return nil, fmt.Errorf("%w: incomplete Tencent SMS settings", ErrInvalidConfig)
 		}
 
 		return NewTencentSMSProvider(TencentSMSConfig{
-			SecretID:   secretID,
-			SecretKey:  secretKey,
-			SDKAppID:   sdkAppID,
-			SignName:   signName,
-			TemplateID: templateID,
-		}, logger, requestClient)
+			SecretID:   c.SecretID,
+			SecretKey:  c.SecretKey,
+			SDKAppID:   c.SDKAppID,
+			SignName:   c.SignName,
+			TemplateID: c.TemplateID,
+		}, logger, requestClient), nil
+
+	case setting.SMSProviderWebhook:
+		return NewWebhookSMSProvider(config.Webhook, logger, requestClient)
+
+	case setting.SMSProviderMock:
+		return NewMockSMSProvider(logger), nil
+
+	case setting.SMSProviderNone:
+		return nil, ErrNotConfigured
 
 	default:
-		return NewMockSMSProvider(logger)
+		return nil, fmt.Errorf("%w: unknown SMS provider %q", ErrInvalidConfig, config.Provider)
 	}
 }
 
+// errorProvider 配置无效时使用，每次发送都返回配置错误
+type errorProvider struct {
+	err error
+}
+
+// NewErrorProvider 创建总是返回 err 的短信服务提供商
+func NewErrorProvider(err error) SMSProvider {
+	return &errorProvider{err: err}
+}
+
+// Send 返回配置错误
+func (e *errorProvider) Send(ctx context.Context, phone, code string) error {
+	return e.err
+}
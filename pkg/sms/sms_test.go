@@ -0,0 +1,188 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordProvider struct {
+	mu    sync.Mutex
+	codes map[string]string
+	err   error
+}
+
+func (r *recordProvider) Send(ctx context.Context, phone, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+
+	r.codes[phone] = code
+	return nil
+}
+
+func newTestService(limit *setting.SMSLimit) (*SMSService, *recordProvider, cache.Driver) {
+	l := logging.NewConsoleLogger(logging.LevelError)
+	kv := cache.NewMemoStore("", l)
+	provider := &recordProvider{codes: make(map[string]string)}
+	return NewSMSService(kv, l, provider, limit), provider, kv
+}
+
+func TestSMSService_VerifyCodeAttempts(t *testing.T) {
+	asserts := assert.New(t)
+	s, provider, kv := newTestService(&setting.SMSLimit{MaxAttempts: 3, BlockDuration: 3600e9})
+
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+	code := provider.codes["13800138000"]
+
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", "wrong1", PurposeLogin))
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", "wrong2", PurposeLogin))
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", "wrong3", PurposeLogin))
+
+	// Code is burned after max attempts
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", code, PurposeLogin))
+	blocked := ListBlocked(kv)
+	asserts.Len(blocked, 1)
+	asserts.Equal(BlockReasonTooManyAttempts, blocked[0].Reason)
+
+	// Blocked number cannot request new code until unblocked
+	_ = kv.Delete(smsCodePrefix, "13800138000_sent")
+	asserts.Error(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+	asserts.NoError(Unblock(kv, "13800138000"))
+	asserts.Empty(ListBlocked(kv))
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+	asserts.NoError(s.VerifyCode(context.Background(), "13800138000", provider.codes["13800138000"], PurposeLogin))
+}
+
+func TestSMSService_SendCodeQuota(t *testing.T) {
+	asserts := assert.New(t)
+	s, _, kv := newTestService(&setting.SMSLimit{PhoneDailyQuota: 1, IPDailyQuota: 2, PrefixDailyQuota: 3, PrefixLength: 7})
+
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+
+	// Phone quota
+	_ = kv.Delete(smsCodePrefix, "13800138000_sent")
+	asserts.Error(s.SendCode(context.Background(), "13800138000", "1.1.1.2", PurposeLogin))
+	asserts.Len(ListBlocked(kv), 1)
+
+	// IP quota
+	asserts.NoError(s.SendCode(context.Background(), "13800138001", "1.1.1.1", PurposeLogin))
+	asserts.Error(s.SendCode(context.Background(), "13800138002", "1.1.1.1", PurposeLogin))
+
+	// Prefix quota
+	asserts.NoError(s.SendCode(context.Background(), "13800138003", "1.1.1.3", PurposeLogin))
+	asserts.Error(s.SendCode(context.Background(), "13800138004", "1.1.1.4", PurposeLogin))
+	asserts.NoError(s.SendCode(context.Background(), "13900138004", "1.1.1.4", PurposeLogin))
+}
+
+func TestSMSService_SendCodeConcurrent(t *testing.T) {
+	asserts := assert.New(t)
+	s, provider, _ := newTestService(&setting.SMSLimit{IPDailyQuota: 5})
+
+	var (
+		wg   sync.WaitGroup
+		sent atomic.Int32
+	)
+	send := func(phone string) {
+		defer wg.Done()
+		if s.SendCode(context.Background(), phone, "1.1.1.1", PurposeLogin) == nil {
+			sent.Add(1)
+		}
+	}
+
+	// Only one of concurrent requests for the same phone passes the interval check
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go send("13800138000")
+	}
+	wg.Wait()
+	asserts.EqualValues(1, sent.Load())
+
+	// Concurrent requests cannot exceed the quota
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go send(fmt.Sprintf("139%08d", i))
+	}
+	wg.Wait()
+	asserts.EqualValues(5, sent.Load())
+	asserts.Len(provider.codes, 5)
+}
+
+func TestSMSService_SendCodeFailure(t *testing.T) {
+	asserts := assert.New(t)
+	s, provider, kv := newTestService(&setting.SMSLimit{PhoneDailyQuota: 1})
+
+	// Failed send does not consume quota or interval
+	provider.err = errors.New("provider down")
+	asserts.Error(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+	asserts.Equal(0, counter(kv, phoneQuotaKey("13800138000", time.Now())))
+
+	provider.err = nil
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeLogin))
+	asserts.Equal(1, counter(kv, phoneQuotaKey("13800138000", time.Now())))
+}
+
+func TestSMSService_VerifyCodePurpose(t *testing.T) {
+	asserts := assert.New(t)
+	s, provider, kv := newTestService(&setting.SMSLimit{MaxAttempts: 5})
+
+	asserts.Error(s.SendCode(context.Background(), "13800138000", "1.1.1.1", Purpose("unknown")))
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeRegister))
+	code := provider.codes["13800138000"]
+	asserts.Len(code, 6)
+
+	// Plaintext code is never stored
+	stored, ok := kv.Get(codeKey("13800138000", PurposeRegister))
+	asserts.True(ok)
+	asserts.NotContains(stored.(string), code)
+
+	// Registration code cannot be used for login
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", code, PurposeLogin))
+	asserts.NoError(s.VerifyCode(context.Background(), "13800138000", code, PurposeRegister))
+
+	// Code is consumed after successful verification
+	asserts.Error(s.VerifyCode(context.Background(), "13800138000", code, PurposeRegister))
+}
+
+func TestIncrConcurrent(t *testing.T) {
+	asserts := assert.New(t)
+	_, _, kv := newTestService(&setting.SMSLimit{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			incr(kv, "counter", 60)
+		}()
+	}
+	wg.Wait()
+
+	asserts.Equal(100, counter(kv, "counter"))
+}
+
+func TestSMSService_CheckCode(t *testing.T) {
+	asserts := assert.New(t)
+	s, provider, _ := newTestService(&setting.SMSLimit{MaxAttempts: 5})
+
+	asserts.NoError(s.SendCode(context.Background(), "13800138000", "1.1.1.1", PurposeBind))
+	code := provider.codes["13800138000"]
+
+	// Checked code is still valid until consumed
+	asserts.NoError(s.CheckCode(context.Background(), "13800138000", code, PurposeBind))
+	asserts.NoError(s.CheckCode(context.Background(), "13800138000", code, PurposeBind))
+
+	s.ConsumeCode("13800138000", PurposeBind)
+	asserts.Error(s.CheckCode(context.Background(), "13800138000", code, PurposeBind))
+}
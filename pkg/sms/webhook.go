@@ -0,0 +1,110 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	webhookPhonePlaceholder = "{phone}"
+	webhookCodePlaceholder  = "{code}"
+	// 通知短信的内容，发送验证码时为空
+	webhookMessagePlaceholder = "{message}"
+)
+
+// WebhookSMSProvider 通过自建 HTTP 短信网关发送验证码
+type WebhookSMSProvider struct {
+	url           string
+	method        string
+	headers       http.Header
+	body          string
+	logger        logging.Logger
+	requestClient request.Client
+}
+
+// NewWebhookSMSProvider 创建 HTTP 短信网关提供商
+func NewWebhookSMSProvider(config setting.SMSWebhook, logger logging.Logger, requestClient request.Client) (SMSProvider, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("%w: webhook URL is empty", ErrInvalidConfig)
+	}
+
+	if _, err := url.Parse(config.URL); err != nil {
+		return nil, fmt.Errorf("%w: invalid webhook URL: %s", ErrInvalidConfig, err)
+	}
+
+	method := strings.ToUpper(config.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	if method != http.MethodPost && method != http.MethodGet && method != http.MethodPut {
+		return nil, fmt.Errorf("%w: unsupported webhook method %q", ErrInvalidConfig, config.Method)
+	}
+
+	headers := http.Header{}
+	if config.Headers != "" {
+		var raw map[string]string
+		if err := json.Unmarshal([]byte(config.Headers), &raw); err != nil {
+			return nil, fmt.Errorf("%w: webhook headers must be a JSON object: %s", ErrInvalidConfig, err)
+		}
+		for k, v := range raw {
+			headers.Set(k, v)
+		}
+	}
+
+	return &WebhookSMSProvider{
+		url:           config.URL,
+		method:        method,
+		headers:       headers,
+		body:          config.Body,
+		logger:        logger,
+		requestClient: requestClient,
+	}, nil
+}
+
+// Send 发送验证码短信
+func (w *WebhookSMSProvider) Send(ctx context.Context, phone, code string) error {
+	return w.request(ctx, phone, code, "")
+}
+
+// Notify 发送通知短信
+func (w *WebhookSMSProvider) Notify(ctx context.Context, phone, message string) error {
+	return w.request(ctx, phone, "", message)
+}
+
+// request 请求短信网关，网关返回 2xx 视为成功
+func (w *WebhookSMSProvider) request(ctx context.Context, phone, code, message string) error {
+	target := strings.NewReplacer(
+		webhookPhonePlaceholder, url.QueryEscape(phone),
+		webhookCodePlaceholder, url.QueryEscape(code),
+		webhookMessagePlaceholder, url.QueryEscape(message),
+	).Replace(w.url)
+	body := strings.NewReplacer(
+		webhookPhonePlaceholder, phone,
+		webhookCodePlaceholder, code,
+		webhookMessagePlaceholder, message,
+	).Replace(w.body)
+
+	resp := w.requestClient.Request(w.method, target, strings.NewReader(body),
+		request.WithContext(ctx),
+		request.WithLogger(w.logger),
+		request.WithHeader(w.headers),
+	)
+	if resp.Err != nil {
+		return fmt.Errorf("failed to request SMS gateway: %w", resp.Err)
+	}
+	defer resp.Response.Body.Close()
+
+	if resp.Response.StatusCode < 200 || resp.Response.StatusCode >= 300 {
+		return fmt.Errorf("SMS gateway returned status %d", resp.Response.StatusCode)
+	}
+
+	return nil
+}
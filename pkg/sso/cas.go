@@ -0,0 +1,138 @@
+package sso
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const casStateQuery = "state"
+
+// CASProvider authenticates users against a CAS 3.0 server.
+type CASProvider struct {
+	server *url.URL
+	client request.Client
+}
+
+type (
+	casServiceResponse struct {
+		XMLName xml.Name        `xml:"serviceResponse"`
+		Success *casAuthSuccess `xml:"authenticationSuccess"`
+		Failure *casAuthFailure `xml:"authenticationFailure"`
+	}
+	casAuthSuccess struct {
+		User       string `xml:"user"`
+		Attributes struct {
+			Items []casAttribute `xml:",any"`
+		} `xml:"attributes"`
+	}
+	casAuthFailure struct {
+		Code    string `xml:"code,attr"`
+		Message string `xml:",chardata"`
+	}
+	casAttribute struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	}
+)
+
+// NewCASProvider creates a CAS provider.
+func NewCASProvider(config *setting.SSOProvider, client request.Client) (Provider, error) {
+	if config.CASServerURL == "" {
+		return nil, fmt.Errorf("%w: CAS server URL is empty", ErrInvalidConfig)
+	}
+
+	server, err := url.Parse(strings.TrimSuffix(config.CASServerURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid CAS server URL: %s", ErrInvalidConfig, err)
+	}
+
+	return &CASProvider{server: server, client: client}, nil
+}
+
+func (p *CASProvider) LoginURL(ctx context.Context, callback string, session *Session) (string, error) {
+	service, err := p.serviceURL(callback, session)
+	if err != nil {
+		return "", err
+	}
+
+	loginURL := p.endpoint("/login")
+	query := loginURL.Query()
+	query.Set("service", service)
+	loginURL.RawQuery = query.Encode()
+	return loginURL.String(), nil
+}
+
+func (p *CASProvider) Authenticate(ctx context.Context, callback string, session *Session, params url.Values) (*Identity, error) {
+	ticket := params.Get("ticket")
+	if ticket == "" {
+		return nil, fmt.Errorf("%w: ticket is empty", ErrAuthFailed)
+	}
+
+	// CAS 校验票据时 service 必须与登录时完全一致
+	service, err := p.serviceURL(callback, session)
+	if err != nil {
+		return nil, err
+	}
+
+	validateURL := p.endpoint("/p3/serviceValidate")
+	query := validateURL.Query()
+	query.Set("service", service)
+	query.Set("ticket", ticket)
+	validateURL.RawQuery = query.Encode()
+
+	body, err := p.client.Request(http.MethodGet, validateURL.String(), nil,
+		request.WithContext(ctx),
+	).CheckHTTPResponse(http.StatusOK).GetResponse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate CAS ticket: %w", err)
+	}
+
+	var res casServiceResponse
+	if err := xml.Unmarshal([]byte(body), &res); err != nil {
+		return nil, fmt.Errorf("failed to parse CAS response: %w", err)
+	}
+
+	if res.Failure != nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrAuthFailed, res.Failure.Code, strings.TrimSpace(res.Failure.Message))
+	}
+
+	if res.Success == nil || strings.TrimSpace(res.Success.User) == "" {
+		return nil, fmt.Errorf("%w: unexpected CAS response", ErrAuthFailed)
+	}
+
+	identity := &Identity{
+		Subject:    strings.TrimSpace(res.Success.User),
+		Attributes: make(map[string][]string),
+	}
+	for _, attr := range res.Success.Attributes.Items {
+		identity.Attributes[attr.XMLName.Local] = append(identity.Attributes[attr.XMLName.Local], strings.TrimSpace(attr.Value))
+	}
+
+	return identity, nil
+}
+
+// serviceURL appends state to callback, since CAS only passes the ticket back.
+func (p *CASProvider) serviceURL(callback string, session *Session) (string, error) {
+	service, err := url.Parse(callback)
+	if err != nil {
+		return "", fmt.Errorf("invalid callback URL: %w", err)
+	}
+
+	query := service.Query()
+	query.Set(casStateQuery, session.State)
+	service.RawQuery = query.Encode()
+	return service.String(), nil
+}
+
+func (p *CASProvider) endpoint(path string) *url.URL {
+	res := *p.server
+	res.Path = strings.TrimSuffix(res.Path, "/") + path
+	return &res
+}
@@ -0,0 +1,307 @@
+package sso
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	oidcClockSkew     = time.Minute
+)
+
+var (
+	defaultOIDCScopes = []string{"openid", "profile", "email"}
+	oidcSigningAlgs   = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// OIDCProvider authenticates users against an OpenID Connect provider using authorization code flow with PKCE.
+type OIDCProvider struct {
+	config *setting.SSOProvider
+	client request.Client
+}
+
+type (
+	oidcDiscovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserinfoEndpoint      string `json:"userinfo_endpoint"`
+		JwksURI               string `json:"jwks_uri"`
+	}
+	oidcTokenResponse struct {
+		AccessToken      string `json:"access_token"`
+		IDToken          string `json:"id_token"`
+		TokenType        string `json:"token_type"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+)
+
+// NewOIDCProvider creates an OIDC provider.
+func NewOIDCProvider(config *setting.SSOProvider, client request.Client) (Provider, error) {
+	if config.Issuer == "" {
+		return nil, fmt.Errorf("%w: issuer is empty", ErrInvalidConfig)
+	}
+
+	if _, err := url.Parse(config.Issuer); err != nil {
+		return nil, fmt.Errorf("%w: invalid issuer: %s", ErrInvalidConfig, err)
+	}
+
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("%w: client ID is empty", ErrInvalidConfig)
+	}
+
+	return &OIDCProvider{config: config, client: client}, nil
+}
+
+func (p *OIDCProvider) LoginURL(ctx context.Context, callback string, session *Session) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+
+	challenge := sha256.Sum256([]byte(session.Verifier))
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", callback)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", session.State)
+	query.Set("nonce", session.Nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+func (p *OIDCProvider) Authenticate(ctx context.Context, callback string, session *Session, params url.Values) (*Identity, error) {
+	if errCode := params.Get("error"); errCode != "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrAuthFailed, errCode, params.Get("error_description"))
+	}
+
+	code := params.Get("code")
+	if code == "" {
+		return nil, fmt.Errorf("%w: authorization code is empty", ErrAuthFailed)
+	}
+
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.exchange(ctx, discovery, callback, code, session.Verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := p.verifyIDToken(ctx, discovery, token.IDToken, session.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: ID token has no subject", ErrAuthFailed)
+	}
+
+	identity := &Identity{
+		Subject:    subject,
+		Attributes: claimsToAttributes(claims),
+	}
+
+	// 部分身份提供方仅通过 UserInfo 返回学号等属性
+	if discovery.UserinfoEndpoint != "" && token.AccessToken != "" {
+		userInfo, err := p.userInfo(ctx, discovery, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if sub, _ := userInfo["sub"].(string); sub != subject {
+			return nil, fmt.Errorf("%w: UserInfo subject mismatch", ErrAuthFailed)
+		}
+
+		for k, v := range claimsToAttributes(userInfo) {
+			if _, ok := identity.Attributes[k]; !ok {
+				identity.Attributes[k] = v
+			}
+		}
+	}
+
+	return identity, nil
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	var discovery oidcDiscovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+oidcDiscoveryPath, nil, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("%w: incomplete OIDC discovery document", ErrInvalidConfig)
+	}
+
+	// 发现文档中的 issuer 必须与配置一致，否则可能接受其他身份提供方签发的 ID Token
+	if discovery.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("%w: issuer %q in discovery document does not match %q", ErrInvalidConfig, discovery.Issuer, p.config.Issuer)
+	}
+
+	return &discovery, nil
+}
+
+func (p *OIDCProvider) exchange(ctx context.Context, discovery *oidcDiscovery, callback, code, verifier string) (*oidcTokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", callback)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("code_verifier", verifier)
+	if p.config.ClientSecret != "" {
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+
+	resp := p.client.Request(http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()),
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+			"Accept":       {"application/json"},
+		}),
+	)
+	body, err := resp.GetResponse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token endpoint: %w", err)
+	}
+
+	var token oidcTokenResponse
+	if err := json.Unmarshal([]byte(body), &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+
+	if token.Error != "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrAuthFailed, token.Error, token.ErrorDescription)
+	}
+
+	if resp.Response.StatusCode != http.StatusOK || token.IDToken == "" {
+		return nil, fmt.Errorf("%w: token endpoint returned status %d without ID token", ErrAuthFailed, resp.Response.StatusCode)
+	}
+
+	return &token, nil
+}
+
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, discovery *oidcDiscovery, idToken, nonce string) (jwt.MapClaims, error) {
+	keys, err := p.fetchKeys(ctx, discovery.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, keys.keyFunc,
+		jwt.WithValidMethods(oidcSigningAlgs),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ID token: %s", ErrAuthFailed, err)
+	}
+
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, fmt.Errorf("%w: ID token nonce mismatch", ErrAuthFailed)
+	}
+
+	return claims, nil
+}
+
+func (p *OIDCProvider) fetchKeys(ctx context.Context, jwksURI string) (*jsonWebKeySet, error) {
+	var keys jsonWebKeySet
+	if err := p.getJSON(ctx, jwksURI, nil, &keys); err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	return &keys, nil
+}
+
+func (p *OIDCProvider) userInfo(ctx context.Context, discovery *oidcDiscovery, accessToken string) (map[string]any, error) {
+	var claims map[string]any
+	header := http.Header{"Authorization": {"Bearer " + accessToken}}
+	if err := p.getJSON(ctx, discovery.UserinfoEndpoint, header, &claims); err != nil {
+		return nil, fmt.Errorf("failed to request UserInfo endpoint: %w", err)
+	}
+
+	return claims, nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, target string, header http.Header, v any) error {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Accept", "application/json")
+
+	body, err := p.client.Request(http.MethodGet, target, nil,
+		request.WithContext(ctx),
+		request.WithHeader(header),
+	).CheckHTTPResponse(http.StatusOK).GetResponse()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(body), v)
+}
+
+// claimsToAttributes flattens scalar and array claims into string values. Nested objects are ignored.
+func claimsToAttributes(claims map[string]any) map[string][]string {
+	res := make(map[string][]string, len(claims))
+	for k, v := range claims {
+		switch value := v.(type) {
+		case []any:
+			for _, item := range value {
+				if s, ok := claimString(item); ok {
+					res[k] = append(res[k], s)
+				}
+			}
+		default:
+			if s, ok := claimString(value); ok {
+				res[k] = []string{s}
+			}
+		}
+	}
+
+	return res
+}
+
+func claimString(v any) (string, bool) {
+	switch value := v.(type) {
+	case string:
+		return value, true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(value), true
+	default:
+		return "", false
+	}
+}
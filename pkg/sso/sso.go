@@ -0,0 +1,137 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	ErrInvalidConfig   = errors.New("invalid SSO provider config")
+	ErrUnsupportedType = errors.New("unsupported SSO provider type")
+	ErrAuthFailed      = errors.New("SSO authentication failed")
+)
+
+func init() {
+	gob.Register(Session{})
+}
+
+type (
+	// Provider is an external identity provider.
+	Provider interface {
+		// LoginURL returns the URL user should be redirected to for signing in. IdP will redirect user
+		// back to callback after signing in.
+		LoginURL(ctx context.Context, callback string, session *Session) (string, error)
+		// Authenticate validates parameters IdP appended to callback and returns the signed-in identity.
+		Authenticate(ctx context.Context, callback string, session *Session, params url.Values) (*Identity, error)
+	}
+
+	// Identity is an authenticated user in IdP.
+	Identity struct {
+		Subject    string
+		Attributes map[string][]string
+	}
+
+	// Session is the state kept between redirecting user to IdP and receiving the callback.
+	Session struct {
+		ProviderID string
+		State      string
+		Nonce      string
+		// Verifier is the PKCE code verifier.
+		Verifier string
+		// UserID is set when a signed-in user is linking this identity to own account.
+		UserID int
+	}
+
+	// Profile is the user profile mapped from identity attributes.
+	Profile struct {
+		StudentID  string
+		University string
+		Major      string
+		Nick       string
+		Email      string
+		// GroupID is 0 if no group is mapped.
+		GroupID int
+	}
+)
+
+// NewProvider creates a provider from its setting.
+func NewProvider(config *setting.SSOProvider, client request.Client) (Provider, error) {
+	switch config.Type {
+	case setting.SSOProviderCAS:
+		return NewCASProvider(config, client)
+	case setting.SSOProviderOIDC:
+		return NewOIDCProvider(config, client)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, config.Type)
+	}
+}
+
+// NewSession creates a new session with random state, nonce and PKCE verifier.
+func NewSession(providerID string, uid int) (*Session, error) {
+	tokens := make([]string, 3)
+	for i := range tokens {
+		// 32 字节随机数编码后为 43 个字符，满足 PKCE 对 verifier 长度的要求
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate random token: %w", err)
+		}
+		tokens[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	return &Session{
+		ProviderID: providerID,
+		State:      tokens[0],
+		Nonce:      tokens[1],
+		Verifier:   tokens[2],
+		UserID:     uid,
+	}, nil
+}
+
+// Attr returns the first value of given attribute.
+func (i *Identity) Attr(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	if values := i.Attributes[name]; len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+
+	return ""
+}
+
+// MapProfile maps identity attributes to user profile according to provider setting.
+func MapProfile(config *setting.SSOProvider, identity *Identity) *Profile {
+	mapping := config.Attributes
+	profile := &Profile{
+		StudentID:  identity.Attr(mapping.StudentID),
+		University: identity.Attr(mapping.University),
+		Major:      identity.Attr(mapping.Major),
+		Nick:       identity.Attr(mapping.Nick),
+		Email:      identity.Attr(mapping.Email),
+	}
+
+	if profile.University == "" {
+		profile.University = config.DefaultUniversity
+	}
+
+	if mapping.Group != "" {
+		for _, value := range identity.Attributes[mapping.Group] {
+			if groupID, ok := config.GroupMapping[strings.TrimSpace(value)]; ok {
+				profile.GroupID = groupID
+				break
+			}
+		}
+	}
+
+	return profile
+}
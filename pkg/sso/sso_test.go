@@ -0,0 +1,269 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCallback = "https://drive.example.edu/session/sso/campus"
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+func newTestClient() request.Client {
+	return request.NewClient(testConfig{})
+}
+
+func TestCASProvider(t *testing.T) {
+	asserts := assert.New(t)
+	session, err := NewSession("campus", 0)
+	asserts.NoError(err)
+
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		asserts.Equal("/cas/p3/serviceValidate", r.URL.Path)
+		service, _ := url.Parse(r.URL.Query().Get("service"))
+		asserts.Equal(session.State, service.Query().Get("state"))
+
+		if r.URL.Query().Get("ticket") != "ST-1" {
+			w.Write([]byte(`<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas">
+  <cas:authenticationFailure code="INVALID_TICKET">Ticket not recognized</cas:authenticationFailure>
+</cas:serviceResponse>`))
+			return
+		}
+
+		w.Write([]byte(`<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas">
+  <cas:authenticationSuccess>
+    <cas:user>zhangsan</cas:user>
+    <cas:attributes>
+      <cas:studentId>2024001</cas:studentId>
+      <cas:school>示例大学</cas:school>
+      <cas:memberOf>staff</cas:memberOf>
+      <cas:memberOf>students</cas:memberOf>
+    </cas:attributes>
+  </cas:authenticationSuccess>
+</cas:serviceResponse>`))
+	}))
+	defer idp.Close()
+
+	config := &setting.SSOProvider{
+		ID:           "campus",
+		Type:         setting.SSOProviderCAS,
+		CASServerURL: idp.URL + "/cas/",
+		Attributes: setting.SSOAttributeMapping{
+			StudentID:  "studentId",
+			University: "school",
+			Group:      "memberOf",
+		},
+		GroupMapping: map[string]int{"students": 3},
+	}
+	provider, err := NewProvider(config, newTestClient())
+	asserts.NoError(err)
+
+	loginURL, err := provider.LoginURL(context.Background(), testCallback, session)
+	asserts.NoError(err)
+	parsed, _ := url.Parse(loginURL)
+	asserts.Equal("/cas/login", parsed.Path)
+
+	_, err = provider.Authenticate(context.Background(), testCallback, session, url.Values{"ticket": {"ST-2"}})
+	asserts.ErrorIs(err, ErrAuthFailed)
+
+	identity, err := provider.Authenticate(context.Background(), testCallback, session, url.Values{"ticket": {"ST-1"}})
+	asserts.NoError(err)
+	asserts.Equal("zhangsan", identity.Subject)
+	asserts.Equal([]string{"staff", "students"}, identity.Attributes["memberOf"])
+
+	profile := MapProfile(config, identity)
+	asserts.Equal("2024001", profile.StudentID)
+	asserts.Equal("示例大学", profile.University)
+	asserts.Equal(3, profile.GroupID)
+}
+
+type mockOIDC struct {
+	*httptest.Server
+	key     *rsa.PrivateKey
+	session *Session
+	// nonce overrides the nonce claim in issued ID token if not empty
+	nonce string
+	// issuer overrides the issuer in discovery document if not empty
+	issuer string
+}
+
+func newMockOIDC(t *testing.T, session *Session) *mockOIDC {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	m := &mockOIDC{key: key, session: session}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := m.URL
+		if m.issuer != "" {
+			issuer = m.issuer
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"userinfo_endpoint":      m.URL + "/userinfo",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "code-1" || base64.RawURLEncoding.EncodeToString(challenge[:]) != m.challenge() {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		nonce := m.session.Nonce
+		if m.nonce != "" {
+			nonce = m.nonce
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   m.URL,
+			"sub":   "u-1",
+			"aud":   "cloudreve",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": nonce,
+			"name":  "张三",
+		})
+		token.Header["kid"] = "k1"
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "at-1",
+			"token_type":   "Bearer",
+			"id_token":     signed,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"sub":        "u-1",
+			"name":       "ignored",
+			"student_id": 2024002,
+			"groups":     []string{"teachers"},
+		})
+	})
+
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+// challenge returns the PKCE challenge sent in authorization request.
+func (m *mockOIDC) challenge() string {
+	sum := sha256.Sum256([]byte(m.session.Verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestOIDCProvider(t *testing.T) {
+	asserts := assert.New(t)
+	session, err := NewSession("campus", 0)
+	asserts.NoError(err)
+	idp := newMockOIDC(t, session)
+	defer idp.Close()
+
+	config := &setting.SSOProvider{
+		ID:       "campus",
+		Type:     setting.SSOProviderOIDC,
+		Issuer:   idp.URL,
+		ClientID: "cloudreve",
+		Attributes: setting.SSOAttributeMapping{
+			StudentID: "student_id",
+			Nick:      "name",
+			Group:     "groups",
+		},
+		DefaultUniversity: "示例大学",
+		GroupMapping:      map[string]int{"teachers": 4},
+	}
+	provider, err := NewProvider(config, newTestClient())
+	asserts.NoError(err)
+
+	loginURL, err := provider.LoginURL(context.Background(), testCallback, session)
+	asserts.NoError(err)
+	parsed, _ := url.Parse(loginURL)
+	asserts.Equal(session.State, parsed.Query().Get("state"))
+	asserts.Equal(idp.challenge(), parsed.Query().Get("code_challenge"))
+	asserts.Equal(testCallback, parsed.Query().Get("redirect_uri"))
+
+	// Error returned by IdP
+	_, err = provider.Authenticate(context.Background(), testCallback, session, url.Values{"error": {"access_denied"}})
+	asserts.ErrorIs(err, ErrAuthFailed)
+
+	// Wrong code
+	_, err = provider.Authenticate(context.Background(), testCallback, session, url.Values{"code": {"code-2"}})
+	asserts.ErrorIs(err, ErrAuthFailed)
+
+	identity, err := provider.Authenticate(context.Background(), testCallback, session, url.Values{"code": {"code-1"}})
+	asserts.NoError(err)
+	asserts.Equal("u-1", identity.Subject)
+
+	profile := MapProfile(config, identity)
+	asserts.Equal("2024002", profile.StudentID)
+	asserts.Equal("张三", profile.Nick)
+	asserts.Equal("示例大学", profile.University)
+	asserts.Equal(4, profile.GroupID)
+
+	// Replayed ID token with another nonce is rejected
+	idp.nonce = "other"
+	_, err = provider.Authenticate(context.Background(), testCallback, session, url.Values{"code": {"code-1"}})
+	asserts.ErrorIs(err, ErrAuthFailed)
+}
+
+func TestOIDCProvider_IssuerMismatch(t *testing.T) {
+	asserts := assert.New(t)
+	session, err := NewSession("campus", 0)
+	asserts.NoError(err)
+	idp := newMockOIDC(t, session)
+	idp.issuer = "https://idp.example.com"
+	defer idp.Close()
+
+	provider, err := NewProvider(&setting.SSOProvider{
+		ID:       "campus",
+		Type:     setting.SSOProviderOIDC,
+		Issuer:   idp.URL,
+		ClientID: "cloudreve",
+	}, newTestClient())
+	asserts.NoError(err)
+
+	_, err = provider.LoginURL(context.Background(), testCallback, session)
+	asserts.ErrorIs(err, ErrInvalidConfig)
+}
@@ -0,0 +1,71 @@
+package util
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// parsePrefix 解析 CIDR 或单个 IP，单个 IP 视为只包含自身的网段
+func parsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+// ValidateCIDRList 校验 IP/CIDR 列表格式
+func ValidateCIDRList(list []string) error {
+	for _, item := range list {
+		if _, err := parsePrefix(item); err != nil {
+			return fmt.Errorf("invalid IP or CIDR %q: %w", item, err)
+		}
+	}
+
+	return nil
+}
+
+// IPInList 判断 IP 是否落在列表中任一网段内，无法解析的条目会被忽略
+func IPInList(ip string, list []string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, item := range list {
+		if prefix, err := parsePrefix(item); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IPAllowed 根据允许与拒绝列表判断 IP 是否可以访问。拒绝列表优先；允许列表为空时不限制，
+// 否则仅允许列表中的 IP。无法解析的 IP 在设置了任一列表时均被拒绝。
+func IPAllowed(ip string, allow, deny []string) bool {
+	if len(allow) == 0 && len(deny) == 0 {
+		return true
+	}
+
+	if _, err := netip.ParseAddr(strings.TrimSpace(ip)); err != nil {
+		return false
+	}
+
+	if IPInList(ip, deny) {
+		return false
+	}
+
+	return len(allow) == 0 || IPInList(ip, allow)
+}
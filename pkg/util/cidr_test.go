@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCIDRList(t *testing.T) {
+	asserts := assert.New(t)
+
+	asserts.NoError(ValidateCIDRList(nil))
+	asserts.NoError(ValidateCIDRList([]string{"10.0.0.0/8", "192.168.1.1", " 2001:db8::/32 "}))
+	asserts.Error(ValidateCIDRList([]string{"10.0.0.0/33"}))
+	asserts.Error(ValidateCIDRList([]string{"campus"}))
+}
+
+func TestIPAllowed(t *testing.T) {
+	asserts := assert.New(t)
+
+	// 未设置任何列表
+	asserts.True(IPAllowed("8.8.8.8", nil, nil))
+	asserts.True(IPAllowed("", nil, nil))
+
+	// 仅允许列表
+	allow := []string{"10.0.0.0/8", "2001:db8::/32", "172.16.0.1"}
+	asserts.True(IPAllowed("10.1.2.3", allow, nil))
+	asserts.True(IPAllowed("::ffff:10.1.2.3", allow, nil))
+	asserts.True(IPAllowed("2001:db8::1", allow, nil))
+	asserts.True(IPAllowed("172.16.0.1", allow, nil))
+	asserts.False(IPAllowed("172.16.0.2", allow, nil))
+	asserts.False(IPAllowed("8.8.8.8", allow, nil))
+	asserts.False(IPAllowed("", allow, nil))
+
+	// 拒绝列表优先
+	deny := []string{"10.10.0.0/16"}
+	asserts.False(IPAllowed("10.10.1.1", allow, deny))
+	asserts.True(IPAllowed("10.11.1.1", allow, deny))
+	asserts.False(IPAllowed("10.10.1.1", nil, deny))
+	asserts.True(IPAllowed("8.8.8.8", nil, deny))
+}
@@ -116,6 +116,27 @@ func AdminSendTestMail(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// AdminSendTestSMS 发送测试短信
+func AdminSendTestSMS(c *gin.Context) {
+	service := ParametersFromContext[*admin.TestSMSService](c, admin.TestSMSParamCtx{})
+	err := service.Test(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminTestLDAP(c *gin.Context) {
+	service := ParametersFromContext[*admin.TestLDAPService](c, admin.TestLDAPParamCtx{})
+	res, err := service.Test(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminCreatePolicy(c *gin.Context) {
 	service := ParametersFromContext[*admin.CreateStoragePolicyService](c, admin.CreateStoragePolicyParamCtx{})
 	res, err := service.Create(c)
@@ -136,6 +157,16 @@ func AdminUpdatePolicy(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminRelocatePolicy(c *gin.Context) {
+	service := ParametersFromContext[*admin.RelocatePolicyService](c, admin.RelocatePolicyParamCtx{})
+	res, err := service.Relocate(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminListNodes(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
 	res, err := service.Nodes(c)
@@ -172,6 +203,18 @@ func AdminCreateStoragePolicyCors(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// AdminScanSftpHostKey 测试 SFTP 连接并获取服务器公钥
+func AdminScanSftpHostKey(c *gin.Context) {
+	service := ParametersFromContext[*admin.ScanSftpHostKeyService](c, admin.ScanSftpHostKeyParamCtx{})
+	res, err := service.Scan(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminOdOAuthURL(c *gin.Context) {
 	service := ParametersFromContext[*admin.GetOauthRedirectService](c, admin.GetOauthRedirectParamCtx{})
 	res, err := service.GetOAuth(c)
@@ -267,6 +310,17 @@ func AdminListUsers(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// AdminImportUsers 从花名册批量导入用户
+func AdminImportUsers(c *gin.Context) {
+	service := ParametersFromContext[*admin.ImportUserService](c, admin.ImportUserParamCtx{})
+	res, err := service.Import(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminGetUser(c *gin.Context) {
 	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
 	res, err := service.Get(c)
@@ -588,6 +642,24 @@ func AdminBatchDeleteShare(c *gin.Context) {
 	}
 }
 
+func AdminListAuditLogs(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.AuditLogs(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminExportAuditLogs(c *gin.Context) {
+	service := ParametersFromContext[*admin.ExportAuditLogService](c, admin.ExportAuditLogParamCtx{})
+	if err := service.Export(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+}
+
 func AdminCalibrateStorage(c *gin.Context) {
 	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
 	res, err := service.CalibrateStorage(c)
@@ -597,3 +669,290 @@ func AdminCalibrateStorage(c *gin.Context) {
 	}
 	c.JSON(200, serializer.Response{Data: res})
 }
+
+func AdminLogoutUser(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	if err := service.Logout(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminResetUserTwoFA(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	if err := service.ResetTwoFA(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListOAuthApps(c *gin.Context) {
+	res, err := admin.ListOAuthApps(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetOAuthApp(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleOAuthAppService](c, admin.SingleOAuthAppParamCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateOAuthApp(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertOAuthAppService](c, admin.UpsertOAuthAppParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateOAuthApp(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertOAuthAppService](c, admin.UpsertOAuthAppParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteOAuthApp(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleOAuthAppService](c, admin.SingleOAuthAppParamCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminResetOAuthAppSecret(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleOAuthAppService](c, admin.SingleOAuthAppParamCtx{})
+	res, err := service.ResetSecret(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListCourses(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.Courses(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetCourse(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleCourseService](c, admin.SingleCourseParamCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateCourse(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertCourseService](c, admin.UpsertCourseParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateCourse(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertCourseService](c, admin.UpsertCourseParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteCourse(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleCourseService](c, admin.SingleCourseParamCtx{})
+	err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminSetCourseMember(c *gin.Context) {
+	service := ParametersFromContext[*admin.SetCourseMemberService](c, admin.SetCourseMemberParamCtx{})
+	res, err := service.Set(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminRemoveCourseMember(c *gin.Context) {
+	service := ParametersFromContext[*admin.RemoveCourseMemberService](c, admin.RemoveCourseMemberParamCtx{})
+	err := service.Remove(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListInstitutions(c *gin.Context) {
+	res, err := admin.ListInstitutions(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateInstitution(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertInstitutionService](c, admin.UpsertInstitutionParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateInstitution(c *gin.Context) {
+	target := ParametersFromContext[*admin.SingleInstitutionService](c, admin.SingleInstitutionParamCtx{})
+	service := ParametersFromContext[*admin.UpsertInstitutionService](c, admin.UpsertInstitutionParamCtx{})
+	res, err := service.Update(c, target.ID)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteInstitution(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleInstitutionService](c, admin.SingleInstitutionParamCtx{})
+	err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminCreateMajor(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertMajorService](c, admin.UpsertMajorParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateMajor(c *gin.Context) {
+	target := ParametersFromContext[*admin.SingleMajorService](c, admin.SingleMajorParamCtx{})
+	service := ParametersFromContext[*admin.UpsertMajorService](c, admin.UpsertMajorParamCtx{})
+	res, err := service.Update(c, target.ID)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteMajor(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleMajorService](c, admin.SingleMajorParamCtx{})
+	err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminNormalizeUsers(c *gin.Context) {
+	service := ParametersFromContext[*admin.NormalizeUsersService](c, admin.NormalizeUsersParamCtx{})
+	res, err := service.Normalize(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminReapplyMapping(c *gin.Context) {
+	service := ParametersFromContext[*admin.ReapplyMappingService](c, admin.ReapplyMappingParamCtx{})
+	res, err := service.Reapply(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListBlockedPhones(c *gin.Context) {
+	c.JSON(200, serializer.Response{Data: admin.ListBlockedPhones(c)})
+}
+
+func AdminListLockedLogins(c *gin.Context) {
+	c.JSON(200, serializer.Response{Data: admin.ListLockedLogins(c)})
+}
+
+func AdminUnlockLogins(c *gin.Context) {
+	service := ParametersFromContext[*admin.UnlockLoginsService](c, admin.UnlockLoginsParamCtx{})
+	err := service.Unlock(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminBreachedPasswordStats(c *gin.Context) {
+	c.JSON(200, serializer.Response{Data: admin.BreachedPasswordStats()})
+}
+
+func AdminImportBreachedPasswords(c *gin.Context) {
+	res, err := admin.ImportBreachedPasswords(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminClearBreachedPasswords(c *gin.Context) {
+	if err := admin.ClearBreachedPasswords(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminUnblockPhones(c *gin.Context) {
+	service := ParametersFromContext[*admin.UnblockPhonesService](c, admin.UnblockPhonesParamCtx{})
+	err := service.Unblock(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
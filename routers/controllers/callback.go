@@ -109,18 +109,5 @@ func OneDriveOAuth(c *gin.Context) {
 
 // GoogleDriveOAuth Google Drive 授权回调
 func GoogleDriveOAuth(c *gin.Context) {
-	//var callbackBody callback.OauthService
-	//if err := c.ShouldBindQuery(&callbackBody); err == nil {
-	//	res := callbackBody.GDriveAuth(c)
-	//	redirect := model.GetSiteURL()
-	//	redirect.Path = path.Join(redirect.Path, "/admin/policy")
-	//	queries := redirect.Query()
-	//	queries.Add("code", strconv.Itoa(res.Code))
-	//	queries.Add("msg", res.Msg)
-	//	queries.Add("err", res.Error)
-	//	redirect.RawQuery = queries.Encode()
-	//	c.Redirect(303, redirect.String())
-	//} else {
-	//	c.JSON(200, ErrorResponse(err))
-	//}
+	c.Redirect(303, callback.GDriveAuthRedirect(c))
 }
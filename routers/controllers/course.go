@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/course"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAssignments 列出课程作业
+func ListAssignments(c *gin.Context) {
+	res, err := course.ListAssignments(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateAssignment 创建作业提交箱
+func CreateAssignment(c *gin.Context) {
+	service := ParametersFromContext[*course.AssignmentService](c, course.AssignmentParamCtx{})
+	res, err := service.Create(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateAssignment 更新作业
+func UpdateAssignment(c *gin.Context) {
+	service := ParametersFromContext[*course.AssignmentService](c, course.AssignmentParamCtx{})
+	res, err := service.Update(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteAssignment 删除作业
+func DeleteAssignment(c *gin.Context) {
+	if err := course.DeleteAssignment(c, hashid.FromContext(c)); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
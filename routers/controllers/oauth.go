@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/oauth"
+	"github.com/gin-gonic/gin"
+)
+
+// OAuthAuthorizeInfo 获取授权确认页信息
+func OAuthAuthorizeInfo(c *gin.Context) {
+	service := ParametersFromContext[*oauth.AuthorizeService](c, oauth.AuthorizeParamCtx{})
+	res, err := service.Info(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// OAuthApprove 同意或拒绝授权
+func OAuthApprove(c *gin.Context) {
+	service := ParametersFromContext[*oauth.ApproveAuthorizeService](c, oauth.ApproveAuthorizeParamCtx{})
+	res, err := service.Approve(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// OAuthToken 令牌端点，按 RFC 6749 格式响应
+func OAuthToken(c *gin.Context) {
+	var service oauth.TokenService
+	if err := c.ShouldBind(&service); err != nil {
+		c.JSON(http.StatusBadRequest, &oauth.Error{Code: "invalid_request", Description: err.Error()})
+		return
+	}
+
+	res, err := service.Token(c)
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+	c.JSON(http.StatusOK, res)
+}
+
+// OAuthIntrospect 令牌内省端点，按 RFC 7662 格式响应
+func OAuthIntrospect(c *gin.Context) {
+	var service oauth.IntrospectService
+	if err := c.ShouldBind(&service); err != nil {
+		c.JSON(http.StatusBadRequest, &oauth.Error{Code: "invalid_request", Description: err.Error()})
+		return
+	}
+
+	res, err := service.Introspect(c)
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func oauthError(c *gin.Context, err error) {
+	var oerr *oauth.Error
+	if !errors.As(err, &oerr) {
+		oerr = &oauth.Error{Status: http.StatusInternalServerError, Code: "server_error"}
+	}
+
+	if oerr.Status == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+
+	c.JSON(oerr.Status, oerr)
+}
+
+// UserListAuthorizedApps 列出已授权的第三方应用
+func UserListAuthorizedApps(c *gin.Context) {
+	res, err := oauth.ListAuthorizedApps(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserRevokeAuthorizedApp 撤销对第三方应用的授权
+func UserRevokeAuthorizedApp(c *gin.Context) {
+	service := ParametersFromContext[*oauth.RevokeAppService](c, oauth.RevokeAppParamCtx{})
+	if err := service.Revoke(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
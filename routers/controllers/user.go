@@ -76,6 +76,11 @@ func UserDeletePasskey(c *gin.Context) {
 func UserLoginValidation(c *gin.Context) {
 	service := ParametersFromContext[*user.UserLoginService](c, user.LoginParameterCtx{})
 	expectedUser, twoFaSession, err := service.Login(c)
+	loginOrChallenge(c, expectedUser, twoFaSession, err)
+}
+
+// loginOrChallenge 登录校验通过且无需二步验证时继续签发令牌，否则返回二步验证会话 ID
+func loginOrChallenge(c *gin.Context, expectedUser *ent.User, twoFaSession string, err error) {
 	if err != nil {
 		c.JSON(200, serializer.Err(c, err))
 		c.Abort()
@@ -93,6 +98,132 @@ func UserLoginValidation(c *gin.Context) {
 	c.Abort()
 }
 
+// UserPrepareSSO 获取外部身份提供方登录地址
+func UserPrepareSSO(c *gin.Context) {
+	service := ParametersFromContext[*user.SSOProviderService](c, user.SSOProviderParamCtx{})
+	res, err := service.Prepare(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserCASLoginValidation 校验 CAS 票据
+func UserCASLoginValidation(c *gin.Context) {
+	service := ParametersFromContext[*user.CASLoginService](c, user.CASLoginParameterCtx{})
+	expectedUser, twoFaSession, err := service.Login(c)
+	loginOrChallenge(c, expectedUser, twoFaSession, err)
+}
+
+// UserOIDCLoginValidation 校验 OpenID Connect 授权码
+func UserOIDCLoginValidation(c *gin.Context) {
+	service := ParametersFromContext[*user.OIDCLoginService](c, user.OIDCLoginParameterCtx{})
+	expectedUser, twoFaSession, err := service.Login(c)
+	loginOrChallenge(c, expectedUser, twoFaSession, err)
+}
+
+// UserListSSOIdentities 列出已关联的外部账号
+func UserListSSOIdentities(c *gin.Context) {
+	res, err := user.ListSSOIdentities(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserPrepareLinkSSO 获取关联外部账号的登录地址
+func UserPrepareLinkSSO(c *gin.Context) {
+	service := ParametersFromContext[*user.SSOProviderService](c, user.SSOProviderParamCtx{})
+	res, err := service.PrepareLink(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserUnlinkSSO 解除关联外部账号
+func UserUnlinkSSO(c *gin.Context) {
+	service := ParametersFromContext[*user.SSOProviderService](c, user.SSOProviderParamCtx{})
+	if err := service.Unlink(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserListLoginSessions 列出已登录的设备
+func UserListLoginSessions(c *gin.Context) {
+	res, err := user.ListLoginSessions(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserRevokeLoginSession 注销指定设备
+func UserRevokeLoginSession(c *gin.Context) {
+	service := ParametersFromContext[*user.RevokeLoginSessionService](c, user.RevokeLoginSessionParamCtx{})
+	if err := service.Revoke(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserRevokeOtherLoginSessions 注销除当前设备外的所有设备
+func UserRevokeOtherLoginSessions(c *gin.Context) {
+	if err := user.RevokeOtherLoginSessions(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserListPersonalAccessTokens 列出个人访问令牌
+func UserListPersonalAccessTokens(c *gin.Context) {
+	res, err := user.ListPersonalAccessTokens(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserCreatePersonalAccessToken 创建个人访问令牌
+func UserCreatePersonalAccessToken(c *gin.Context) {
+	service := ParametersFromContext[*user.CreatePersonalAccessTokenService](c, user.CreatePersonalAccessTokenParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserRevokePersonalAccessToken 撤销个人访问令牌
+func UserRevokePersonalAccessToken(c *gin.Context) {
+	service := ParametersFromContext[*user.RevokePersonalAccessTokenService](c, user.RevokePersonalAccessTokenParamCtx{})
+	if err := service.Revoke(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // UserLogin2FAValidation validates user OTP code
 func UserLogin2FAValidation(c *gin.Context) {
 	service := ParametersFromContext[*user.OtpValidationService](c, user.OtpValidationParameterCtx{})
@@ -151,15 +282,8 @@ func SendSMSCode(c *gin.Context) {
 // UserSMSLoginValidation 手机号+验证码登录验证
 func UserSMSLoginValidation(c *gin.Context) {
 	service := ParametersFromContext[*user.SMSLoginService](c, user.SMSLoginParameterCtx{})
-	expectedUser, err := service.Login(c)
-	if err != nil {
-		c.JSON(200, serializer.Err(c, err))
-		c.Abort()
-		return
-	}
-
-	util.WithValue(c, inventory.UserCtx{}, expectedUser)
-	c.Next()
+	expectedUser, twoFaSession, err := service.Login(c)
+	loginOrChallenge(c, expectedUser, twoFaSession, err)
 }
 
 // UserSendReset 发送密码重设邮件
@@ -185,6 +309,30 @@ func UserReset(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// UserResetBySMS 通过手机验证码重设密码
+func UserResetBySMS(c *gin.Context) {
+	service := ParametersFromContext[*user.UserResetSMSService](c, user.UserResetSMSParameterCtx{})
+	res, err := service.Reset(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserChangePhone 更换绑定手机号
+func UserChangePhone(c *gin.Context) {
+	service := ParametersFromContext[*user.ChangePhoneService](c, user.ChangePhoneParamsCtx{})
+	if err := service.Change(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // UserActivate 用户激活
 func UserActivate(c *gin.Context) {
 	c.JSON(200, user.ActivateUser(c))
@@ -246,6 +394,20 @@ func UserStorage(c *gin.Context) {
 	})
 }
 
+// UserCourses 获取当前用户加入的课程空间
+func UserCourses(c *gin.Context) {
+	res, err := user.ListMyCourses(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: res,
+	})
+}
+
 // UserSetting 获取用户设定
 func UserSetting(c *gin.Context) {
 	res, err := user.GetUserSettings(c)
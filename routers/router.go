@@ -17,8 +17,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/routers/controllers"
 	adminsvc "github.com/cloudreve/Cloudreve/v4/service/admin"
 	"github.com/cloudreve/Cloudreve/v4/service/basic"
+	coursesvc "github.com/cloudreve/Cloudreve/v4/service/course"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
 	"github.com/cloudreve/Cloudreve/v4/service/node"
+	oauthsvc "github.com/cloudreve/Cloudreve/v4/service/oauth"
 	"github.com/cloudreve/Cloudreve/v4/service/setting"
 	sharesvc "github.com/cloudreve/Cloudreve/v4/service/share"
 	usersvc "github.com/cloudreve/Cloudreve/v4/service/user"
@@ -298,6 +300,18 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.UserSMSLoginValidation,
 					controllers.UserIssueToken,
 				)
+				// CAS 统一身份认证登录
+				token.POST("cas",
+					controllers.FromJSON[usersvc.CASLoginService](usersvc.CASLoginParameterCtx{}),
+					controllers.UserCASLoginValidation,
+					controllers.UserIssueToken,
+				)
+				// OpenID Connect 登录
+				token.POST("oidc",
+					controllers.FromJSON[usersvc.OIDCLoginService](usersvc.OIDCLoginParameterCtx{}),
+					controllers.UserOIDCLoginValidation,
+					controllers.UserIssueToken,
+				)
 				// 2-factor authentication
 				token.POST("2fa",
 					controllers.FromJSON[usersvc.OtpValidationService](usersvc.OtpValidationParameterCtx{}),
@@ -314,6 +328,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				)
 			}
 
+			// 获取外部身份提供方登录地址
+			session.GET("sso/:id",
+				controllers.FromUri[usersvc.SSOProviderService](usersvc.SSOProviderParamCtx{}),
+				controllers.UserPrepareSSO,
+			)
+
 			// Prepare login
 			session.GET("prepare",
 				controllers.FromQuery[usersvc.PrepareLoginService](usersvc.PrepareLoginParameterCtx{}),
@@ -346,6 +366,9 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		{
 			// 发送短信验证码
 			user.POST("sms/send",
+				middleware.CaptchaRequired(func(c *gin.Context) bool {
+					return dep.SettingProvider().SMSCaptchaEnabled(c)
+				}),
 				controllers.FromJSON[usersvc.SendSMSCodeService](usersvc.SendSMSCodeParameterCtx{}),
 				controllers.SendSMSCode,
 			)
@@ -374,6 +397,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[usersvc.UserResetEmailService](usersvc.UserResetEmailParameterCtx{}),
 				controllers.UserSendReset,
 			)
+			// 通过手机验证码重设密码
+			user.POST("reset/sms",
+				controllers.FromJSON[usersvc.UserResetSMSService](usersvc.UserResetSMSParameterCtx{}),
+				controllers.UserResetBySMS,
+			)
 			// 邮件激活 Done
 			user.GET("activate/:id",
 				middleware.SignRequired(dep.GeneralAuth()),
@@ -394,6 +422,60 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromQuery[sharesvc.ListShareService](sharesvc.ListShareParamCtx{}),
 				controllers.ListPublicShare,
 			)
+			// 当前登录用户信息
+			user.GET("me",
+				middleware.RequireScope(types.TokenScopeUserRead),
+				middleware.AllowTwoFAEnrollment(),
+				middleware.AllowPasswordRotation(),
+				middleware.LoginRequired(),
+				controllers.UserMe,
+			)
+			// 存储信息
+			user.GET("capacity",
+				middleware.RequireScope(types.TokenScopeUserRead),
+				middleware.LoginRequired(),
+				controllers.UserStorage,
+			)
+			// 开始注册 WebAuthn 凭证
+			user.PUT("authn",
+				middleware.AllowTwoFAEnrollment(),
+				middleware.LoginRequired(),
+				middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+					return dep.SettingProvider().AuthnEnabled(c)
+				}),
+				controllers.StartRegAuthn,
+			)
+			// 完成注册 WebAuthn 凭证
+			user.POST("authn",
+				middleware.AllowTwoFAEnrollment(),
+				middleware.LoginRequired(),
+				middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+					return dep.SettingProvider().AuthnEnabled(c)
+				}),
+				controllers.FromJSON[usersvc.FinishPasskeyRegisterService](usersvc.FinishPasskeyRegisterParameterCtx{}),
+				controllers.FinishRegAuthn,
+			)
+			// 获取当前用户设定
+			user.GET("setting",
+				middleware.AllowTwoFAEnrollment(),
+				middleware.AllowPasswordRotation(),
+				middleware.LoginRequired(),
+				controllers.UserSetting,
+			)
+			// 更改用户设定
+			user.PATCH("setting",
+				middleware.AllowTwoFAEnrollment(),
+				middleware.AllowPasswordRotation(),
+				middleware.LoginRequired(),
+				controllers.FromJSON[usersvc.PatchUserSetting](usersvc.PatchUserSettingParamsCtx{}),
+				controllers.UpdateOption,
+			)
+			// 获得二步验证初始化信息
+			user.GET("setting/2fa",
+				middleware.AllowTwoFAEnrollment(),
+				middleware.LoginRequired(),
+				controllers.UserInit2FA,
+			)
 		}
 
 		// 需要携带签名验证的
@@ -485,6 +567,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			// Google Drive related
 			gdrive := callback.Group("googledrive")
 			{
+				// 文件上传完成
+				gdrive.POST(
+					":sessionID/:key",
+					middleware.UseUploadSession(types.PolicyTypeGoogleDrive),
+					controllers.ProcessCallback(http.StatusOK, false),
+				)
 				// OAuth 完成
 				gdrive.GET(
 					"auth",
@@ -515,6 +603,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				middleware.UseUploadSession(types.PolicyTypeObs),
 				controllers.ProcessCallback(http.StatusBadRequest, false),
 			)
+			// Azure Blob 策略上传回调
+			callback.POST(
+				"azblob/:sessionID/:key",
+				middleware.UseUploadSession(types.PolicyTypeAzblob),
+				controllers.ProcessCallback(http.StatusBadRequest, false),
+			)
 			// Qiniu callback
 			callback.POST(
 				"qiniu/:sessionID/:key",
@@ -526,7 +620,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 
 		// Workflows
 		wf := v4.Group("workflow")
-		wf.Use(middleware.LoginRequired())
+		wf.Use(middleware.RequireScope(types.TokenScopeWorkflow), middleware.LoginRequired())
 		{
 			// List
 			wf.GET("",
@@ -548,6 +642,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[explorer.ArchiveWorkflowService](explorer.CreateArchiveParamCtx{}),
 				controllers.ExtractArchive,
 			)
+			// Create task to relocate files to another storage policy
+			wf.POST("relocate",
+				controllers.FromJSON[explorer.RelocateWorkflowService](explorer.CreateRelocateParamCtx{}),
+				middleware.ValidateBatchFileCount(dep, explorer.CreateRelocateParamCtx{}),
+				controllers.RelocateFiles,
+			)
 
 			remoteDownload := wf.Group("download")
 			{
@@ -574,30 +674,36 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		{
 			// List files
 			file.GET("",
+				middleware.RequireScope(types.TokenScopeFilesRead),
 				controllers.FromQuery[explorer.ListFileService](explorer.ListFileParameterCtx{}),
 				controllers.ListDirectory,
 			)
 			file.GET("archive",
+				middleware.RequireScope(types.TokenScopeFilesRead),
 				controllers.FromQuery[explorer.ArchiveListFilesService](explorer.ArchiveListFilesParamCtx{}),
 				controllers.ListArchiveFiles,
 			)
 			// Create file
 			file.POST("create",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.CreateFileService](explorer.CreateFileParameterCtx{}),
 				controllers.CreateFile,
 			)
 			// Rename file
 			file.POST("rename",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.RenameFileService](explorer.RenameFileParameterCtx{}),
 				controllers.RenameFile,
 			)
 			// Move or copy files
 			file.POST("move",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.MoveFileService](explorer.MoveFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.MoveFileParameterCtx{}),
 				controllers.MoveFile)
 			// Get URL of the file for preview/download
 			file.POST("url",
+				middleware.RequireScope(types.TokenScopeFilesRead),
 				middleware.ContextHint(),
 				controllers.FromJSON[explorer.FileURLService](explorer.FileURLParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.FileURLParameterCtx{}),
@@ -605,6 +711,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			)
 			// Update file content
 			file.PUT("content",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromQuery[explorer.FileUpdateService](explorer.FileUpdateParameterCtx{}),
 				controllers.PutContent)
 			// Get entity content for preview/download
@@ -631,12 +738,14 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			}
 			// get thumb
 			file.GET("thumb",
+				middleware.RequireScope(types.TokenScopeFilesRead),
 				middleware.ContextHint(),
 				controllers.FromQuery[explorer.FileThumbService](explorer.FileThumbParameterCtx{}),
 				controllers.Thumb,
 			)
 			// Delete files
 			file.DELETE("",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.DeleteFileService](explorer.DeleteFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.DeleteFileParameterCtx{}),
 				controllers.Delete,
@@ -648,12 +757,14 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			)
 			// Restore files
 			file.POST("restore",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.DeleteFileService](explorer.DeleteFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.DeleteFileParameterCtx{}),
 				controllers.Restore,
 			)
 			// Patch metadata
 			file.PATCH("metadata",
+				middleware.RequireScope(types.TokenScopeFilesWrite),
 				controllers.FromJSON[explorer.PatchMetadataService](explorer.PatchMetadataParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.PatchMetadataParameterCtx{}),
 				controllers.PatchMetadata,
@@ -663,15 +774,18 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			{
 				// Create upload session
 				upload.PUT("",
+					middleware.RequireScope(types.TokenScopeFilesWrite),
 					controllers.FromJSON[explorer.CreateUploadSessionService](explorer.CreateUploadSessionParameterCtx{}),
 					controllers.CreateUploadSession,
 				)
 				// Upload file data
 				upload.POST(":sessionId/:index",
+					middleware.RequireScope(types.TokenScopeFilesWrite),
 					controllers.FromUri[explorer.UploadService](explorer.UploadParameterCtx{}),
 					controllers.FileUpload,
 				)
 				upload.DELETE("",
+					middleware.RequireScope(types.TokenScopeFilesWrite),
 					controllers.FromJSON[explorer.DeleteUploadSessionService](explorer.DeleteUploadSessionParameterCtx{}),
 					controllers.DeleteUploadSession,
 				)
@@ -692,6 +806,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			}
 			// Get file info
 			file.GET("info",
+				middleware.RequireScope(types.TokenScopeFilesRead),
 				controllers.FromQuery[explorer.GetFileInfoService](explorer.GetFileInfoParameterCtx{}),
 				controllers.GetFileInfo,
 			)
@@ -700,11 +815,13 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			{
 				// Set current version
 				version.POST("current",
+					middleware.RequireScope(types.TokenScopeFilesWrite),
 					controllers.FromJSON[explorer.SetCurrentVersionService](explorer.SetCurrentVersionParamCtx{}),
 					controllers.SetCurrentVersion,
 				)
 				// Delete a version from a file
 				version.DELETE("",
+					middleware.RequireScope(types.TokenScopeFilesWrite),
 					controllers.FromJSON[explorer.DeleteVersionService](explorer.DeleteVersionParamCtx{}),
 					controllers.DeleteVersion,
 				)
@@ -745,12 +862,14 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		{
 			// Create share link
 			share.PUT("",
+				middleware.RequireScope(types.TokenScopeSharesManage),
 				middleware.LoginRequired(),
 				controllers.FromJSON[sharesvc.ShareCreateService](sharesvc.ShareCreateParamCtx{}),
 				controllers.CreateShare,
 			)
 			// Edit existing share link
 			share.POST(":id",
+				middleware.RequireScope(types.TokenScopeSharesManage),
 				middleware.LoginRequired(),
 				middleware.HashID(hashid.ShareID),
 				controllers.FromJSON[sharesvc.ShareCreateService](sharesvc.ShareCreateParamCtx{}),
@@ -764,12 +883,14 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			)
 			// List my shares
 			share.GET("",
+				middleware.RequireScope(types.TokenScopeSharesManage),
 				middleware.LoginRequired(),
 				controllers.FromQuery[sharesvc.ListShareService](sharesvc.ListShareParamCtx{}),
 				controllers.ListShare,
 			)
 			// 删除分享
 			share.DELETE(":id",
+				middleware.RequireScope(types.TokenScopeSharesManage),
 				middleware.LoginRequired(),
 				middleware.HashID(hashid.ShareID),
 				controllers.DeleteShare,
@@ -786,6 +907,100 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			//)
 		}
 
+		// OAuth2 授权服务
+		oauth := v4.Group("oauth")
+		{
+			// 获取授权确认页信息
+			oauth.GET("authorize",
+				middleware.LoginRequired(),
+				controllers.FromQuery[oauthsvc.AuthorizeService](oauthsvc.AuthorizeParamCtx{}),
+				controllers.OAuthAuthorizeInfo,
+			)
+			// 同意或拒绝授权
+			oauth.POST("authorize",
+				middleware.LoginRequired(),
+				controllers.FromJSON[oauthsvc.ApproveAuthorizeService](oauthsvc.ApproveAuthorizeParamCtx{}),
+				controllers.OAuthApprove,
+			)
+			// 令牌端点
+			oauth.POST("token", controllers.OAuthToken)
+			// 令牌内省端点
+			oauth.POST("introspect", controllers.OAuthIntrospect)
+		}
+
+		// 管理员只读接口，可使用具备 admin:read 权限的令牌访问
+		adminRead := v4.Group("admin",
+			middleware.RequireScope(types.TokenScopeAdminRead),
+			middleware.LoginRequired(),
+			middleware.IsAdmin(),
+		)
+		{
+			adminRead.GET("summary",
+				controllers.FromQuery[adminsvc.SummaryService](adminsvc.SummaryParamCtx{}),
+				controllers.AdminSummary,
+			)
+			// 列出用户组
+			adminRead.POST("group",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListGroups,
+			)
+			// 获取用户组
+			adminRead.GET("group/:id",
+				controllers.FromUri[adminsvc.SingleGroupService](adminsvc.SingleGroupParamCtx{}),
+				controllers.AdminGetGroup,
+			)
+			// List tasks
+			adminRead.POST("queue",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListTasks,
+			)
+			// Get task
+			adminRead.GET("queue/:id",
+				controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
+				controllers.AdminGetTask,
+			)
+			// 列出用户
+			adminRead.POST("user",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListUsers,
+			)
+			// 获取用户
+			adminRead.GET("user/:id",
+				controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+				controllers.AdminGetUser,
+			)
+			// 列出文件
+			adminRead.POST("file",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListFiles,
+			)
+			// 获取文件
+			adminRead.GET("file/:id",
+				controllers.FromUri[adminsvc.SingleFileService](adminsvc.SingleFileParamCtx{}),
+				controllers.AdminGetFile,
+			)
+			// List shares
+			adminRead.POST("share",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListShares,
+			)
+			// Get share
+			adminRead.GET("share/:id",
+				controllers.FromUri[adminsvc.SingleShareService](adminsvc.SingleShareParamCtx{}),
+				controllers.AdminGetShare,
+			)
+			// 列出审计日志
+			adminRead.POST("audit",
+				controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+				controllers.AdminListAuditLogs,
+			)
+			// 导出审计日志为 CSV
+			adminRead.POST("audit/export",
+				controllers.FromJSON[adminsvc.ExportAuditLogService](adminsvc.ExportAuditLogParamCtx{}),
+				controllers.AdminExportAuditLogs,
+			)
+		}
+
 		// 需要登录保护的
 		auth := v4.Group("")
 		auth.Use(middleware.LoginRequired())
@@ -793,11 +1008,6 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			// 管理
 			admin := auth.Group("admin", middleware.IsAdmin())
 			{
-				admin.GET("summary",
-					controllers.FromQuery[adminsvc.SummaryService](adminsvc.SummaryParamCtx{}),
-					controllers.AdminSummary,
-				)
-
 				settings := admin.Group("settings")
 				{
 					// Get settings
@@ -815,16 +1025,6 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				// 用户组管理
 				group := admin.Group("group")
 				{
-					// 列出用户组
-					group.POST("",
-						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
-						controllers.AdminListGroups,
-					)
-					// 获取用户组
-					group.GET(":id",
-						controllers.FromUri[adminsvc.SingleGroupService](adminsvc.SingleGroupParamCtx{}),
-						controllers.AdminGetGroup,
-					)
 					// 创建用户组
 					group.PUT("",
 						controllers.FromJSON[adminsvc.UpsertGroupService](adminsvc.UpsertGroupParamCtx{}),
@@ -855,6 +1055,39 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.TestSMTPService](adminsvc.TestSMTPParamCtx{}),
 						controllers.AdminSendTestMail,
 					)
+					tool.POST("sms",
+						controllers.FromJSON[adminsvc.TestSMSService](adminsvc.TestSMSParamCtx{}),
+						controllers.AdminSendTestSMS,
+					)
+					// 列出被限制接收验证码的手机号
+					tool.GET("sms/blocked",
+						controllers.AdminListBlockedPhones,
+					)
+					// 解除手机号限制
+					tool.POST("sms/blocked/clear",
+						controllers.FromJSON[adminsvc.UnblockPhonesService](adminsvc.UnblockPhonesParamCtx{}),
+						controllers.AdminUnblockPhones,
+					)
+					// 列出因登录失败被锁定的账号与 IP
+					tool.GET("lockout",
+						controllers.AdminListLockedLogins,
+					)
+					// 解除登录锁定
+					tool.POST("lockout/clear",
+						controllers.FromJSON[adminsvc.UnlockLoginsService](adminsvc.UnlockLoginsParamCtx{}),
+						controllers.AdminUnlockLogins,
+					)
+					// 泄露密码库
+					tool.GET("breached_passwords", controllers.AdminBreachedPasswordStats)
+					// 上传泄露密码库，替换已上传的列表
+					tool.PUT("breached_passwords", controllers.AdminImportBreachedPasswords)
+					// 删除已上传的泄露密码库
+					tool.DELETE("breached_passwords", controllers.AdminClearBreachedPasswords)
+					// 测试 LDAP 连接与登录
+					tool.POST("ldap",
+						controllers.FromJSON[adminsvc.TestLDAPService](adminsvc.TestLDAPParamCtx{}),
+						controllers.AdminTestLDAP,
+					)
 					tool.DELETE("entityUrlCache",
 						controllers.AdminClearEntityUrlCache,
 					)
@@ -863,16 +1096,6 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				queue := admin.Group("queue")
 				{
 					queue.GET("metrics", controllers.AdminGetQueueMetrics)
-					// List tasks
-					queue.POST("",
-						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
-						controllers.AdminListTasks,
-					)
-					// Get task
-					queue.GET(":id",
-						controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
-						controllers.AdminGetTask,
-					)
 					// Batch delete task
 					queue.POST("batch/delete",
 						controllers.FromJSON[adminsvc.BatchTaskService](adminsvc.BatchTaskParamCtx{}),
@@ -912,11 +1135,21 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.UpdateStoragePolicyService](adminsvc.UpdateStoragePolicyParamCtx{}),
 						controllers.AdminUpdatePolicy,
 					)
+					// 迁移存储策略中的所有文件
+					policy.POST(":id/relocate",
+						controllers.FromJSON[adminsvc.RelocatePolicyService](adminsvc.RelocatePolicyParamCtx{}),
+						controllers.AdminRelocatePolicy,
+					)
 					// 创建跨域策略
 					policy.POST("cors",
 						controllers.FromJSON[adminsvc.CreateStoragePolicyCorsService](adminsvc.CreateStoragePolicyCorsParamCtx{}),
 						controllers.AdminCreateStoragePolicyCors,
 					)
+					// 测试 SFTP 连接并获取服务器公钥
+					policy.POST("sftp/hostkey",
+						controllers.FromJSON[adminsvc.ScanSftpHostKeyService](adminsvc.ScanSftpHostKeyParamCtx{}),
+						controllers.AdminScanSftpHostKey,
+					)
 					// // 获取 OneDrive OAuth URL
 					oauth := policy.Group("oauth")
 					{
@@ -984,16 +1217,6 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 
 				user := admin.Group("user")
 				{
-					// 列出用户
-					user.POST("",
-						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
-						controllers.AdminListUsers,
-					)
-					// 获取用户
-					user.GET(":id",
-						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
-						controllers.AdminGetUser,
-					)
 					// 更新用户
 					user.PUT(":id",
 						controllers.FromJSON[adminsvc.UpsertUserService](adminsvc.UpsertUserParamCtx{}),
@@ -1004,6 +1227,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.UpsertUserService](adminsvc.UpsertUserParamCtx{}),
 						controllers.AdminCreateUser,
 					)
+					// 从花名册批量导入用户
+					user.POST("import",
+						controllers.FromQuery[adminsvc.ImportUserService](adminsvc.ImportUserParamCtx{}),
+						controllers.AdminImportUsers,
+					)
 					batch := user.Group("batch")
 					{
 						// 批量删除用户
@@ -1016,20 +1244,20 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
 						controllers.AdminCalibrateStorage,
 					)
+					// 强制用户退出所有设备
+					user.POST(":id/logout",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminLogoutUser,
+					)
+					// 重置用户二步验证
+					user.POST(":id/2fa/reset",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminResetUserTwoFA,
+					)
 				}
 
 				file := admin.Group("file")
 				{
-					// 列出文件
-					file.POST("",
-						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
-						controllers.AdminListFiles,
-					)
-					// 获取文件
-					file.GET(":id",
-						controllers.FromUri[adminsvc.SingleFileService](adminsvc.SingleFileParamCtx{}),
-						controllers.AdminGetFile,
-					)
 					// 更新文件
 					file.PUT(":id",
 						controllers.FromJSON[adminsvc.UpsertFileService](adminsvc.UpsertFileParamCtx{}),
@@ -1073,31 +1301,140 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 
 				share := admin.Group("share")
 				{
-					// List shares
-					share.POST("",
-						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
-						controllers.AdminListShares,
-					)
-					// Get share
-					share.GET(":id",
-						controllers.FromUri[adminsvc.SingleShareService](adminsvc.SingleShareParamCtx{}),
-						controllers.AdminGetShare,
-					)
 					// Batch delete shares
 					share.POST("batch/delete",
 						controllers.FromJSON[adminsvc.BatchShareService](adminsvc.BatchShareParamCtx{}),
 						controllers.AdminBatchDeleteShare,
 					)
 				}
+
+				// 第三方应用
+				oauthApp := admin.Group("oauth")
+				{
+					// 列出第三方应用
+					oauthApp.GET("", controllers.AdminListOAuthApps)
+					// 获取第三方应用
+					oauthApp.GET(":id",
+						controllers.FromUri[adminsvc.SingleOAuthAppService](adminsvc.SingleOAuthAppParamCtx{}),
+						controllers.AdminGetOAuthApp,
+					)
+					// 注册第三方应用
+					oauthApp.PUT("",
+						controllers.FromJSON[adminsvc.UpsertOAuthAppService](adminsvc.UpsertOAuthAppParamCtx{}),
+						controllers.AdminCreateOAuthApp,
+					)
+					// 更新第三方应用
+					oauthApp.PUT(":id",
+						controllers.FromJSON[adminsvc.UpsertOAuthAppService](adminsvc.UpsertOAuthAppParamCtx{}),
+						controllers.AdminUpdateOAuthApp,
+					)
+					// 删除第三方应用
+					oauthApp.DELETE(":id",
+						controllers.FromUri[adminsvc.SingleOAuthAppService](adminsvc.SingleOAuthAppParamCtx{}),
+						controllers.AdminDeleteOAuthApp,
+					)
+					// 重新生成客户端密钥
+					oauthApp.POST(":id/secret",
+						controllers.FromUri[adminsvc.SingleOAuthAppService](adminsvc.SingleOAuthAppParamCtx{}),
+						controllers.AdminResetOAuthAppSecret,
+					)
+				}
+
+				// 课程空间管理
+				course := admin.Group("course")
+				{
+					// 列出课程
+					course.POST("",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListCourses,
+					)
+					// 获取课程
+					course.GET(":id",
+						controllers.FromUri[adminsvc.SingleCourseService](adminsvc.SingleCourseParamCtx{}),
+						controllers.AdminGetCourse,
+					)
+					// 创建课程
+					course.PUT("",
+						controllers.FromJSON[adminsvc.UpsertCourseService](adminsvc.UpsertCourseParamCtx{}),
+						controllers.AdminCreateCourse,
+					)
+					// 更新课程
+					course.PUT(":id",
+						controllers.FromJSON[adminsvc.UpsertCourseService](adminsvc.UpsertCourseParamCtx{}),
+						controllers.AdminUpdateCourse,
+					)
+					// 删除课程
+					course.DELETE(":id",
+						controllers.FromUri[adminsvc.SingleCourseService](adminsvc.SingleCourseParamCtx{}),
+						controllers.AdminDeleteCourse,
+					)
+					// 添加成员或修改成员角色
+					course.PUT("member",
+						controllers.FromJSON[adminsvc.SetCourseMemberService](adminsvc.SetCourseMemberParamCtx{}),
+						controllers.AdminSetCourseMember,
+					)
+					// 移除成员
+					course.POST("member/delete",
+						controllers.FromJSON[adminsvc.RemoveCourseMemberService](adminsvc.RemoveCourseMemberParamCtx{}),
+						controllers.AdminRemoveCourseMember,
+					)
+				}
+
+				// 院校与专业目录
+				institution := admin.Group("institution")
+				{
+					// 列出院校及专业
+					institution.GET("", controllers.AdminListInstitutions)
+					// 创建院校
+					institution.PUT("",
+						controllers.FromJSON[adminsvc.UpsertInstitutionService](adminsvc.UpsertInstitutionParamCtx{}),
+						controllers.AdminCreateInstitution,
+					)
+					// 更新院校
+					institution.PUT(":id",
+						controllers.FromUri[adminsvc.SingleInstitutionService](adminsvc.SingleInstitutionParamCtx{}),
+						controllers.FromJSON[adminsvc.UpsertInstitutionService](adminsvc.UpsertInstitutionParamCtx{}),
+						controllers.AdminUpdateInstitution,
+					)
+					// 删除院校
+					institution.DELETE(":id",
+						controllers.FromUri[adminsvc.SingleInstitutionService](adminsvc.SingleInstitutionParamCtx{}),
+						controllers.AdminDeleteInstitution,
+					)
+					// 创建专业
+					institution.PUT("major",
+						controllers.FromJSON[adminsvc.UpsertMajorService](adminsvc.UpsertMajorParamCtx{}),
+						controllers.AdminCreateMajor,
+					)
+					// 更新专业
+					institution.PUT("major/:id",
+						controllers.FromUri[adminsvc.SingleMajorService](adminsvc.SingleMajorParamCtx{}),
+						controllers.FromJSON[adminsvc.UpsertMajorService](adminsvc.UpsertMajorParamCtx{}),
+						controllers.AdminUpdateMajor,
+					)
+					// 删除专业
+					institution.DELETE("major/:id",
+						controllers.FromUri[adminsvc.SingleMajorService](adminsvc.SingleMajorParamCtx{}),
+						controllers.AdminDeleteMajor,
+					)
+					// 将已有用户的院校、专业规范化
+					institution.POST("normalize",
+						controllers.FromJSON[adminsvc.NormalizeUsersService](adminsvc.NormalizeUsersParamCtx{}),
+						controllers.AdminNormalizeUsers,
+					)
+					// 将用户组与额外容量映射重新应用到已有用户
+					institution.POST("reapply",
+						controllers.FromJSON[adminsvc.ReapplyMappingService](adminsvc.ReapplyMappingParamCtx{}),
+						controllers.AdminReapplyMapping,
+					)
+				}
 			}
 
 			// 用户
 			user := auth.Group("user")
 			{
-				// 当前登录用户信息
-				user.GET("me", controllers.UserMe)
-				// 存储信息
-				user.GET("capacity", controllers.UserStorage)
+				// 加入的课程空间
+				user.GET("course", controllers.UserCourses)
 				// Search user by keywords
 				user.GET("search",
 					controllers.FromQuery[usersvc.SearchUserService](usersvc.SearchUserParamCtx{}),
@@ -1110,11 +1447,6 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						return dep.SettingProvider().AuthnEnabled(c)
 					}))
 				{
-					authn.PUT("", controllers.StartRegAuthn)
-					authn.POST("",
-						controllers.FromJSON[usersvc.FinishPasskeyRegisterService](usersvc.FinishPasskeyRegisterParameterCtx{}),
-						controllers.FinishRegAuthn,
-					)
 					authn.DELETE("",
 						controllers.FromQuery[usersvc.DeletePasskeyService](usersvc.DeletePasskeyParameterCtx{}),
 						controllers.UserDeletePasskey,
@@ -1124,20 +1456,102 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				// 用户设置
 				setting := user.Group("setting")
 				{
-					// 获取当前用户设定
-					setting.GET("", controllers.UserSetting)
 					// 从文件上传头像
 					setting.PUT("avatar", controllers.UploadAvatar)
-					// 更改用户设定
-					setting.PATCH("",
-						controllers.FromJSON[usersvc.PatchUserSetting](usersvc.PatchUserSettingParamsCtx{}),
-						controllers.UpdateOption,
+					// 外部账号关联
+					sso := setting.Group("sso")
+					{
+						// 列出已关联的外部账号
+						sso.GET("", controllers.UserListSSOIdentities)
+						// 获取关联外部账号的登录地址
+						sso.PUT(":id",
+							controllers.FromUri[usersvc.SSOProviderService](usersvc.SSOProviderParamCtx{}),
+							controllers.UserPrepareLinkSSO,
+						)
+						// 解除关联
+						sso.DELETE(":id",
+							controllers.FromUri[usersvc.SSOProviderService](usersvc.SSOProviderParamCtx{}),
+							controllers.UserUnlinkSSO,
+						)
+					}
+					// 已登录设备
+					sessions := setting.Group("sessions")
+					{
+						// 列出已登录设备
+						sessions.GET("", controllers.UserListLoginSessions)
+						// 注销除当前设备外的所有设备
+						sessions.DELETE("", controllers.UserRevokeOtherLoginSessions)
+						// 注销指定设备
+						sessions.DELETE(":id",
+							controllers.FromUri[usersvc.RevokeLoginSessionService](usersvc.RevokeLoginSessionParamCtx{}),
+							controllers.UserRevokeLoginSession,
+						)
+					}
+					// 已授权的第三方应用
+					authorizedApps := setting.Group("oauth")
+					{
+						// 列出已授权的第三方应用
+						authorizedApps.GET("", controllers.UserListAuthorizedApps)
+						// 撤销授权
+						authorizedApps.DELETE(":id",
+							controllers.FromUri[oauthsvc.RevokeAppService](oauthsvc.RevokeAppParamCtx{}),
+							controllers.UserRevokeAuthorizedApp,
+						)
+					}
+					// 个人访问令牌
+					tokens := setting.Group("tokens")
+					{
+						// 列出个人访问令牌
+						tokens.GET("", controllers.UserListPersonalAccessTokens)
+						// 创建个人访问令牌
+						tokens.PUT("",
+							controllers.FromJSON[usersvc.CreatePersonalAccessTokenService](usersvc.CreatePersonalAccessTokenParamCtx{}),
+							controllers.UserCreatePersonalAccessToken,
+						)
+						// 撤销个人访问令牌
+						tokens.DELETE(":id",
+							controllers.FromUri[usersvc.RevokePersonalAccessTokenService](usersvc.RevokePersonalAccessTokenParamCtx{}),
+							controllers.UserRevokePersonalAccessToken,
+						)
+					}
+					// 更换绑定手机号
+					setting.PUT("phone",
+						controllers.FromJSON[usersvc.ChangePhoneService](usersvc.ChangePhoneParamsCtx{}),
+						controllers.UserChangePhone,
 					)
-					// 获得二步验证初始化信息
-					setting.GET("2fa", controllers.UserInit2FA)
 				}
 			}
 
+			// 课程作业
+			course := auth.Group("course")
+			{
+				// 列出课程作业
+				course.GET(":id/assignment",
+					middleware.HashID(hashid.CourseID),
+					controllers.ListAssignments,
+				)
+				// 创建作业提交箱
+				course.PUT(":id/assignment",
+					middleware.HashID(hashid.CourseID),
+					controllers.FromJSON[coursesvc.AssignmentService](coursesvc.AssignmentParamCtx{}),
+					controllers.CreateAssignment,
+				)
+			}
+			assignment := auth.Group("assignment")
+			{
+				// 更新作业
+				assignment.PUT(":id",
+					middleware.HashID(hashid.AssignmentID),
+					controllers.FromJSON[coursesvc.AssignmentService](coursesvc.AssignmentParamCtx{}),
+					controllers.UpdateAssignment,
+				)
+				// 删除作业
+				assignment.DELETE(":id",
+					middleware.HashID(hashid.AssignmentID),
+					controllers.DeleteAssignment,
+				)
+			}
+
 			// WebDAV and devices
 			devices := auth.Group("devices")
 			{
@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	auditActionCondition = "audit_action"
+	auditUserIDCondition = "audit_user_id"
+	auditIPCondition     = "audit_ip"
+	auditTargetCondition = "audit_target"
+	auditStartCondition  = "audit_start"
+	auditEndCondition    = "audit_end"
+
+	auditExportPageSize = 1000
+	// auditExportMaxRows 单次导出的最大行数，避免一次性导出过多记录
+	auditExportMaxRows = 100000
+)
+
+func (s *AdminListService) AuditLogs(c *gin.Context) (*ListAuditLogResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	args, err := auditLogFilters(s.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	args.PaginationArgs = &inventory.PaginationArgs{
+		Page:     s.Page - 1,
+		PageSize: s.PageSize,
+		OrderBy:  s.OrderBy,
+		Order:    inventory.OrderDirection(s.OrderDirection),
+	}
+	res, err := dep.AuditLogClient().List(c, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list audit logs", err)
+	}
+
+	return &ListAuditLogResponse{
+		Pagination: res.PaginationResults,
+		Logs: lo.Map(res.Logs, func(log *ent.AuditLog, _ int) GetAuditLogResponse {
+			var uid string
+			if log.UserID != 0 {
+				uid = hashid.EncodeUserID(hasher, log.UserID)
+			}
+
+			return GetAuditLogResponse{
+				AuditLog:   log,
+				UserHashID: uid,
+			}
+		}),
+	}, nil
+}
+
+type (
+	ExportAuditLogService struct {
+		Conditions map[string]string `json:"conditions"`
+	}
+	ExportAuditLogParamCtx struct{}
+)
+
+// Export writes audit logs matching the conditions into response as CSV, newest first.
+func (s *ExportAuditLogService) Export(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	auditLogClient := dep.AuditLogClient()
+
+	args, err := auditLogFilters(s.Conditions)
+	if err != nil {
+		return err
+	}
+
+	args.PaginationArgs = &inventory.PaginationArgs{
+		PageSize: auditExportPageSize,
+		Order:    inventory.OrderDirectionDesc,
+	}
+
+	// 先查询第一页，出错时仍可返回 JSON 错误信息
+	res, err := auditLogClient.List(c, args)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list audit logs", err)
+	}
+
+	fileName := fmt.Sprintf("audit_logs_%s.csv", time.Now().Format("20060102150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+	c.Status(200)
+
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write([]string{"id", "created_at", "action", "user_id", "ip", "user_agent", "target", "props"}); err != nil {
+		return nil
+	}
+
+	written := 0
+	for {
+		for _, log := range res.Logs {
+			if written >= auditExportMaxRows {
+				break
+			}
+
+			props := ""
+			if len(log.Props) > 0 {
+				propsBytes, _ := json.Marshal(log.Props)
+				props = string(propsBytes)
+			}
+
+			if err := w.Write([]string{
+				strconv.Itoa(log.ID),
+				log.CreatedAt.Format(time.RFC3339),
+				log.Action,
+				strconv.Itoa(log.UserID),
+				log.IP,
+				log.UserAgent,
+				log.Target,
+				props,
+			}); err != nil {
+				// 客户端已断开
+				return nil
+			}
+			written++
+		}
+
+		if written >= auditExportMaxRows || len(res.Logs) < auditExportPageSize {
+			break
+		}
+
+		args.Page++
+		res, err = auditLogClient.List(c, args)
+		if err != nil {
+			// 响应头已发送，只能中止输出
+			dep.Logger().Warning("Failed to list audit logs for export: %s", err)
+			break
+		}
+	}
+
+	w.Flush()
+	return nil
+}
+
+func auditLogFilters(conditions map[string]string) (*inventory.ListAuditLogParameters, error) {
+	args := &inventory.ListAuditLogParameters{
+		Action: conditions[auditActionCondition],
+		IP:     conditions[auditIPCondition],
+		Target: conditions[auditTargetCondition],
+	}
+
+	if conditions[auditUserIDCondition] != "" {
+		userID, err := strconv.Atoi(conditions[auditUserIDCondition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid audit user ID", err)
+		}
+		args.UserID = userID
+	}
+
+	if conditions[auditStartCondition] != "" {
+		start, err := parseAuditTime(conditions[auditStartCondition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid start time", err)
+		}
+		args.Start = &start
+	}
+
+	if conditions[auditEndCondition] != "" {
+		end, err := parseAuditTime(conditions[auditEndCondition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid end time", err)
+		}
+		args.End = &end
+	}
+
+	return args, nil
+}
+
+// parseAuditTime accepts RFC3339 time or unix timestamp in seconds.
+func parseAuditTime(s string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(ts, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,350 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	courseNameCondition = "course_name"
+	courseCodeCondition = "course_code"
+)
+
+// Courses 列出课程
+func (s *AdminListService) Courses(c *gin.Context) (*ListCourseResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+	courseClient := dep.CourseClient()
+
+	ctx := context.WithValue(c, inventory.LoadCourseAccount{}, true)
+	res, err := courseClient.List(ctx, &inventory.ListCourseParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+			OrderBy:  s.OrderBy,
+			Order:    inventory.OrderDirection(s.OrderDirection),
+		},
+		Name: s.Conditions[courseNameCondition],
+		Code: s.Conditions[courseCodeCondition],
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list courses", err)
+	}
+
+	return &ListCourseResponse{
+		Pagination: res.PaginationResults,
+		Courses: lo.Map(res.Courses, func(course *ent.Course, _ int) GetCourseResponse {
+			return buildCourseResponse(course, hasher)
+		}),
+	}, nil
+}
+
+type (
+	SingleCourseService struct {
+		ID int `uri:"id" json:"id" binding:"required"`
+	}
+	SingleCourseParamCtx struct{}
+)
+
+// Get 获取课程详情及成员
+func (s *SingleCourseService) Get(c *gin.Context) (*GetCourseResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+	courseClient := dep.CourseClient()
+
+	ctx := context.WithValue(c, inventory.LoadCourseAccount{}, true)
+	course, err := courseClient.GetByID(ctx, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCourseNotFound, "Course not found", err)
+	}
+
+	ctx = context.WithValue(ctx, inventory.LoadCourseMemberUser{}, true)
+	members, err := courseClient.ListMembers(ctx, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list course members", err)
+	}
+
+	res := buildCourseResponse(course, hasher)
+	res.Members = lo.Map(members, func(m *ent.CourseMember, _ int) CourseMemberResponse {
+		return buildCourseMemberResponse(m, hasher)
+	})
+	return &res, nil
+}
+
+// Delete 删除课程，课程账号及其文件一并删除
+func (s *SingleCourseService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	courseClient := dep.CourseClient()
+
+	course, err := courseClient.GetByID(c, s.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeCourseNotFound, "Course not found", err)
+	}
+
+	cc, tx, ctx, err := inventory.WithTx(c, courseClient)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	fc, _, ctx, err := inventory.WithTx(ctx, dep.FileClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	uc, _, ctx, err := inventory.WithTx(ctx, dep.UserClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if err := cc.Delete(ctx, course.ID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete course", err)
+	}
+
+	if err := fc.DeleteByUser(ctx, course.AccountID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete course files", err)
+	}
+
+	if err := uc.Delete(ctx, course.AccountID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete course account", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+type (
+	UpsertCourseService struct {
+		Course *ent.Course `json:"course" binding:"required"`
+		// GroupID 课程账号所属用户组，决定课程文件使用的存储策略
+		GroupID int `json:"group_id"`
+	}
+	UpsertCourseParamCtx struct{}
+)
+
+// Create 创建课程，同时创建课程账号和根目录
+func (s *UpsertCourseService) Create(c *gin.Context) (*GetCourseResponse, error) {
+	dep := dependency.FromContext(c)
+	courseClient := dep.CourseClient()
+
+	if s.Course.ID > 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := courseClient.GetByCode(c, s.Course.Code); err == nil {
+		return nil, serializer.NewError(serializer.CodeCourseCodeExisted, "Course code already exists", nil)
+	}
+
+	groupID := s.GroupID
+	if groupID == 0 {
+		groupID = dep.SettingProvider().DefaultGroup(c)
+	}
+
+	uc, tx, ctx, err := inventory.WithTx(c, dep.UserClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	// 课程账号不可登录，仅用于持有课程文件和统计用量
+	account, err := uc.Create(ctx, &inventory.NewUserArgs{
+		Nick:    s.Course.Name,
+		Status:  user.StatusSysBanned,
+		GroupID: groupID,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create course account", err)
+	}
+
+	fc, _, ctx, err := inventory.WithTx(ctx, dep.FileClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if _, err := fc.CreateFolder(ctx, nil, &inventory.CreateFolderParameters{
+		Owner: account.ID,
+		Name:  inventory.RootFolderName,
+	}); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create course root folder", err)
+	}
+
+	cc, _, ctx, err := inventory.WithTx(ctx, courseClient)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	course, err := cc.Create(ctx, &inventory.CreateCourseParameters{
+		Name:        s.Course.Name,
+		Code:        s.Course.Code,
+		Description: s.Course.Description,
+		MaxStorage:  s.Course.MaxStorage,
+		AccountID:   account.ID,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create course", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	service := &SingleCourseService{ID: course.ID}
+	return service.Get(c)
+}
+
+// Update 更新课程信息及配额
+func (s *UpsertCourseService) Update(c *gin.Context) (*GetCourseResponse, error) {
+	dep := dependency.FromContext(c)
+	courseClient := dep.CourseClient()
+
+	if s.Course.ID == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	if existed, err := courseClient.GetByCode(c, s.Course.Code); err == nil && existed.ID != s.Course.ID {
+		return nil, serializer.NewError(serializer.CodeCourseCodeExisted, "Course code already exists", nil)
+	}
+
+	if _, err := courseClient.Update(c, s.Course.ID, &inventory.CreateCourseParameters{
+		Name:        s.Course.Name,
+		Code:        s.Course.Code,
+		Description: s.Course.Description,
+		MaxStorage:  s.Course.MaxStorage,
+	}); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update course", err)
+	}
+
+	service := &SingleCourseService{ID: s.Course.ID}
+	return service.Get(c)
+}
+
+func (s *UpsertCourseService) validate() error {
+	if s.Course.Name == "" || s.Course.Code == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Course name and code are required", nil)
+	}
+
+	if s.Course.MaxStorage < 0 {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid course quota", nil)
+	}
+
+	return nil
+}
+
+type (
+	SetCourseMemberService struct {
+		ID      int    `json:"id" binding:"required"`
+		UserIDs []int  `json:"user_ids" binding:"min=1"`
+		Role    string `json:"role" binding:"required,oneof=teacher ta student"`
+	}
+	SetCourseMemberParamCtx struct{}
+)
+
+// Set 添加课程成员或修改其角色
+func (s *SetCourseMemberService) Set(c *gin.Context) (*GetCourseResponse, error) {
+	dep := dependency.FromContext(c)
+	courseClient := dep.CourseClient()
+	userClient := dep.UserClient()
+
+	course, err := courseClient.GetByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCourseNotFound, "Course not found", err)
+	}
+
+	ae := serializer.NewAggregateError()
+	for _, uid := range s.UserIDs {
+		target, err := userClient.GetByID(c, uid)
+		if err != nil || target.ID == course.AccountID {
+			ae.Add(strconv.Itoa(uid), serializer.NewError(serializer.CodeUserNotFound, "", err))
+			continue
+		}
+
+		if _, err := courseClient.SetMember(c, course.ID, target.ID, coursemember.Role(s.Role)); err != nil {
+			ae.Add(strconv.Itoa(uid), serializer.NewError(serializer.CodeDBError, "Failed to set course member", err))
+		}
+	}
+
+	if err := ae.Aggregate(); err != nil {
+		return nil, err
+	}
+
+	service := &SingleCourseService{ID: course.ID}
+	return service.Get(c)
+}
+
+type (
+	RemoveCourseMemberService struct {
+		ID      int   `json:"id" binding:"required"`
+		UserIDs []int `json:"user_ids" binding:"min=1"`
+	}
+	RemoveCourseMemberParamCtx struct{}
+)
+
+// Remove 移除课程成员
+func (s *RemoveCourseMemberService) Remove(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	courseClient := dep.CourseClient()
+
+	ae := serializer.NewAggregateError()
+	for _, uid := range s.UserIDs {
+		if err := courseClient.RemoveMember(c, s.ID, uid); err != nil {
+			ae.Add(strconv.Itoa(uid), serializer.NewError(serializer.CodeDBError, "Failed to remove course member", err))
+		}
+	}
+
+	return ae.Aggregate()
+}
+
+func buildCourseResponse(course *ent.Course, hasher hashid.Encoder) GetCourseResponse {
+	hashID := hashid.EncodeCourseID(hasher, course.ID)
+	res := GetCourseResponse{
+		Course: course,
+		HashID: hashID,
+		Uri:    fs.NewCourseUri(hashID),
+	}
+	if account := course.Edges.Account; account != nil {
+		res.Used = account.Storage
+	}
+
+	return res
+}
+
+func buildCourseMemberResponse(m *ent.CourseMember, hasher hashid.Encoder) CourseMemberResponse {
+	res := CourseMemberResponse{
+		CourseMember: m,
+	}
+	if u := m.Edges.User; u != nil {
+		res.UserHashID = hashid.EncodeUserID(hasher, u.ID)
+	}
+
+	return res
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -165,6 +166,10 @@ func (s *UpsertGroupService) Update(c *gin.Context) (*GetGroupResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Initial admin group have to be admin", nil)
 	}
 
+	if err := validateGroupIPList(s.Group); err != nil {
+		return nil, err
+	}
+
 	group, err := groupClient.Upsert(c, s.Group)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update group", err)
@@ -182,6 +187,10 @@ func (s *UpsertGroupService) Create(c *gin.Context) (*GetGroupResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
 	}
 
+	if err := validateGroupIPList(s.Group); err != nil {
+		return nil, err
+	}
+
 	group, err := groupClient.Upsert(c, s.Group)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create group", err)
@@ -190,3 +199,18 @@ func (s *UpsertGroupService) Create(c *gin.Context) (*GetGroupResponse, error) {
 	service := &SingleGroupService{ID: group.ID}
 	return service.Get(c)
 }
+
+// validateGroupIPList 校验用户组网络限制中的 IP 与 CIDR 格式
+func validateGroupIPList(group *ent.Group) error {
+	if group.Settings == nil {
+		return nil
+	}
+
+	for _, list := range [][]string{group.Settings.IPAllowList, group.Settings.IPDenyList} {
+		if err := util.ValidateCIDRList(list); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, err.Error(), nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package admin
+
+import (
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/roster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// ListInstitutions 列出院校目录及其专业
+func ListInstitutions(c *gin.Context) ([]*ent.Institution, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.InstitutionClient().ListAll(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list institutions", err)
+	}
+
+	return res, nil
+}
+
+type (
+	UpsertInstitutionService struct {
+		Institution *ent.Institution `json:"institution" binding:"required"`
+	}
+	UpsertInstitutionParamCtx struct{}
+)
+
+// Create 创建院校
+func (s *UpsertInstitutionService) Create(c *gin.Context) (*ent.Institution, error) {
+	if s.Institution.ID > 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	return s.save(c)
+}
+
+// Update 更新院校名称与别名，院校 ID 以请求路径为准
+func (s *UpsertInstitutionService) Update(c *gin.Context, id int) (*ent.Institution, error) {
+	s.Institution.ID = id
+	if _, err := dependency.FromContext(c).InstitutionClient().GetByID(c, s.Institution.ID); err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Institution not found", err)
+	}
+
+	return s.save(c)
+}
+
+func (s *UpsertInstitutionService) save(c *gin.Context) (*ent.Institution, error) {
+	s.Institution.Name = strings.TrimSpace(s.Institution.Name)
+	if s.Institution.Name == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Name is required", nil)
+	}
+	s.Institution.Aliases = cleanAliases(s.Institution.Name, s.Institution.Aliases)
+
+	if err := validateMappedGroup(c, s.Institution.GroupID, s.Institution.ExtraStorage); err != nil {
+		return nil, err
+	}
+
+	institutionClient := dependency.FromContext(c).InstitutionClient()
+	if err := checkAliasConflict(c, institutionClient, s.Institution); err != nil {
+		return nil, err
+	}
+
+	res, err := institutionClient.Upsert(c, s.Institution)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeInstitutionExisted, "Institution already exists", err)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save institution", err)
+	}
+
+	return institutionClient.GetByID(c, res.ID)
+}
+
+type (
+	SingleInstitutionService struct {
+		ID int `uri:"id" json:"id" binding:"required"`
+	}
+	SingleInstitutionParamCtx struct{}
+)
+
+// Delete 删除院校及其专业，已有用户的院校字段保持不变
+func (s *SingleInstitutionService) Delete(c *gin.Context) error {
+	institutionClient := dependency.FromContext(c).InstitutionClient()
+	if _, err := institutionClient.GetByID(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Institution not found", err)
+	}
+
+	ic, tx, ctx, err := inventory.WithTx(c, institutionClient)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if err := ic.Delete(ctx, s.ID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete institution", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+type (
+	SingleMajorService struct {
+		ID int `uri:"id" json:"id" binding:"required"`
+	}
+	SingleMajorParamCtx struct{}
+)
+
+// Delete 删除专业
+func (s *SingleMajorService) Delete(c *gin.Context) error {
+	if err := dependency.FromContext(c).InstitutionClient().DeleteMajor(c, s.ID); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Major not found", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete major", err)
+	}
+
+	return nil
+}
+
+type (
+	UpsertMajorService struct {
+		Major *ent.Major `json:"major" binding:"required"`
+	}
+	UpsertMajorParamCtx struct{}
+)
+
+// Create 创建专业
+func (s *UpsertMajorService) Create(c *gin.Context) (*ent.Institution, error) {
+	if s.Major.ID > 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	return s.save(c)
+}
+
+// Update 更新专业，专业 ID 以请求路径为准
+func (s *UpsertMajorService) Update(c *gin.Context, id int) (*ent.Institution, error) {
+	s.Major.ID = id
+	return s.save(c)
+}
+
+func (s *UpsertMajorService) save(c *gin.Context) (*ent.Institution, error) {
+	institutionClient := dependency.FromContext(c).InstitutionClient()
+
+	s.Major.Name = strings.TrimSpace(s.Major.Name)
+	if s.Major.Name == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Name is required", nil)
+	}
+	s.Major.Aliases = cleanAliases(s.Major.Name, s.Major.Aliases)
+
+	if err := validateMappedGroup(c, s.Major.GroupID, s.Major.ExtraStorage); err != nil {
+		return nil, err
+	}
+
+	inst, err := institutionClient.GetByID(c, s.Major.InstitutionID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Institution not found", err)
+	}
+
+	// 同一院校下专业名称与别名不能重复，否则无法确定映射目标
+	for _, m := range inst.Edges.Majors {
+		if m.ID == s.Major.ID {
+			continue
+		}
+		for _, name := range append([]string{s.Major.Name}, s.Major.Aliases...) {
+			if inventory.DirectoryMatch(name, m.Name, m.Aliases) {
+				return nil, serializer.NewError(serializer.CodeInstitutionExisted, "Major name or alias conflicts with "+m.Name, nil)
+			}
+		}
+	}
+
+	if _, err := institutionClient.UpsertMajor(c, s.Major); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Major not found", err)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeInstitutionExisted, "Major already exists", err)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save major", err)
+	}
+
+	return institutionClient.GetByID(c, inst.ID)
+}
+
+type (
+	NormalizeUsersService struct {
+		DryRun bool `json:"dry_run"`
+	}
+	NormalizeUsersParamCtx struct{}
+)
+
+// Normalize 将已有用户的院校、专业映射为目录中的规范名称
+func (s *NormalizeUsersService) Normalize(c *gin.Context) (*inventory.NormalizeUsersResult, error) {
+	res, err := dependency.FromContext(c).InstitutionClient().NormalizeUsers(c, s.DryRun)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to normalize users", err)
+	}
+
+	return res, nil
+}
+
+type (
+	ReapplyMappingService struct {
+		InstitutionID int `json:"institution_id" binding:"required"`
+		// MajorID 为 0 时应用到院校全部用户
+		MajorID int `json:"major_id"`
+	}
+	ReapplyMappingParamCtx struct{}
+)
+
+// Reapply 创建后台任务，将院校或专业的用户组、额外容量映射应用到已有用户
+func (s *ReapplyMappingService) Reapply(c *gin.Context) (*GetTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	inst, err := dep.InstitutionClient().GetByID(c, s.InstitutionID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Institution not found", err)
+	}
+
+	majorName := ""
+	if s.MajorID != 0 {
+		m, found := lo.Find(inst.Edges.Majors, func(m *ent.Major) bool {
+			return m.ID == s.MajorID
+		})
+		if !found {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Major not found", nil)
+		}
+		majorName = m.Name
+	}
+
+	t, err := roster.NewReapplyMappingTask(c, inventory.UserFromContext(c), inst.Name, majorName)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return &GetTaskResponse{
+		Task:       t.Model(),
+		Summary:    t.Summarize(hasher),
+		TaskHashID: hashid.EncodeTaskID(hasher, t.ID()),
+	}, nil
+}
+
+// validateMappedGroup 映射的用户组必须存在，且不能是管理员组或匿名用户组
+func validateMappedGroup(c *gin.Context, groupID int, extraStorage int64) error {
+	if extraStorage < 0 {
+		return serializer.NewError(serializer.CodeParamErr, "Extra storage cannot be negative", nil)
+	}
+
+	if groupID == 0 {
+		return nil
+	}
+
+	g, err := dependency.FromContext(c).GroupClient().GetByID(c, groupID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeGroupNotFound, "Group not found", err)
+	}
+
+	if g.ID == inventory.AnonymousGroupID || g.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return serializer.NewError(serializer.CodeParamErr, "Group cannot be mapped", nil)
+	}
+
+	return nil
+}
+
+// checkAliasConflict 院校名称与别名不能与其他院校重复
+func checkAliasConflict(c *gin.Context, institutionClient inventory.InstitutionClient, inst *ent.Institution) error {
+	institutions, err := institutionClient.ListAll(c)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list institutions", err)
+	}
+
+	for _, existing := range institutions {
+		if existing.ID == inst.ID {
+			continue
+		}
+		for _, name := range append([]string{inst.Name}, inst.Aliases...) {
+			if inventory.DirectoryMatch(name, existing.Name, existing.Aliases) {
+				return serializer.NewError(serializer.CodeInstitutionExisted, "Name or alias conflicts with "+existing.Name, nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+func cleanAliases(name string, aliases []string) []string {
+	aliases = lo.FilterMap(aliases, func(alias string, _ int) (string, bool) {
+		alias = strings.TrimSpace(alias)
+		return alias, alias != "" && alias != name
+	})
+	return lo.Uniq(aliases)
+}
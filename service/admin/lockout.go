@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/lockout"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// LockedLogin 因登录失败次数过多被锁定的账号或 IP
+type LockedLogin struct {
+	lockout.Locked
+	UserHashID string `json:"user_hash_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+	Nick       string `json:"nick,omitempty"`
+}
+
+// ListLockedLogins 列出被锁定的账号与 IP
+func ListLockedLogins(c *gin.Context) []LockedLogin {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	hasher := dep.HashIDEncoder()
+
+	locked := lockout.ListLocked(dep.KV())
+	res := make([]LockedLogin, 0, len(locked))
+	for _, item := range locked {
+		entry := LockedLogin{Locked: item}
+		if item.Kind == lockout.KindUser {
+			if uid, err := strconv.Atoi(item.Key); err == nil {
+				entry.UserHashID = hashid.EncodeUserID(hasher, uid)
+				if u, err := userClient.GetByID(c, uid); err == nil {
+					entry.Email = u.Email
+					entry.Nick = u.Nick
+				}
+			}
+		}
+
+		res = append(res, entry)
+	}
+
+	return res
+}
+
+type (
+	UnlockLoginsService struct {
+		Locks []LockSubject `json:"locks" binding:"required,min=1,dive"`
+	}
+	LockSubject struct {
+		Kind lockout.Kind `json:"kind" binding:"required,oneof=user ip"`
+		Key  string       `json:"key" binding:"required"`
+	}
+	UnlockLoginsParamCtx struct{}
+)
+
+// Unlock 解除账号或 IP 的锁定，并重置其登录失败次数
+func (s *UnlockLoginsService) Unlock(c *gin.Context) error {
+	kv := dependency.FromContext(c).KV()
+	ae := serializer.NewAggregateError()
+	for _, item := range s.Locks {
+		subject := lockout.Subject{Kind: item.Kind, Key: item.Key}
+		if err := lockout.Unlock(kv, subject); err != nil {
+			ae.Add(subject.String(), serializer.NewError(serializer.CodeInternalSetting, "Failed to unlock", err))
+			continue
+		}
+
+		audit.Record(c, audit.ActionLoginUnlock, audit.WithTarget(subject.String()))
+	}
+
+	return ae.Aggregate()
+}
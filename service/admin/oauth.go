@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+// ListOAuthApps 列出所有第三方应用
+func ListOAuthApps(c *gin.Context) ([]GetOAuthAppResponse, error) {
+	dep := dependency.FromContext(c)
+	apps, err := dep.OAuthAppClient().ListApps(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list OAuth apps", err)
+	}
+
+	return lo.Map(apps, func(app *ent.OAuthApp, _ int) GetOAuthAppResponse {
+		return BuildOAuthAppResponse(app)
+	}), nil
+}
+
+func BuildOAuthAppResponse(app *ent.OAuthApp) GetOAuthAppResponse {
+	return GetOAuthAppResponse{
+		OAuthApp:     app,
+		Confidential: app.SecretHash != "",
+	}
+}
+
+type (
+	SingleOAuthAppService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	SingleOAuthAppParamCtx struct{}
+)
+
+func (s *SingleOAuthAppService) Get(c *gin.Context) (*GetOAuthAppResponse, error) {
+	dep := dependency.FromContext(c)
+	app, err := dep.OAuthAppClient().GetAppByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "OAuth app not found", err)
+	}
+
+	res := BuildOAuthAppResponse(app)
+	return &res, nil
+}
+
+func (s *SingleOAuthAppService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.OAuthAppClient().DeleteApp(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete OAuth app", err)
+	}
+
+	return nil
+}
+
+// ResetSecret 重新生成客户端密钥，公开客户端也会因此转为机密客户端
+func (s *SingleOAuthAppService) ResetSecret(c *gin.Context) (*GetOAuthAppResponse, error) {
+	dep := dependency.FromContext(c)
+	oauthClient := dep.OAuthAppClient()
+
+	secret, hash, err := auth.NewOAuthClientSecret()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate client secret", err)
+	}
+
+	if err := oauthClient.SetAppSecret(c, s.ID, hash); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update client secret", err)
+	}
+
+	res, err := s.Get(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res.ClientSecret = secret
+	return res, nil
+}
+
+type (
+	UpsertOAuthAppService struct {
+		App *ent.OAuthApp `json:"app" binding:"required"`
+		// Confidential 创建时是否生成客户端密钥，仅在创建时生效
+		Confidential bool `json:"confidential"`
+	}
+	UpsertOAuthAppParamCtx struct{}
+)
+
+func (s *UpsertOAuthAppService) Create(c *gin.Context) (*GetOAuthAppResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.App.ID != 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	if err := validateOAuthApp(s.App); err != nil {
+		return nil, err
+	}
+
+	var secret string
+	s.App.ClientID = strings.ReplaceAll(uuid.Must(uuid.NewV4()).String(), "-", "")
+	s.App.SecretHash = ""
+	if s.Confidential {
+		var err error
+		secret, s.App.SecretHash, err = auth.NewOAuthClientSecret()
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate client secret", err)
+		}
+	}
+
+	app, err := dep.OAuthAppClient().UpsertApp(c, s.App)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create OAuth app", err)
+	}
+
+	res := BuildOAuthAppResponse(app)
+	res.ClientSecret = secret
+	return &res, nil
+}
+
+func (s *UpsertOAuthAppService) Update(c *gin.Context) (*GetOAuthAppResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.App.ID == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
+	}
+
+	if err := validateOAuthApp(s.App); err != nil {
+		return nil, err
+	}
+
+	app, err := dep.OAuthAppClient().UpsertApp(c, s.App)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update OAuth app", err)
+	}
+
+	res := BuildOAuthAppResponse(app)
+	return &res, nil
+}
+
+func validateOAuthApp(app *ent.OAuthApp) error {
+	if strings.TrimSpace(app.Name) == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Name is required", nil)
+	}
+
+	if len(app.RedirectUris) == 0 {
+		return serializer.NewError(serializer.CodeParamErr, "At least one redirect URI is required", nil)
+	}
+
+	for _, redirect := range app.RedirectUris {
+		// 移动应用可使用自定义协议，但必须为不含片段的绝对地址，见 RFC 6749 3.1.2
+		u, err := url.Parse(redirect)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return serializer.NewError(serializer.CodeParamErr, "Invalid redirect URI: "+redirect, err)
+		}
+	}
+
+	if len(app.Scopes) == 0 {
+		return serializer.NewError(serializer.CodeParamErr, "At least one scope is required", nil)
+	}
+
+	app.Scopes = lo.Uniq(app.Scopes)
+	for _, scope := range app.Scopes {
+		if !auth.IsValidScope(scope) {
+			return serializer.NewError(serializer.CodeParamErr, "Unknown scope: "+scope, nil)
+		}
+
+		if scope == string(types.TokenScopeAdminRead) {
+			return serializer.NewError(serializer.CodeParamErr, "Admin scope cannot be granted to OAuth apps", nil)
+		}
+	}
+
+	return nil
+}
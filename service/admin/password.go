@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// 上传的泄露密码库大小上限，每条 SHA-1 摘要约 41 字节
+const maxBreachedListSize = 1 << 30
+
+// BreachedPasswordStats 返回内置与上传的泄露密码库条目数
+func BreachedPasswordStats() passwordpolicy.BreachListStats {
+	return passwordpolicy.DefaultBreachList().Stats()
+}
+
+// ImportBreachedPasswords 使用请求体替换已上传的泄露密码库，每行一个 SHA-1 摘要
+func ImportBreachedPasswords(c *gin.Context) (*passwordpolicy.BreachListStats, error) {
+	if c.Request.ContentLength > maxBreachedListSize {
+		request.BlackHole(c.Request.Body)
+		return nil, serializer.NewError(serializer.CodeFileTooLarge, "Breached password list is too large", nil)
+	}
+
+	list := passwordpolicy.DefaultBreachList()
+	n, err := list.Import(io.LimitReader(c.Request.Body, maxBreachedListSize))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Failed to import breached password list", err)
+	}
+
+	audit.Record(c, audit.ActionBreachedPasswordImport, audit.WithProp("count", strconv.Itoa(n)))
+	stats := list.Stats()
+	return &stats, nil
+}
+
+// ClearBreachedPasswords 删除已上传的泄露密码库，内置列表不受影响
+func ClearBreachedPasswords(c *gin.Context) error {
+	if err := passwordpolicy.DefaultBreachList().Clear(); err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to remove breached password list", err)
+	}
+
+	audit.Record(c, audit.ActionBreachedPasswordClear)
+	return nil
+}
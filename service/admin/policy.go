@@ -14,21 +14,28 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/azblob"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/cos"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/ks3"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/obs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/oss"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/s3"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/sftp"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/ssh"
 )
 
 // PathTestService 本地路径测试服务
@@ -265,6 +272,11 @@ func (service *CreateStoragePolicyService) Create(c *gin.Context) (*GetStoragePo
 		service.Policy.DirNameRule = util.DataPath("uploads/{uid}/{path}")
 	}
 
+	enforcePolicySettings(service.Policy)
+	if err := validatePolicySettings(service.Policy); err != nil {
+		return nil, err
+	}
+
 	service.Policy.ID = 0
 	policy, err := storagePolicyClient.Upsert(c, service.Policy)
 	if err != nil {
@@ -274,6 +286,69 @@ func (service *CreateStoragePolicyService) Create(c *gin.Context) (*GetStoragePo
 	return &GetStoragePolicyResponse{StoragePolicy: policy}, nil
 }
 
+// enforcePolicySettings 修正存储策略中与存储类型不兼容的设置
+func enforcePolicySettings(policy *ent.StoragePolicy) {
+	if policy.Settings == nil {
+		return
+	}
+
+	switch policy.Type {
+	case types.PolicyTypeGoogleDrive:
+		// Google Drive 无法签发预签名下载地址，下载地址中包含访问令牌，必须由 Cloudreve 中转
+		policy.Settings.InternalProxy = true
+	case types.PolicyTypeSftp, types.PolicyTypeWebdav:
+		// 客户端无法直接连接 SFTP / FTP 服务器，WebDAV 需要凭证鉴权，只能由 Cloudreve 中转上传
+		policy.Settings.Relay = true
+	}
+}
+
+// validatePolicySettings 校验存储策略中必须的设置
+func validatePolicySettings(policy *ent.StoragePolicy) error {
+	if policy.Type == types.PolicyTypeSftp && isSftpProtocol(policy) &&
+		(policy.Settings == nil || policy.Settings.SftpHostKey == "") {
+		return serializer.NewError(serializer.CodeParamErr, "SFTP host key is required", nil)
+	}
+
+	return nil
+}
+
+func isSftpProtocol(policy *ent.StoragePolicy) bool {
+	return policy.Settings == nil || policy.Settings.SftpProtocol == "" || policy.Settings.SftpProtocol == sftp.ProtocolSftp
+}
+
+type (
+	// ScanSftpHostKeyService 测试 SFTP 存储策略连接并获取服务器公钥，由管理员确认后保存
+	ScanSftpHostKeyService struct {
+		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
+	}
+	ScanSftpHostKeyParamCtx struct{}
+
+	ScanSftpHostKeyResponse struct {
+		HostKey     string `json:"host_key"`
+		Fingerprint string `json:"fingerprint"`
+	}
+)
+
+func (service *ScanSftpHostKeyService) Scan(c *gin.Context) (*ScanSftpHostKeyResponse, error) {
+	if service.Policy.Type != types.PolicyTypeSftp || !isSftpProtocol(service.Policy) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Only SFTP protocol has host key", nil)
+	}
+
+	if service.Policy.Settings == nil {
+		service.Policy.Settings = &types.PolicySetting{}
+	}
+
+	hostKey, err := sftp.ScanHostKey(c, service.Policy)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Failed to connect to SFTP server", err)
+	}
+
+	return &ScanSftpHostKeyResponse{
+		HostKey:     strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey))),
+		Fingerprint: ssh.FingerprintSHA256(hostKey),
+	}, nil
+}
+
 type (
 	UpdateStoragePolicyService struct {
 		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
@@ -295,6 +370,10 @@ func (service *UpdateStoragePolicyService) Update(c *gin.Context) (*GetStoragePo
 	}
 
 	service.Policy.ID = idInt
+	enforcePolicySettings(service.Policy)
+	if err := validatePolicySettings(service.Policy); err != nil {
+		return nil, err
+	}
 
 	sc, tx, ctx, err := inventory.WithTx(c, storagePolicyClient)
 	if err != nil {
@@ -317,6 +396,58 @@ func (service *UpdateStoragePolicyService) Update(c *gin.Context) (*GetStoragePo
 	return s.Get(c)
 }
 
+type (
+	RelocatePolicyService struct {
+		DstPolicyID int `json:"dst_policy_id" binding:"required"`
+	}
+	RelocatePolicyParamCtx struct{}
+)
+
+// Relocate 创建任务，将存储策略中的所有文件迁移至目标存储策略
+func (service *RelocatePolicyService) Relocate(c *gin.Context) (*GetTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	storagePolicyClient := dep.StoragePolicyClient()
+	hasher := dep.HashIDEncoder()
+	user := inventory.UserFromContext(c)
+
+	id := c.Param("id")
+	if id == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid ID", err)
+	}
+
+	if idInt == service.DstPolicyID {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Source and destination storage policy cannot be the same", nil)
+	}
+
+	for _, policyID := range []int{idInt, service.DstPolicyID} {
+		if _, err := storagePolicyClient.GetPolicyByID(c, policyID); err != nil {
+			return nil, serializer.NewError(serializer.CodePolicyNotExist, "", err)
+		}
+	}
+
+	t, err := workflows.NewPolicyRelocateTask(c, idInt, service.DstPolicyID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	audit.Record(c, audit.ActionPolicyRelocate, audit.WithTarget(id), audit.WithProp("dst", strconv.Itoa(service.DstPolicyID)))
+
+	return &GetTaskResponse{
+		Task:       t.Model(),
+		TaskHashID: hashid.EncodeTaskID(hasher, t.ID()),
+		UserHashID: hashid.EncodeUserID(hasher, user.ID),
+		Summary:    t.Summarize(hasher),
+	}, nil
+}
+
 type (
 	CreateStoragePolicyCorsService struct {
 		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
@@ -384,6 +515,17 @@ func (service *CreateStoragePolicyCorsService) Create(c *gin.Context) error {
 			return serializer.NewError(serializer.CodeInternalSetting, "Failed to create cors: "+err.Error(), err)
 		}
 
+		return nil
+	case types.PolicyTypeAzblob:
+		handler, err := azblob.New(c, service.Policy, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger(), dep.MimeDetector(c))
+		if err != nil {
+			return serializer.NewError(serializer.CodeDBError, "Failed to create azblob driver", err)
+		}
+
+		if err := handler.CORS(); err != nil {
+			return serializer.NewError(serializer.CodeInternalSetting, "Failed to create cors: "+err.Error(), err)
+		}
+
 		return nil
 	default:
 		return serializer.NewError(serializer.CodeParamErr, "Unsupported policy type", nil)
@@ -399,18 +541,18 @@ type (
 	GetOauthRedirectParamCtx struct{}
 )
 
-// GetOAuth 获取 OneDrive OAuth 地址
+// GetOAuth 获取 OneDrive / Google Drive OAuth 地址
 func (service *GetOauthRedirectService) GetOAuth(c *gin.Context) (string, error) {
 	dep := dependency.FromContext(c)
 	storagePolicyClient := dep.StoragePolicyClient()
 
 	policy, err := storagePolicyClient.GetPolicyByID(c, service.ID)
-	if err != nil || policy.Type != types.PolicyTypeOd {
+	if err != nil || !isOauthPolicy(policy) {
 		return "", serializer.NewError(serializer.CodePolicyNotExist, "", nil)
 	}
 
 	// Update to latest redirect url
-	policy.Settings.OauthRedirect = routes.MasterPolicyOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
+	policy.Settings.OauthRedirect = oauthRedirectURL(c, policy.Type)
 	policy.SecretKey = service.Secret
 	policy.BucketName = service.AppID
 	policy, err = storagePolicyClient.Upsert(c, policy)
@@ -418,6 +560,11 @@ func (service *GetOauthRedirectService) GetOAuth(c *gin.Context) (string, error)
 		return "", serializer.NewError(serializer.CodeDBError, "Failed to update policy", err)
 	}
 
+	if policy.Type == types.PolicyTypeGoogleDrive {
+		client := googledrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
+		return client.OAuthURL(context.Background(), googledrive.RequiredScope), nil
+	}
+
 	client := onedrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
 	redirect := client.OAuthURL(context.Background(), []string{
 		"offline_access",
@@ -428,8 +575,29 @@ func (service *GetOauthRedirectService) GetOAuth(c *gin.Context) (string, error)
 }
 
 func GetPolicyOAuthURL(c *gin.Context) string {
-	dep := dependency.FromContext(c)
-	return routes.MasterPolicyOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
+	return oauthRedirectURL(c, c.Query("type"))
+}
+
+// oauthRedirectURL 返回存储策略授权的回调地址。Google Drive 要求回调地址指向 API 路由，再由其转发至管理面板
+func oauthRedirectURL(c *gin.Context, policyType string) string {
+	siteURL := dependency.FromContext(c).SettingProvider().SiteURL(c)
+	if policyType == types.PolicyTypeGoogleDrive {
+		return routes.MasterGoogleDriveOAuthCallback(siteURL).String()
+	}
+
+	return routes.MasterPolicyOAuthCallback(siteURL).String()
+}
+
+func isOauthPolicy(policy *ent.StoragePolicy) bool {
+	return policy.Type == types.PolicyTypeOd || policy.Type == types.PolicyTypeGoogleDrive
+}
+
+func oauthCredentialKey(policy *ent.StoragePolicy) string {
+	if policy.Type == types.PolicyTypeGoogleDrive {
+		return googledrive.CredentialKey(policy.ID)
+	}
+
+	return onedrive.CredentialKey(policy.ID)
 }
 
 // GetOauthCredentialStatus returns last refresh time of oauth credential
@@ -438,7 +606,7 @@ func (service *SingleStoragePolicyService) GetOauthCredentialStatus(c *gin.Conte
 	storagePolicyClient := dep.StoragePolicyClient()
 
 	policy, err := storagePolicyClient.GetPolicyByID(c, service.ID)
-	if err != nil || policy.Type != types.PolicyTypeOd {
+	if err != nil || !isOauthPolicy(policy) {
 		return nil, serializer.NewError(serializer.CodePolicyNotExist, "", nil)
 	}
 
@@ -446,7 +614,7 @@ func (service *SingleStoragePolicyService) GetOauthCredentialStatus(c *gin.Conte
 		return &OauthCredentialStatus{Valid: false}, nil
 	}
 
-	token, err := dep.CredManager().Obtain(c, onedrive.CredentialKey(policy.ID))
+	token, err := dep.CredManager().Obtain(c, oauthCredentialKey(policy))
 	if err != nil {
 		if errors.Is(err, credmanager.ErrNotFound) {
 			return &OauthCredentialStatus{Valid: false}, nil
@@ -480,12 +648,17 @@ func (service *FinishOauthCallbackService) Finish(c *gin.Context) error {
 		return serializer.NewError(serializer.CodePolicyNotExist, "", nil)
 	}
 
-	if policy.Type != types.PolicyTypeOd {
+	var credential credmanager.Credential
+	switch policy.Type {
+	case types.PolicyTypeOd:
+		client := onedrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
+		credential, err = client.ObtainToken(c, onedrive.WithCode(service.Code))
+	case types.PolicyTypeGoogleDrive:
+		client := googledrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
+		credential, err = client.ObtainToken(c, googledrive.WithCode(service.Code))
+	default:
 		return serializer.NewError(serializer.CodeParamErr, "Invalid policy type", nil)
 	}
-
-	client := onedrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
-	credential, err := client.ObtainToken(c, onedrive.WithCode(service.Code))
 	if err != nil {
 		return serializer.NewError(serializer.CodeParamErr, "Failed to obtain token: "+err.Error(), err)
 	}
@@ -496,7 +669,7 @@ func (service *FinishOauthCallbackService) Finish(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeInternalSetting, "Failed to upsert credential", err)
 	}
 
-	_, err = credManager.Obtain(c, onedrive.CredentialKey(policy.ID))
+	_, err = credManager.Obtain(c, oauthCredentialKey(policy))
 	if err != nil {
 		return serializer.NewError(serializer.CodeInternalSetting, "Failed to obtain credential", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/roster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 )
 
@@ -65,9 +66,21 @@ type ListUserResponse struct {
 
 type GetUserResponse struct {
 	*ent.User
-	HashID       string       `json:"hash_id,omitempty"`
-	TwoFAEnabled bool         `json:"two_fa_enabled,omitempty"`
-	Capacity     *fs.Capacity `json:"capacity,omitempty"`
+	HashID         string       `json:"hash_id,omitempty"`
+	TwoFAEnabled   bool         `json:"two_fa_enabled,omitempty"`
+	TwoFARequired  bool         `json:"two_fa_required,omitempty"`
+	TwoFACompliant bool         `json:"two_fa_compliant"`
+	Capacity       *fs.Capacity `json:"capacity,omitempty"`
+}
+
+type ImportUserResponse struct {
+	*roster.Report
+	Rows []ImportUserRowResponse `json:"rows"`
+}
+
+type ImportUserRowResponse struct {
+	*roster.RowResult
+	UserHashID string `json:"user_id,omitempty"`
 }
 
 type GetNodeResponse struct {
@@ -141,3 +154,38 @@ type Version struct {
 func init() {
 	gob.Register(MetricsSummary{})
 }
+
+type ListCourseResponse struct {
+	Pagination *inventory.PaginationResults `json:"pagination"`
+	Courses    []GetCourseResponse          `json:"courses"`
+}
+
+type GetCourseResponse struct {
+	*ent.Course
+	HashID  string                 `json:"hash_id,omitempty"`
+	Uri     string                 `json:"uri,omitempty"`
+	Used    int64                  `json:"used"`
+	Members []CourseMemberResponse `json:"members,omitempty"`
+}
+
+type CourseMemberResponse struct {
+	*ent.CourseMember
+	UserHashID string `json:"user_hash_id,omitempty"`
+}
+
+type ListAuditLogResponse struct {
+	Pagination *inventory.PaginationResults `json:"pagination"`
+	Logs       []GetAuditLogResponse        `json:"logs"`
+}
+
+type GetAuditLogResponse struct {
+	*ent.AuditLog
+	UserHashID string `json:"user_hash_id,omitempty"`
+}
+
+type GetOAuthAppResponse struct {
+	*ent.OAuthApp
+	Confidential bool `json:"confidential"`
+	// ClientSecret is only returned once on creation or reset.
+	ClientSecret string `json:"client_secret,omitempty"`
+}
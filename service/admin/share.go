@@ -8,6 +8,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -157,5 +158,9 @@ func (s *BatchShareService) Delete(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeDBError, "Failed to delete shares", err)
 	}
 
+	hasher := dep.HashIDEncoder()
+	audit.Record(c, audit.ActionShareDelete, audit.WithTarget(strings.Join(lo.Map(s.ShareIDs, func(id int, _ int) string {
+		return hashid.EncodeShareID(hasher, id)
+	}), ",")))
 	return nil
 }
@@ -7,15 +7,19 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ldap"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sso"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
@@ -214,9 +218,12 @@ type (
 
 var (
 	preprocessors = map[string]SettingPreProcessor{
-		"siteURL":      siteUrlPreProcessor,
-		"mime_mapping": mimeMappingPreProcessor,
-		"secret_key":   secretKeyPreProcessor,
+		"siteURL":            siteUrlPreProcessor,
+		"mime_mapping":       mimeMappingPreProcessor,
+		"secret_key":         secretKeyPreProcessor,
+		"sso_providers":      ssoProvidersPreProcessor,
+		"ldap_user_filter":   ldapPreProcessor,
+		"ldap_group_mapping": ldapPreProcessor,
 	}
 	postprocessors = map[string]SettingPostProcessor{
 		"mime_mapping":                               mimeMappingPostProcessor,
@@ -232,6 +239,20 @@ var (
 		"replyTo":                                    emailPostProcessor,
 		"fromName":                                   emailPostProcessor,
 		"fromAdress":                                 emailPostProcessor,
+		"sms_provider":                               smsPostProcessor,
+		"sms_aliyun_access_key_id":                   smsPostProcessor,
+		"sms_aliyun_access_key_secret":               smsPostProcessor,
+		"sms_aliyun_sign_name":                       smsPostProcessor,
+		"sms_aliyun_template_code":                   smsPostProcessor,
+		"sms_tencent_secret_id":                      smsPostProcessor,
+		"sms_tencent_secret_key":                     smsPostProcessor,
+		"sms_tencent_sdk_app_id":                     smsPostProcessor,
+		"sms_tencent_sign_name":                      smsPostProcessor,
+		"sms_tencent_template_id":                    smsPostProcessor,
+		"sms_webhook_url":                            smsPostProcessor,
+		"sms_webhook_method":                         smsPostProcessor,
+		"sms_webhook_headers":                        smsPostProcessor,
+		"sms_webhook_body":                           smsPostProcessor,
 		"queue_media_meta_worker_num":                mediaMetaQueuePostProcessor,
 		"queue_media_meta_max_execution":             mediaMetaQueuePostProcessor,
 		"queue_media_meta_backoff_factor":            mediaMetaQueuePostProcessor,
@@ -312,6 +333,11 @@ func (s *SetSettingService) SetSetting(c *gin.Context) (map[string]string, error
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
 	}
 
+	// 仅记录修改的设置项名称，避免密钥等敏感值写入审计日志
+	keys := lo.Keys(s.Settings)
+	sort.Strings(keys)
+	audit.Record(c, audit.ActionSettingUpdate, audit.WithTarget(strings.Join(keys, ",")))
+
 	// Clean cache
 	if err := kv.Delete(setting.KvSettingPrefix, lo.Keys(s.Settings)...); err != nil {
 		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to clear cache", err)
@@ -356,6 +382,51 @@ func mimeMappingPreProcessor(ctx context.Context, settings map[string]string) er
 	return nil
 }
 
+func ssoProvidersPreProcessor(ctx context.Context, settings map[string]string) error {
+	var providers []setting.SSOProvider
+	if err := json.Unmarshal([]byte(settings["sso_providers"]), &providers); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid SSO providers", err)
+	}
+
+	ids := make(map[string]bool, len(providers))
+	for i := range providers {
+		if providers[i].ID == "" || ids[providers[i].ID] {
+			return serializer.NewError(serializer.CodeParamErr, "SSO provider ID must be unique and non-empty", nil)
+		}
+		if providers[i].ID == ldap.IdentityProvider {
+			return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("SSO provider ID %q is reserved", ldap.IdentityProvider), nil)
+		}
+		ids[providers[i].ID] = true
+
+		if _, err := sso.NewProvider(&providers[i], nil); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Invalid SSO provider %q: %s", providers[i].ID, err), err)
+		}
+	}
+
+	return nil
+}
+
+func ldapPreProcessor(ctx context.Context, settings map[string]string) error {
+	if filter, ok := settings["ldap_user_filter"]; ok && !strings.Contains(filter, "{username}") {
+		return serializer.NewError(serializer.CodeParamErr, "LDAP user filter must contain {username}", nil)
+	}
+
+	if raw, ok := settings["ldap_group_mapping"]; ok {
+		var mapping []setting.LDAPGroupMapping
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Invalid LDAP group mapping", err)
+		}
+
+		for _, rule := range mapping {
+			if rule.LDAPGroup == "" || rule.GroupID <= 0 {
+				return serializer.NewError(serializer.CodeParamErr, "LDAP group mapping requires LDAP group and group ID", nil)
+			}
+		}
+	}
+
+	return nil
+}
+
 func mimeMappingPostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.MimeDetector(context.WithValue(ctx, dependency.ReloadCtx{}, true))
@@ -375,6 +446,12 @@ func emailPostProcessor(ctx context.Context, settings map[string]string) error {
 	return nil
 }
 
+func smsPostProcessor(ctx context.Context, settings map[string]string) error {
+	dep := dependency.FromContext(ctx)
+	dep.SMSProvider(context.WithValue(ctx, dependency.ReloadCtx{}, true))
+	return nil
+}
+
 func mediaMetaQueuePostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.MediaMetaQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
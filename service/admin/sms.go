@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// ListBlockedPhones 列出被暂时限制接收验证码的手机号
+func ListBlockedPhones(c *gin.Context) []sms.BlockedPhone {
+	return sms.ListBlocked(dependency.FromContext(c).KV())
+}
+
+type (
+	UnblockPhonesService struct {
+		Phones []string `json:"phones" binding:"required,min=1"`
+	}
+	UnblockPhonesParamCtx struct{}
+)
+
+// Unblock 解除手机号限制，并重置其当日发送次数与错误次数
+func (s *UnblockPhonesService) Unblock(c *gin.Context) error {
+	kv := dependency.FromContext(c).KV()
+	ae := serializer.NewAggregateError()
+	for _, phone := range s.Phones {
+		if err := sms.Unblock(kv, util.NormalizePhone(phone)); err != nil {
+			ae.Add(phone, serializer.NewError(serializer.CodeInternalSetting, "Failed to unblock phone", err))
+		}
+	}
+
+	return ae.Aggregate()
+}
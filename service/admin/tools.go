@@ -2,6 +2,7 @@ package admin
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strconv"
@@ -11,9 +12,12 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ldap"
 	request2 "github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/cloudreve/Cloudreve/v4/pkg/wopi"
 	"github.com/gin-gonic/gin"
 	"github.com/wneessen/go-mail"
@@ -179,6 +183,117 @@ func (s *TestSMTPService) Test(c *gin.Context) error {
 	return nil
 }
 
+type (
+	TestSMSService struct {
+		Settings map[string]string `json:"settings" binding:"required"`
+		Phone    string            `json:"phone" binding:"required"`
+	}
+	TestSMSParamCtx struct{}
+)
+
+// Test 使用未保存的短信设置发送一条测试验证码
+func (s *TestSMSService) Test(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	phone := util.NormalizePhone(s.Phone)
+	if !util.ValidatePhone(phone) {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid phone number", nil)
+	}
+
+	provider, err := sms.NewProvider(&setting.SMS{
+		Provider: setting.SMSProviderType(s.Settings["sms_provider"]),
+		Aliyun: setting.SMSAliyun{
+			AccessKeyID:     s.Settings["sms_aliyun_access_key_id"],
+			AccessKeySecret: s.Settings["sms_aliyun_access_key_secret"],
+			SignName:        s.Settings["sms_aliyun_sign_name"],
+			TemplateCode:    s.Settings["sms_aliyun_template_code"],
+		},
+		Tencent: setting.SMSTencent{
+			SecretID:   s.Settings["sms_tencent_secret_id"],
+			SecretKey:  s.Settings["sms_tencent_secret_key"],
+			SDKAppID:   s.Settings["sms_tencent_sdk_app_id"],
+			SignName:   s.Settings["sms_tencent_sign_name"],
+			TemplateID: s.Settings["sms_tencent_template_id"],
+		},
+		Webhook: setting.SMSWebhook{
+			URL:     s.Settings["sms_webhook_url"],
+			Method:  s.Settings["sms_webhook_method"],
+			Headers: s.Settings["sms_webhook_headers"],
+			Body:    s.Settings["sms_webhook_body"],
+		},
+	}, dep.Logger(), dep.RequestClient(request2.WithLogger(dep.Logger())))
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Invalid SMS settings: "+err.Error(), err)
+	}
+
+	if err := provider.Send(c, phone, "123456"); err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to send test SMS: "+err.Error(), err)
+	}
+
+	return nil
+}
+
+type (
+	TestLDAPService struct {
+		Settings map[string]string `json:"settings" binding:"required"`
+		// Username and Password are optional, if provided, a test login is performed.
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	TestLDAPParamCtx struct{}
+	TestLDAPResponse struct {
+		DN         string              `json:"dn,omitempty"`
+		Subject    string              `json:"subject,omitempty"`
+		GroupID    int                 `json:"group_id,omitempty"`
+		Attributes map[string][]string `json:"attributes,omitempty"`
+	}
+)
+
+// Test 使用未保存的 LDAP 设置测试连接，提供用户名密码时同时测试登录并返回读取到的属性
+func (s *TestLDAPService) Test(c *gin.Context) (*TestLDAPResponse, error) {
+	config := &setting.LDAP{
+		URL:                s.Settings["ldap_url"],
+		StartTLS:           setting.IsTrueValue(s.Settings["ldap_start_tls"]),
+		InsecureSkipVerify: setting.IsTrueValue(s.Settings["ldap_insecure_skip_verify"]),
+		BindDN:             s.Settings["ldap_bind_dn"],
+		BindPassword:       s.Settings["ldap_bind_password"],
+		BaseDN:             s.Settings["ldap_base_dn"],
+		UserFilter:         s.Settings["ldap_user_filter"],
+		SubjectAttr:        s.Settings["ldap_attr_subject"],
+		Attributes: setting.SSOAttributeMapping{
+			StudentID:  s.Settings["ldap_attr_student_id"],
+			University: s.Settings["ldap_attr_university"],
+			Major:      s.Settings["ldap_attr_major"],
+			Nick:       s.Settings["ldap_attr_nick"],
+			Email:      s.Settings["ldap_attr_email"],
+			Group:      s.Settings["ldap_attr_group"],
+		},
+	}
+	if raw := s.Settings["ldap_group_mapping"]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &config.GroupMapping); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid LDAP group mapping", err)
+		}
+	}
+
+	if s.Username == "" {
+		if err := ldap.TestConnection(c, config); err != nil {
+			return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to connect to LDAP server: "+err.Error(), err)
+		}
+		return &TestLDAPResponse{}, nil
+	}
+
+	entry, err := ldap.Authenticate(c, config, s.Username, s.Password)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to sign in with LDAP: "+err.Error(), err)
+	}
+
+	return &TestLDAPResponse{
+		DN:         entry.DN,
+		Subject:    ldap.Subject(config, entry),
+		GroupID:    ldap.MapGroup(config, entry),
+		Attributes: entry.Attributes,
+	}, nil
+}
+
 func ClearEntityUrlCache(c *gin.Context) {
 	dep := dependency.FromContext(c)
 	dep.KV().Delete(manager.EntityUrlCacheKeyPrefix)
@@ -2,6 +2,8 @@ package admin
 
 import (
 	"context"
+	"errors"
+	"io"
 	"strconv"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -9,8 +11,12 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/roster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
@@ -37,6 +43,9 @@ const (
 	userGroupCondition  = "user_group"
 	userNickCondition   = "user_nick"
 	userEmailCondition  = "user_email"
+	// 按学号、院校筛选
+	userStudentIDCondition  = "user_student_id"
+	userUniversityCondition = "user_university"
 )
 
 func (service *AdminListService) Users(c *gin.Context) (*ListUserResponse, error) {
@@ -65,10 +74,12 @@ func (service *AdminListService) Users(c *gin.Context) (*ListUserResponse, error
 			OrderBy:  service.OrderBy,
 			Order:    inventory.OrderDirection(service.OrderDirection),
 		},
-		Status:  user.Status(service.Conditions[userStatusCondition]),
-		GroupID: groupID,
-		Nick:    service.Conditions[userNickCondition],
-		Email:   service.Conditions[userEmailCondition],
+		Status:     user.Status(service.Conditions[userStatusCondition]),
+		GroupID:    groupID,
+		Nick:       service.Conditions[userNickCondition],
+		Email:      service.Conditions[userEmailCondition],
+		StudentID:  service.Conditions[userStudentIDCondition],
+		University: service.Conditions[userUniversityCondition],
 	})
 
 	if err != nil {
@@ -79,14 +90,21 @@ func (service *AdminListService) Users(c *gin.Context) (*ListUserResponse, error
 		Pagination: res.PaginationResults,
 		Users: lo.Map(res.Users, func(user *ent.User, _ int) GetUserResponse {
 			return GetUserResponse{
-				User:         user,
-				HashID:       hashid.EncodeUserID(hasher, user.ID),
-				TwoFAEnabled: user.TwoFactorSecret != "",
+				User:           user,
+				HashID:         hashid.EncodeUserID(hasher, user.ID),
+				TwoFAEnabled:   user.TwoFactorSecret != "",
+				TwoFARequired:  inventory.IsTwoFARequired(user),
+				TwoFACompliant: isTwoFACompliant(user),
 			}
 		}),
 	}, nil
 }
 
+// isTwoFACompliant 用户是否满足所在用户组的二步验证要求，需预先加载用户组和通行密钥
+func isTwoFACompliant(u *ent.User) bool {
+	return !inventory.IsTwoFARequired(u) || u.TwoFactorSecret != "" || len(u.Edges.Passkey) > 0
+}
+
 type (
 	SingleUserService struct {
 		ID int `uri:"id" json:"id" binding:"required"`
@@ -114,10 +132,12 @@ func (service *SingleUserService) Get(c *gin.Context) (*GetUserResponse, error)
 	}
 
 	return &GetUserResponse{
-		User:         user,
-		HashID:       hashid.EncodeUserID(hasher, user.ID),
-		TwoFAEnabled: user.TwoFactorSecret != "",
-		Capacity:     capacity,
+		User:           user,
+		HashID:         hashid.EncodeUserID(hasher, user.ID),
+		TwoFAEnabled:   user.TwoFactorSecret != "",
+		TwoFARequired:  inventory.IsTwoFARequired(user),
+		TwoFACompliant: isTwoFACompliant(user),
+		Capacity:       capacity,
 	}, nil
 }
 
@@ -135,11 +155,39 @@ func (service *SingleUserService) CalibrateStorage(c *gin.Context) (*GetUserResp
 	return subService.Get(c)
 }
 
+// Logout revokes all login sessions of the user.
+func (service *SingleUserService) Logout(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u, err := dep.UserClient().GetByID(c, service.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeUserNotFound, "", err)
+	}
+
+	if err := dep.TokenAuth().RevokeAll(c, u); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke login sessions", err)
+	}
+
+	return nil
+}
+
+// ResetTwoFA 清除用户的 TOTP 密钥和通行密钥，用户组要求二步验证时用户下次登录需重新启用
+func (service *SingleUserService) ResetTwoFA(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.UserClient().ResetTwoFA(c, service.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to reset 2FA", err)
+	}
+
+	audit.Record(c, audit.ActionTwoFAReset, audit.WithTarget(strconv.Itoa(service.ID)))
+	return nil
+}
+
 type (
 	UpsertUserService struct {
 		User     *ent.User `json:"user" binding:"required"`
 		Password string    `json:"password"`
 		TwoFA    string    `json:"two_fa"`
+		// Clear 需要清除的院校、专业或学号字段，未列出的空字段保持不变
+		Clear []string `json:"clear" binding:"dive,oneof=university major student_id"`
 	}
 	UpsertUserParamCtx struct{}
 )
@@ -169,11 +217,32 @@ func (s *UpsertUserService) Update(c *gin.Context) (*GetUserResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Password too long", nil)
 	}
 
-	newUser, err := userClient.Upsert(ctx, s.User, s.Password, s.TwoFA)
+	if s.Password != "" {
+		if err := passwordpolicy.NewChecker(dep.SettingProvider().PasswordPolicy(c)).Check(s.Password, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := canonicalizeDirectory(c, s.User); err != nil {
+		return nil, err
+	}
+
+	newUser, err := userClient.Upsert(ctx, s.User, s.Password, s.TwoFA, s.Clear...)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeStudentIDExisted, "Student ID or email already in use", err)
+		}
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update user", err)
 	}
 
+	if existing.Status != newUser.Status {
+		if newUser.Status == user.StatusManualBanned {
+			audit.Record(c, audit.ActionUserBan, audit.WithTarget(strconv.Itoa(newUser.ID)))
+		} else if existing.Status == user.StatusManualBanned || existing.Status == user.StatusSysBanned {
+			audit.Record(c, audit.ActionUserUnban, audit.WithTarget(strconv.Itoa(newUser.ID)))
+		}
+	}
+
 	service := &SingleUserService{ID: newUser.ID}
 	return service.Get(c)
 }
@@ -186,12 +255,23 @@ func (s *UpsertUserService) Create(c *gin.Context) (*GetUserResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Password is required", nil)
 	}
 
+	if err := passwordpolicy.NewChecker(dep.SettingProvider().PasswordPolicy(c)).Check(s.Password, nil); err != nil {
+		return nil, err
+	}
+
 	if s.User.ID != 0 {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
 	}
 
+	if err := canonicalizeDirectory(c, s.User); err != nil {
+		return nil, err
+	}
+
 	user, err := userClient.Upsert(c, s.User, s.Password, s.TwoFA)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeStudentIDExisted, "Student ID or email already in use", err)
+		}
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create user", err)
 	}
 
@@ -200,6 +280,22 @@ func (s *UpsertUserService) Create(c *gin.Context) (*GetUserResponse, error) {
 
 }
 
+// canonicalizeDirectory 将院校与专业替换为目录中的规范名称
+func canonicalizeDirectory(c *gin.Context, u *ent.User) error {
+	dep := dependency.FromContext(c)
+	university, major, err := dep.InstitutionClient().Canonicalize(c, u.University, u.Major,
+		dep.SettingProvider().InstitutionDirectoryEnforced(c))
+	if err != nil {
+		if errors.Is(err, inventory.ErrInstitutionNotFound) || errors.Is(err, inventory.ErrMajorNotFound) {
+			return serializer.NewError(serializer.CodeNotInDirectory, "University or major not in directory", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to query institution directory", err)
+	}
+
+	u.University, u.Major = university, major
+	return nil
+}
+
 type (
 	BatchUserService struct {
 		IDs []int `json:"ids" binding:"min=1"`
@@ -252,3 +348,65 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 
 	return ae.Aggregate()
 }
+
+type (
+	// ImportUserService 从花名册批量导入学生账号，请求体为 CSV 或 XLSX 文件
+	ImportUserService struct {
+		Format string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+		Group  int    `form:"group"`
+		DryRun bool   `form:"dry_run"`
+	}
+	ImportUserParamCtx struct{}
+)
+
+const maxRosterSize = 16 << 20
+
+func (s *ImportUserService) Import(c *gin.Context) (*ImportUserResponse, error) {
+	dep := dependency.FromContext(c)
+	if c.Request.ContentLength > maxRosterSize {
+		request.BlackHole(c.Request.Body)
+		return nil, serializer.NewError(serializer.CodeFileTooLarge, "Roster file is too large", nil)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(c.Request.Body, maxRosterSize+1))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeIOFailed, "Failed to read roster file", err)
+	}
+	if len(content) > maxRosterSize {
+		return nil, serializer.NewError(serializer.CodeFileTooLarge, "Roster file is too large", nil)
+	}
+
+	format := roster.Format(s.Format)
+	if format == "" {
+		format = roster.FormatFromName(c.GetHeader("Content-Type"))
+	}
+
+	rows, err := roster.Parse(content, format)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Failed to parse roster", err)
+	}
+
+	defaultGroup := s.Group
+	if defaultGroup == 0 {
+		defaultGroup = dep.SettingProvider().DefaultGroup(c)
+	}
+
+	importer, err := roster.NewImporter(c, dep.UserClient(), dep.GroupClient(), dep.InstitutionClient(), defaultGroup,
+		dep.SettingProvider().InstitutionDirectoryEnforced(c), s.DryRun)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to initialize importer", err)
+	}
+
+	report := importer.Import(c, rows)
+	hasher := dep.HashIDEncoder()
+	return &ImportUserResponse{
+		Report: report,
+		Rows: lo.Map(report.Rows, func(item *roster.RowResult, index int) ImportUserRowResponse {
+			res := ImportUserRowResponse{RowResult: item}
+			if item.UserID > 0 {
+				res.UserHashID = hashid.EncodeUserID(hasher, item.UserID)
+			}
+			return res
+		}),
+	}, nil
+}
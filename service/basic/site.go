@@ -5,13 +5,16 @@ import (
 	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/service/user"
 	"github.com/gin-gonic/gin"
 	"github.com/mojocn/base64Captcha"
+	"github.com/samber/lo"
 )
 
 // SiteConfig 站点全局设置序列
@@ -30,6 +33,7 @@ type SiteConfig struct {
 	// Login Section
 	LoginCaptcha     bool                `json:"login_captcha,omitempty"`
 	RegCaptcha       bool                `json:"reg_captcha,omitempty"`
+	SMSCaptcha       bool                `json:"sms_captcha,omitempty"`
 	ForgetCaptcha    bool                `json:"forget_captcha,omitempty"`
 	Authn            bool                `json:"authn,omitempty"`
 	ReCaptchaKey     string              `json:"captcha_ReCaptchaKey,omitempty"`
@@ -41,6 +45,10 @@ type SiteConfig struct {
 	RegisterEnabled  bool                `json:"register_enabled,omitempty"`
 	TosUrl           string              `json:"tos_url,omitempty"`
 	PrivacyPolicyUrl string              `json:"privacy_policy_url,omitempty"`
+	SSOProviders     []SSOProviderOption `json:"sso_providers,omitempty"`
+	// 密码策略，用于在设置密码前提示要求
+	PasswordMinLength  int `json:"password_min_length,omitempty"`
+	PasswordMinClasses int `json:"password_min_classes,omitempty"`
 
 	// Explorer section
 	Icons                string                    `json:"icons,omitempty"`
@@ -61,6 +69,10 @@ type SiteConfig struct {
 	// App settings
 	AppPromotion bool `json:"app_promotion,omitempty"`
 
+	// Institution section
+	Institutions        []InstitutionOption `json:"institutions,omitempty"`
+	InstitutionEnforced bool                `json:"institution_enforced,omitempty"`
+
 	//EmailActive          bool      `json:"emailActive"`
 	//QQLogin              bool      `json:"QQLogin"`
 	//ScoreEnabled         bool      `json:"score_enabled"`
@@ -72,6 +84,19 @@ type SiteConfig struct {
 	//AppForumLink         string              `json:"app_forum"`
 }
 
+// SSOProviderOption 登录页可用的外部身份提供方
+type SSOProviderOption struct {
+	ID   string                  `json:"id"`
+	Name string                  `json:"name"`
+	Type setting.SSOProviderType `json:"type"`
+}
+
+// InstitutionOption 注册页可选的院校及专业
+type InstitutionOption struct {
+	Name   string   `json:"name"`
+	Majors []string `json:"majors,omitempty"`
+}
+
 type (
 	GetSettingService struct {
 		Section string `uri:"section" binding:"required"`
@@ -86,14 +111,21 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 	switch s.Section {
 	case "login":
 		legalDocs := settings.LegalDocuments(c)
+		passwordPolicy := settings.PasswordPolicy(c)
 		return &SiteConfig{
-			LoginCaptcha:     settings.LoginCaptchaEnabled(c),
-			RegCaptcha:       settings.RegCaptchaEnabled(c),
-			ForgetCaptcha:    settings.ForgotPasswordCaptchaEnabled(c),
-			Authn:            settings.AuthnEnabled(c),
-			RegisterEnabled:  settings.RegisterEnabled(c),
-			PrivacyPolicyUrl: legalDocs.PrivacyPolicy,
-			TosUrl:           legalDocs.TermsOfService,
+			LoginCaptcha:       settings.LoginCaptchaEnabled(c),
+			RegCaptcha:         settings.RegCaptchaEnabled(c),
+			SMSCaptcha:         settings.SMSCaptchaEnabled(c),
+			ForgetCaptcha:      settings.ForgotPasswordCaptchaEnabled(c),
+			Authn:              settings.AuthnEnabled(c),
+			RegisterEnabled:    settings.RegisterEnabled(c),
+			PrivacyPolicyUrl:   legalDocs.PrivacyPolicy,
+			TosUrl:             legalDocs.TermsOfService,
+			PasswordMinLength:  passwordPolicy.MinLength,
+			PasswordMinClasses: passwordPolicy.MinClasses,
+			SSOProviders: lo.FilterMap(settings.SSOProviders(c), func(p setting.SSOProvider, _ int) (SSOProviderOption, bool) {
+				return SSOProviderOption{ID: p.ID, Name: p.Name, Type: p.Type}, p.Enabled
+			}),
 		}, nil
 	case "explorer":
 		explorerSettings := settings.ExplorerFrontendSettings(c)
@@ -171,6 +203,22 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 		}
 		sort.Strings(result)
 		return &SiteConfig{ThumbExts: result}, nil
+	case "institution":
+		institutions, err := dep.InstitutionClient().ListAll(c)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list institutions", err)
+		}
+		return &SiteConfig{
+			Institutions: lo.Map(institutions, func(item *ent.Institution, index int) InstitutionOption {
+				return InstitutionOption{
+					Name: item.Name,
+					Majors: lo.Map(item.Edges.Majors, func(m *ent.Major, index int) string {
+						return m.Name
+					}),
+				}
+			}),
+			InstitutionEnforced: settings.InstitutionDirectoryEnforced(c),
+		}, nil
 	default:
 		break
 	}
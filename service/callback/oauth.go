@@ -1,6 +1,8 @@
 package callback
 
 import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
 )
@@ -13,51 +15,13 @@ type OauthService struct {
 	Scope    string `form:"scope"`
 }
 
-//
-//// GDriveAuth Google Drive 更新认证信息
-//func (service *OauthService) GDriveAuth(c *gin.Context) serializer.Response {
-//	if service.Error != "" {
-//		return serializer.ParamErrDeprecated(service.Error, nil)
-//	}
-//
-//	// validate required scope
-//	if missing, found := lo.Find[string](googledrive.RequiredScope, func(item string) bool {
-//		return !strings.Contains(service.Scope, item)
-//	}); found {
-//		return serializer.ParamErrDeprecated(fmt.Sprintf("Missing required scope: %s", missing), nil)
-//	}
-//
-//	policyID, ok := util.GetSession(c, "googledrive_oauth_policy").(uint)
-//	if !ok {
-//		return serializer.ErrDeprecated(serializer.CodeNotFound, "", nil)
-//	}
-//
-//	util.DeleteSession(c, "googledrive_oauth_policy")
-//
-//	policy, err := model.GetPolicyByID(policyID)
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodePolicyNotExist, "", nil)
-//	}
-//
-//	client, err := googledrive.NewClient(&policy)
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodeInternalSetting, "Failed to initialize Google Drive client", err)
-//	}
-//
-//	credential, err := client.ObtainToken(c, service.Code, "")
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodeInternalSetting, "Failed to fetch AccessToken", err)
-//	}
-//
-//	// 更新存储策略的 RefreshToken
-//	client.Policy.AccessKey = credential.RefreshToken
-//	if err := client.Policy.SaveAndClearCache(); err != nil {
-//		return serializer.DBErrDeprecated("Failed to update RefreshToken", err)
-//	}
-//
-//	cache.Deletes([]string{client.Policy.AccessKey}, googledrive.TokenCachePrefix)
-//	return serializer.Response{}
-//}
+// GDriveAuthRedirect 返回 Google Drive 授权回调的转发地址，授权码由管理面板提交以完成授权
+func GDriveAuthRedirect(c *gin.Context) string {
+	dep := dependency.FromContext(c)
+	redirect := routes.MasterPolicyOAuthCallback(dep.SettingProvider().SiteURL(c))
+	redirect.RawQuery = c.Request.URL.RawQuery
+	return redirect.String()
+}
 
 // OdAuth OneDrive 更新认证信息
 func (service *OauthService) OdAuth(c *gin.Context) serializer.Response {
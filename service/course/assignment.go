@@ -0,0 +1,220 @@
+package course
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/assignment"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	// AssignmentService 创建或更新作业提交箱
+	AssignmentService struct {
+		Name         string                   `json:"name" binding:"required,min=1,max=200"`
+		Uri          string                   `json:"uri"`
+		Deadline     time.Time                `json:"deadline" binding:"required"`
+		LatePolicy   string                   `json:"late_policy" binding:"omitempty,oneof=reject allow until"`
+		LateDeadline *time.Time               `json:"late_deadline"`
+		Settings     *types.AssignmentSetting `json:"settings"`
+	}
+	AssignmentParamCtx struct{}
+)
+
+// ListAssignments 列出课程中的作业，课程成员均可查看
+func ListAssignments(c *gin.Context, courseID int) ([]Assignment, error) {
+	dep := dependency.FromContext(c)
+	if _, err := courseRole(c, courseID); err != nil {
+		return nil, err
+	}
+
+	assignments, err := dep.CourseClient().ListAssignments(c, courseID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list assignments", err)
+	}
+
+	now := time.Now()
+	return lo.Map(assignments, func(item *ent.Assignment, index int) Assignment {
+		return BuildAssignment(item, dep.HashIDEncoder(), now)
+	}), nil
+}
+
+// Create 创建作业提交箱，提交目录不存在时会自动创建
+func (service *AssignmentService) Create(c *gin.Context, courseID int) (*Assignment, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	if err := managerOnly(c, courseID); err != nil {
+		return nil, err
+	}
+
+	if err := service.validate(); err != nil {
+		return nil, err
+	}
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	// 提交目录必须位于当前课程空间内
+	if uri.FileSystem() != constants.FileSystemCourse || uri.ID("") != hashid.EncodeCourseID(dep.HashIDEncoder(), courseID) ||
+		uri.IsSame(uri.Root(), "") {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Drop-box folder must be inside the course", nil)
+	}
+
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	folder, err := m.Create(c, uri, types.FileTypeFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := dep.CourseClient().CreateAssignment(c, service.parameters(courseID, folder.ID()))
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeObjectExist, "Folder is already used by another assignment", err)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create assignment", err)
+	}
+
+	res := BuildAssignment(a, dep.HashIDEncoder(), time.Now())
+	return &res, nil
+}
+
+// Update 更新作业的截止时间及提交规则
+func (service *AssignmentService) Update(c *gin.Context, id int) (*Assignment, error) {
+	dep := dependency.FromContext(c)
+	existed, err := getAssignment(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := managerOnly(c, existed.CourseID); err != nil {
+		return nil, err
+	}
+
+	if err := service.validate(); err != nil {
+		return nil, err
+	}
+
+	a, err := dep.CourseClient().UpdateAssignment(c, id, service.parameters(existed.CourseID, existed.FolderID))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update assignment", err)
+	}
+
+	res := BuildAssignment(a, dep.HashIDEncoder(), time.Now())
+	return &res, nil
+}
+
+// DeleteAssignment 删除作业，提交目录及已提交的文件会被保留
+func DeleteAssignment(c *gin.Context, id int) error {
+	dep := dependency.FromContext(c)
+	existed, err := getAssignment(c, id)
+	if err != nil {
+		return err
+	}
+
+	if err := managerOnly(c, existed.CourseID); err != nil {
+		return err
+	}
+
+	if err := dep.CourseClient().DeleteAssignment(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete assignment", err)
+	}
+
+	return nil
+}
+
+func (service *AssignmentService) validate() error {
+	if service.LatePolicy == "" {
+		service.LatePolicy = string(assignment.LatePolicyReject)
+	}
+
+	if service.LatePolicy == string(assignment.LatePolicyUntil) {
+		if service.LateDeadline == nil || !service.LateDeadline.After(service.Deadline) {
+			return serializer.NewError(serializer.CodeParamErr, "Late deadline must be after deadline", nil)
+		}
+	} else {
+		service.LateDeadline = nil
+	}
+
+	if service.Settings == nil {
+		service.Settings = &types.AssignmentSetting{}
+	}
+
+	return nil
+}
+
+func (service *AssignmentService) parameters(courseID, folderID int) *inventory.AssignmentParameters {
+	return &inventory.AssignmentParameters{
+		Name:         service.Name,
+		CourseID:     courseID,
+		FolderID:     folderID,
+		Deadline:     service.Deadline,
+		LatePolicy:   assignment.LatePolicy(service.LatePolicy),
+		LateDeadline: service.LateDeadline,
+		Settings:     service.Settings,
+	}
+}
+
+func getAssignment(ctx context.Context, id int) (*ent.Assignment, error) {
+	dep := dependency.FromContext(ctx)
+	a, err := dep.CourseClient().GetAssignment(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeAssignmentNotFound, "", err)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get assignment", err)
+	}
+
+	return a, nil
+}
+
+// courseRole 返回当前用户在课程中的角色，站点管理员视为教师
+func courseRole(ctx context.Context, courseID int) (coursemember.Role, error) {
+	dep := dependency.FromContext(ctx)
+	user := inventory.UserFromContext(ctx)
+	if _, err := dep.CourseClient().GetByID(ctx, courseID); err != nil {
+		if ent.IsNotFound(err) {
+			return "", serializer.NewError(serializer.CodeCourseNotFound, "", err)
+		}
+		return "", serializer.NewError(serializer.CodeDBError, "Failed to get course", err)
+	}
+
+	if user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return coursemember.RoleTeacher, nil
+	}
+
+	member, err := dep.CourseClient().GetMember(ctx, courseID, user.ID)
+	if err != nil {
+		return "", serializer.NewError(serializer.CodeNoPermissionErr, "Not a member of this course", err)
+	}
+
+	return member.Role, nil
+}
+
+// managerOnly 只有教师和助教可以管理作业
+func managerOnly(ctx context.Context, courseID int) error {
+	role, err := courseRole(ctx, courseID)
+	if err != nil {
+		return err
+	}
+
+	if role != coursemember.RoleTeacher && role != coursemember.RoleTa {
+		return serializer.NewError(serializer.CodeNoPermissionErr, "Only teachers can manage assignments", nil)
+	}
+
+	return nil
+}
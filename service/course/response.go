@@ -0,0 +1,37 @@
+package course
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+)
+
+type Assignment struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	FolderID     string                   `json:"folder_id"`
+	Deadline     time.Time                `json:"deadline"`
+	LatePolicy   string                   `json:"late_policy"`
+	LateDeadline *time.Time               `json:"late_deadline,omitempty"`
+	Settings     *types.AssignmentSetting `json:"settings,omitempty"`
+	Accepting    bool                     `json:"accepting"`
+	CreatedAt    time.Time                `json:"created_at"`
+}
+
+func BuildAssignment(a *ent.Assignment, hasher hashid.Encoder, now time.Time) Assignment {
+	accepting, _ := dbfs.AssignmentAccepting(a, now)
+	return Assignment{
+		ID:           hashid.EncodeAssignmentID(hasher, a.ID),
+		Name:         a.Name,
+		FolderID:     hashid.EncodeFileID(hasher, a.FolderID),
+		Deadline:     a.Deadline,
+		LatePolicy:   string(a.LatePolicy),
+		LateDeadline: a.LateDeadline,
+		Settings:     a.Settings,
+		Accepting:    accepting,
+		CreatedAt:    a.CreatedAt,
+	}
+}
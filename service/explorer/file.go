@@ -5,11 +5,14 @@ import (
 	"encoding/gob"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
@@ -173,8 +176,7 @@ func (service *ListFileService) List(c *gin.Context) (*ListResponse, error) {
 		// 获取管理员用户（ID=1）的公共文件夹
 		adminUser, err := dep.UserClient().GetByID(c, 1)
 		if err == nil {
-			adminRoot, err := dep.FileClient().Root(c, adminUser)
-			if err == nil {
+			if _, err := dep.FileClient().Root(c, adminUser); err == nil {
 				// 构建公共文件夹的URI
 				hasher := dep.HashIDEncoder()
 				publicFolderURI := fmt.Sprintf("my://%s/%s", hashid.EncodeUserID(hasher, adminUser.ID), inventory.PublicFolderName)
@@ -313,7 +315,14 @@ func (s *MoveFileService) Move(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeParamErr, "unknown destination uri", err)
 	}
 
-	return m.MoveOrCopy(c, uris, dst, s.Copy)
+	if err := m.MoveOrCopy(c, uris, dst, s.Copy); err != nil {
+		return err
+	}
+
+	if !s.Copy {
+		audit.Record(c, audit.ActionFileMove, audit.WithTarget(strings.Join(s.Uris, "\n")), audit.WithProp("dst", s.Dst))
+	}
+	return nil
 }
 
 type (
@@ -572,6 +581,10 @@ func (s *DeleteFileService) Delete(c *gin.Context) error {
 		return fmt.Errorf("failed to delete file: %w", err)
 	}
 
+	audit.Record(c, audit.ActionFileDelete, audit.WithTarget(strings.Join(s.Uris, "\n")),
+		audit.WithProp("unlink", strconv.FormatBool(s.UnlinkOnly)),
+		audit.WithProp("skip_soft_delete", strconv.FormatBool(s.SkipSoftDelete)),
+	)
 	return nil
 }
 
@@ -592,6 +605,7 @@ func (s *DeleteFileService) Restore(c *gin.Context) error {
 
 	}
 
+	audit.Record(c, audit.ActionFileRestore, audit.WithTarget(strings.Join(s.Uris, "\n")))
 	return nil
 }
 
@@ -689,7 +703,13 @@ func RedirectDirectLink(c *gin.Context, name string, download bool) error {
 		return serializer.NewError(serializer.CodeNotFound, "direct link not found", err)
 	}
 
-	m := manager.NewFileManager(dep, dl.Edges.File.Edges.Owner)
+	// 直链受文件所有者所在用户组的网络限制
+	owner := dl.Edges.File.Edges.Owner
+	if !inventory.IsIPAllowed(owner, c.ClientIP()) {
+		return serializer.NewError(serializer.CodeIPNotAllowed, "Access from current network is not allowed", nil)
+	}
+
+	m := manager.NewFileManager(dep, owner)
 	defer m.Recycle()
 
 	// Request entity URL
@@ -703,7 +723,13 @@ func RedirectDirectLink(c *gin.Context, name string, download bool) error {
 	}
 
 	c.Redirect(http.StatusFound, res)
-	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(earliestExpire.Sub(time.Now()).Seconds())))
+	if group := owner.Edges.Group; group != nil && group.Settings != nil &&
+		(len(group.Settings.IPAllowList) > 0 || len(group.Settings.IPDenyList) > 0) {
+		// 受网络限制的直链不能被共享缓存
+		c.Header("Cache-Control", "private, no-store")
+	} else {
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(earliestExpire.Sub(time.Now()).Seconds())))
+	}
 	return nil
 }
 
@@ -312,9 +312,11 @@ type Share struct {
 	ShowReadMe        bool            `json:"show_readme,omitempty"`
 
 	// Only viewable by owner
-	IsPrivate bool   `json:"is_private,omitempty"`
-	Password  string `json:"password,omitempty"`
-	ShareView bool   `json:"share_view,omitempty"`
+	IsPrivate   bool     `json:"is_private,omitempty"`
+	Password    string   `json:"password,omitempty"`
+	ShareView   bool     `json:"share_view,omitempty"`
+	IPAllowList []string `json:"ip_allow_list,omitempty"`
+	IPDenyList  []string `json:"ip_deny_list,omitempty"`
 
 	// Only viewable if explicitly unlocked by owner
 	SourceUri string `json:"source_uri,omitempty"`
@@ -350,6 +352,10 @@ func BuildShare(s *ent.Share, base *url.URL, hasher hashid.Encoder, requester *e
 	if requester.ID == owner.ID {
 		res.IsPrivate = s.Password != ""
 		res.ShareView = s.Props != nil && s.Props.ShareView
+		if s.Props != nil {
+			res.IPAllowList = s.Props.IPAllowList
+			res.IPDenyList = s.Props.IPDenyList
+		}
 	}
 
 	return &res
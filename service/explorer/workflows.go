@@ -312,6 +312,61 @@ func (service *ImportWorkflowService) CreateImportTask(c *gin.Context) (*TaskRes
 	return BuildTaskResponse(t, nil, hasher), nil
 }
 
+type (
+	RelocateWorkflowService struct {
+		Src         []string `json:"src" binding:"required"`
+		DstPolicyID string   `json:"dst_policy_id" binding:"required"`
+	}
+	CreateRelocateParamCtx struct{}
+)
+
+func (service *RelocateWorkflowService) GetUris() []string {
+	return service.Src
+}
+
+// CreateRelocateTask Create task to relocate files to another storage policy
+func (service *RelocateWorkflowService) CreateRelocateTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	if len(service.Src) == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "No source files", nil)
+	}
+
+	for _, src := range service.Src {
+		if _, err := fs.NewUriFromString(src); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+		}
+	}
+
+	policyID, err := hasher.Decode(service.DstPolicyID, hashid.PolicyID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid storage policy id", err)
+	}
+
+	// Users can only relocate files to the storage policy of their group
+	if policyID != user.Edges.Group.StoragePolicyID && !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Storage policy not available", nil)
+	}
+
+	if _, err := dep.StoragePolicyClient().GetPolicyByID(c, policyID); err != nil {
+		return nil, serializer.NewError(serializer.CodePolicyNotExist, "", err)
+	}
+
+	// Create task
+	t, err := workflows.NewRelocateTask(c, service.Src, policyID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
+
 type (
 	ListTaskService struct {
 		PageSize      int    `form:"page_size" binding:"required,min=10,max=100"`
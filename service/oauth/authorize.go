@@ -0,0 +1,192 @@
+package oauth
+
+import (
+	"encoding/gob"
+	"net/url"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	authorizationCodePrefix = "oauth_code_"
+	// authorizationCodeUsedPrefix 授权码兑换计数，用于原子地判断授权码是否已被使用
+	authorizationCodeUsedPrefix = "oauth_code_used_"
+	// authorizationCodeGrantPrefix 授权码兑换出的授权 ID，授权码被重放时据此吊销
+	authorizationCodeGrantPrefix = "oauth_code_grant_"
+)
+
+func init() {
+	gob.Register(AuthorizationCode{})
+}
+
+// AuthorizationCode 授权码对应的授权请求，存储于 KV 中，仅可兑换一次
+type AuthorizationCode struct {
+	AppID         int
+	UserID        int
+	RedirectURI   string
+	Scopes        []string
+	CodeChallenge string
+}
+
+type (
+	// AuthorizeService 授权请求参数，见 RFC 6749 4.1.1 与 RFC 7636 4.3
+	AuthorizeService struct {
+		ResponseType        string `form:"response_type" json:"response_type" binding:"required"`
+		ClientID            string `form:"client_id" json:"client_id" binding:"required"`
+		RedirectURI         string `form:"redirect_uri" json:"redirect_uri" binding:"required"`
+		Scope               string `form:"scope" json:"scope"`
+		State               string `form:"state" json:"state"`
+		CodeChallenge       string `form:"code_challenge" json:"code_challenge"`
+		CodeChallengeMethod string `form:"code_challenge_method" json:"code_challenge_method"`
+	}
+	AuthorizeParamCtx struct{}
+
+	// AuthorizeInfo 授权确认页所需信息
+	AuthorizeInfo struct {
+		App         AppInfo  `json:"app"`
+		Scopes      []string `json:"scopes"`
+		RedirectURI string   `json:"redirect_uri"`
+		State       string   `json:"state,omitempty"`
+	}
+
+	// AppInfo 向用户展示的第三方应用信息
+	AppInfo struct {
+		ID          int    `json:"id"`
+		ClientID    string `json:"client_id"`
+		Name        string `json:"name"`
+		Homepage    string `json:"homepage,omitempty"`
+		Description string `json:"description,omitempty"`
+	}
+)
+
+func BuildAppInfo(app *ent.OAuthApp) AppInfo {
+	return AppInfo{
+		ID:          app.ID,
+		ClientID:    app.ClientID,
+		Name:        app.Name,
+		Homepage:    app.Homepage,
+		Description: app.Description,
+	}
+}
+
+// Info 校验授权请求并返回授权确认页所需信息
+func (s *AuthorizeService) Info(c *gin.Context) (*AuthorizeInfo, error) {
+	app, scopes, err := s.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorizeInfo{
+		App:         BuildAppInfo(app),
+		Scopes:      scopes,
+		RedirectURI: s.RedirectURI,
+		State:       s.State,
+	}, nil
+}
+
+type (
+	// ApproveAuthorizeService 用户同意或拒绝授权
+	ApproveAuthorizeService struct {
+		AuthorizeService
+		Approved bool `json:"approve"`
+	}
+	ApproveAuthorizeParamCtx struct{}
+
+	// ApproveAuthorizeResponse 前端需跳转到的第三方应用回调地址
+	ApproveAuthorizeResponse struct {
+		RedirectURI string `json:"redirect_uri"`
+	}
+)
+
+// Approve 处理用户的授权决定，返回携带授权码或错误信息的回调地址
+func (s *ApproveAuthorizeService) Approve(c *gin.Context) (*ApproveAuthorizeResponse, error) {
+	dep := dependency.FromContext(c)
+	app, scopes, err := s.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, _ := url.Parse(s.RedirectURI)
+	query := redirect.Query()
+	if s.State != "" {
+		query.Set("state", s.State)
+	}
+
+	if !s.Approved {
+		query.Set("error", "access_denied")
+		redirect.RawQuery = query.Encode()
+		return &ApproveAuthorizeResponse{RedirectURI: redirect.String()}, nil
+	}
+
+	code, err := auth.NewOAuthAuthorizationCode()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate authorization code", err)
+	}
+
+	codeTTL := dep.SettingProvider().OAuthServer(c).CodeTTL
+	if err := dep.KV().Set(authorizationCodePrefix+code, AuthorizationCode{
+		AppID:         app.ID,
+		UserID:        inventory.UserFromContext(c).ID,
+		RedirectURI:   s.RedirectURI,
+		Scopes:        scopes,
+		CodeChallenge: s.CodeChallenge,
+	}, int(codeTTL.Seconds())); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save authorization code", err)
+	}
+
+	query.Set("code", code)
+	redirect.RawQuery = query.Encode()
+	return &ApproveAuthorizeResponse{RedirectURI: redirect.String()}, nil
+}
+
+// validate 校验授权请求，返回应用及最终授予的权限范围
+func (s *AuthorizeService) validate(c *gin.Context) (*ent.OAuthApp, []string, error) {
+	dep := dependency.FromContext(c)
+	app, err := dep.OAuthAppClient().GetAppByClientID(c, s.ClientID)
+	if err != nil || !app.Enabled {
+		return nil, nil, serializer.NewError(serializer.CodeNotFound, "OAuth app not found", err)
+	}
+
+	// 回调地址必须与注册的地址完全一致，校验失败时不能重定向
+	if !lo.Contains(app.RedirectUris, s.RedirectURI) {
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, "Redirect URI is not registered", nil)
+	}
+
+	if s.ResponseType != "code" {
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, "Unsupported response type", nil)
+	}
+
+	if s.CodeChallenge != "" || s.CodeChallengeMethod != "" {
+		if s.CodeChallengeMethod != auth.PKCEMethodS256 {
+			return nil, nil, serializer.NewError(serializer.CodeParamErr, "Unsupported code challenge method", nil)
+		}
+
+		if s.CodeChallenge == "" {
+			return nil, nil, serializer.NewError(serializer.CodeParamErr, "Code challenge is required", nil)
+		}
+	} else if app.SecretHash == "" {
+		// 公开客户端无法保管密钥，必须使用 PKCE
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, "PKCE is required for public clients", nil)
+	}
+
+	scopes := app.Scopes
+	if s.Scope != "" {
+		scopes = lo.Uniq(strings.Fields(s.Scope))
+		if invalid, _ := lo.Difference(scopes, app.Scopes); len(invalid) > 0 {
+			return nil, nil, serializer.NewError(serializer.CodeParamErr, "Scope not allowed: "+strings.Join(invalid, " "), nil)
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, "No scope requested", nil)
+	}
+
+	return app, scopes, nil
+}
@@ -0,0 +1,79 @@
+package oauth
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// AuthorizedApp 用户已授权的第三方应用，同一应用的多次授权合并展示
+type AuthorizedApp struct {
+	App        AppInfo    `json:"app"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// ListAuthorizedApps 列出当前用户已授权的第三方应用
+func ListAuthorizedApps(c *gin.Context) ([]AuthorizedApp, error) {
+	dep := dependency.FromContext(c)
+	grants, err := dep.OAuthAppClient().ListGrantsByUser(c, inventory.UserFromContext(c).ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list authorized apps", err)
+	}
+
+	var (
+		res   []AuthorizedApp
+		index = make(map[int]int)
+	)
+	for _, grant := range grants {
+		if grant.Edges.App == nil {
+			continue
+		}
+
+		i, ok := index[grant.AppID]
+		if !ok {
+			index[grant.AppID] = len(res)
+			res = append(res, AuthorizedApp{
+				App:        BuildAppInfo(grant.Edges.App),
+				Scopes:     grant.Scopes,
+				CreatedAt:  grant.CreatedAt,
+				LastUsedAt: grant.LastUsedAt,
+			})
+			continue
+		}
+
+		app := &res[i]
+		app.Scopes = lo.Union(app.Scopes, grant.Scopes)
+		if grant.CreatedAt.Before(app.CreatedAt) {
+			app.CreatedAt = grant.CreatedAt
+		}
+		if grant.LastUsedAt != nil && (app.LastUsedAt == nil || grant.LastUsedAt.After(*app.LastUsedAt)) {
+			app.LastUsedAt = grant.LastUsedAt
+		}
+	}
+
+	return res, nil
+}
+
+type (
+	// RevokeAppService 撤销对第三方应用的授权
+	RevokeAppService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	RevokeAppParamCtx struct{}
+)
+
+// Revoke 撤销当前用户对指定应用的所有授权，已签发的令牌立即失效
+func (s *RevokeAppService) Revoke(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.OAuthAppClient().DeleteGrants(c, inventory.UserFromContext(c).ID, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke authorization", err)
+	}
+
+	return nil
+}
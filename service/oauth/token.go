@@ -0,0 +1,320 @@
+package oauth
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+)
+
+// Error is an OAuth2 error response defined in RFC 6749 5.2. Token and introspection endpoints
+// respond with it instead of serializer.Response.
+type Error struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func newError(status int, code, description string) *Error {
+	return &Error{Status: status, Code: code, Description: description}
+}
+
+var (
+	errInvalidClient = newError(http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+	errInvalidGrant  = newError(http.StatusBadRequest, "invalid_grant", "Authorization grant is invalid, expired or revoked")
+)
+
+// ClientCredentials 客户端认证信息，支持 HTTP Basic 与表单参数两种方式
+type ClientCredentials struct {
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// authenticate 认证客户端。公开客户端不需要密钥，requireSecret 为 true 时仅允许机密客户端。
+func (s *ClientCredentials) authenticate(c *gin.Context, requireSecret bool) (*ent.OAuthApp, error) {
+	if id, secret, ok := c.Request.BasicAuth(); ok {
+		s.ClientID, s.ClientSecret = id, secret
+	}
+
+	if s.ClientID == "" {
+		return nil, errInvalidClient
+	}
+
+	dep := dependency.FromContext(c)
+	app, err := dep.OAuthAppClient().GetAppByClientID(c, s.ClientID)
+	if err != nil || !app.Enabled {
+		return nil, errInvalidClient
+	}
+
+	if app.SecretHash == "" {
+		if requireSecret {
+			return nil, errInvalidClient
+		}
+
+		return app, nil
+	}
+
+	if s.ClientSecret == "" || !auth.VerifyTokenHash(s.ClientSecret, app.SecretHash) {
+		return nil, errInvalidClient
+	}
+
+	return app, nil
+}
+
+type (
+	// TokenService 令牌端点请求参数，见 RFC 6749 4.1.3 与 6
+	TokenService struct {
+		ClientCredentials
+		GrantType    string `form:"grant_type" binding:"required"`
+		Code         string `form:"code"`
+		RedirectURI  string `form:"redirect_uri"`
+		CodeVerifier string `form:"code_verifier"`
+		RefreshToken string `form:"refresh_token"`
+	}
+
+	// TokenResponse 令牌端点成功响应，见 RFC 6749 5.1
+	TokenResponse struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int    `json:"expires_in"`
+		RefreshToken string `json:"refresh_token"`
+		Scope        string `json:"scope"`
+	}
+)
+
+// Token 兑换授权码或刷新令牌
+func (s *TokenService) Token(c *gin.Context) (*TokenResponse, error) {
+	app, err := s.authenticate(c, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.GrantType {
+	case GrantTypeAuthorizationCode:
+		return s.exchangeCode(c, app)
+	case GrantTypeRefreshToken:
+		return s.refresh(c, app)
+	default:
+		return nil, newError(http.StatusBadRequest, "unsupported_grant_type", "")
+	}
+}
+
+func (s *TokenService) exchangeCode(c *gin.Context, app *ent.OAuthApp) (*TokenResponse, error) {
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+
+	if s.Code == "" {
+		return nil, newError(http.StatusBadRequest, "invalid_request", "Missing code")
+	}
+
+	codeRaw, ok := kv.Get(authorizationCodePrefix + s.Code)
+	if !ok {
+		return nil, errInvalidGrant
+	}
+
+	// 授权码只能使用一次，无论兑换是否成功。先原子地标记为已使用，重放时吊销已签发的授权（RFC 6749 4.1.2）
+	usedTTL := int(dep.SettingProvider().OAuthServer(c).RefreshTokenTTL.Seconds())
+	if used, err := kv.Incr(authorizationCodeUsedPrefix+s.Code, usedTTL); err != nil || used > 1 {
+		revokeCodeGrant(c, s.Code)
+		return nil, errInvalidGrant
+	}
+
+	_ = kv.Delete(authorizationCodePrefix, s.Code)
+	code := codeRaw.(AuthorizationCode)
+	if code.AppID != app.ID || code.RedirectURI != s.RedirectURI {
+		return nil, errInvalidGrant
+	}
+
+	if code.CodeChallenge != "" && !auth.VerifyPKCE(s.CodeVerifier, code.CodeChallenge) {
+		return nil, newError(http.StatusBadRequest, "invalid_grant", "Code verifier mismatch")
+	}
+
+	if _, err := dep.UserClient().GetActiveByID(c, code.UserID); err != nil {
+		return nil, errInvalidGrant
+	}
+
+	accessToken, accessHash, refreshToken, refreshHash, err := auth.NewOAuthTokenPair()
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	ttl := dep.SettingProvider().OAuthServer(c)
+	now := time.Now()
+	grant, err := dep.OAuthAppClient().CreateGrant(c, &inventory.OAuthGrantArgs{
+		UserID:           code.UserID,
+		AppID:            app.ID,
+		Scopes:           code.Scopes,
+		AccessTokenHash:  accessHash,
+		AccessExpiresAt:  now.Add(ttl.AccessTokenTTL),
+		RefreshTokenHash: refreshHash,
+		RefreshExpiresAt: now.Add(ttl.RefreshTokenTTL),
+	})
+	if err != nil {
+		dep.Logger().Warning("Failed to create OAuth grant: %s", err)
+		return nil, newError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	if err := kv.Set(authorizationCodeGrantPrefix+s.Code, grant.ID, usedTTL); err != nil {
+		dep.Logger().Warning("Failed to save grant of authorization code: %s", err)
+	}
+
+	// 签发期间授权码已被重放，重放方已看不到授权 ID，由本次兑换自行吊销
+	if used, ok := kv.Get(authorizationCodeUsedPrefix + s.Code); !ok || used != 1 {
+		revokeCodeGrant(c, s.Code)
+		return nil, errInvalidGrant
+	}
+
+	return &TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(ttl.AccessTokenTTL.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(code.Scopes, " "),
+	}, nil
+}
+
+// revokeCodeGrant 吊销由授权码兑换出的授权
+func revokeCodeGrant(c *gin.Context, code string) {
+	dep := dependency.FromContext(c)
+	grantRaw, ok := dep.KV().Get(authorizationCodeGrantPrefix + code)
+	grantID, isInt := grantRaw.(int)
+	if !ok || !isInt {
+		return
+	}
+
+	if err := dep.OAuthAppClient().DeleteGrant(c, grantID); err != nil {
+		dep.Logger().Warning("Failed to revoke OAuth grant %d of replayed authorization code: %s", grantID, err)
+	}
+}
+
+func (s *TokenService) refresh(c *gin.Context, app *ent.OAuthApp) (*TokenResponse, error) {
+	dep := dependency.FromContext(c)
+	oauthClient := dep.OAuthAppClient()
+
+	if s.RefreshToken == "" {
+		return nil, newError(http.StatusBadRequest, "invalid_request", "Missing refresh token")
+	}
+
+	grant, err := oauthClient.GetGrantByRefreshToken(c, auth.HashToken(s.RefreshToken))
+	if err != nil || grant.AppID != app.ID || grant.RefreshExpiresAt.Before(time.Now()) {
+		return nil, errInvalidGrant
+	}
+
+	if _, err := dep.UserClient().GetActiveByID(c, grant.UserID); err != nil {
+		return nil, errInvalidGrant
+	}
+
+	// 刷新令牌轮换，旧的令牌对随即失效
+	accessToken, accessHash, refreshToken, refreshHash, err := auth.NewOAuthTokenPair()
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	ttl := dep.SettingProvider().OAuthServer(c)
+	now := time.Now()
+	if _, err := oauthClient.RotateGrant(c, grant.ID, &inventory.OAuthGrantArgs{
+		AccessTokenHash:  accessHash,
+		AccessExpiresAt:  now.Add(ttl.AccessTokenTTL),
+		RefreshTokenHash: refreshHash,
+		RefreshExpiresAt: now.Add(ttl.RefreshTokenTTL),
+	}); err != nil {
+		dep.Logger().Warning("Failed to rotate OAuth grant %d: %s", grant.ID, err)
+		return nil, newError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	return &TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(ttl.AccessTokenTTL.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(grant.Scopes, " "),
+	}, nil
+}
+
+type (
+	// IntrospectService 令牌内省请求参数，见 RFC 7662 2.1
+	IntrospectService struct {
+		ClientCredentials
+		Token         string `form:"token" binding:"required"`
+		TokenTypeHint string `form:"token_type_hint"`
+	}
+
+	// IntrospectResponse 令牌内省响应，见 RFC 7662 2.2
+	IntrospectResponse struct {
+		Active    bool   `json:"active"`
+		Scope     string `json:"scope,omitempty"`
+		ClientID  string `json:"client_id,omitempty"`
+		Username  string `json:"username,omitempty"`
+		Subject   string `json:"sub,omitempty"`
+		TokenType string `json:"token_type,omitempty"`
+		ExpiresAt int64  `json:"exp,omitempty"`
+		IssuedAt  int64  `json:"iat,omitempty"`
+	}
+)
+
+// Introspect 查询令牌状态，仅机密客户端可调用，且只能查询签发给自身的令牌
+func (s *IntrospectService) Introspect(c *gin.Context) (*IntrospectResponse, error) {
+	app, err := s.authenticate(c, true)
+	if err != nil {
+		return nil, err
+	}
+
+	dep := dependency.FromContext(c)
+	oauthClient := dep.OAuthAppClient()
+	inactive := &IntrospectResponse{Active: false}
+
+	var (
+		grant     *ent.OAuthGrant
+		expiresAt time.Time
+		tokenType string
+	)
+	switch {
+	case strings.HasPrefix(s.Token, auth.OAuthAccessTokenPrefix):
+		grant, err = oauthClient.GetGrantByAccessToken(c, auth.HashToken(s.Token))
+		if grant != nil {
+			expiresAt, tokenType = grant.AccessExpiresAt, "Bearer"
+		}
+	case strings.HasPrefix(s.Token, auth.OAuthRefreshTokenPrefix):
+		grant, err = oauthClient.GetGrantByRefreshToken(c, auth.HashToken(s.Token))
+		if grant != nil {
+			expiresAt, tokenType = grant.RefreshExpiresAt, GrantTypeRefreshToken
+		}
+	default:
+		return inactive, nil
+	}
+
+	if err != nil || grant.AppID != app.ID || expiresAt.Before(time.Now()) {
+		return inactive, nil
+	}
+
+	u, err := dep.UserClient().GetActiveByID(c, grant.UserID)
+	if err != nil {
+		return inactive, nil
+	}
+
+	return &IntrospectResponse{
+		Active:    true,
+		Scope:     strings.Join(grant.Scopes, " "),
+		ClientID:  app.ClientID,
+		Username:  u.Email,
+		Subject:   hashid.EncodeUserID(dep.HashIDEncoder(), u.ID),
+		TokenType: tokenType,
+		ExpiresAt: expiresAt.Unix(),
+		IssuedAt:  grant.UpdatedAt.Unix(),
+	}, nil
+}
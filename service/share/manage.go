@@ -8,23 +8,29 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 type (
 	// ShareCreateService 创建新分享服务
 	ShareCreateService struct {
-		Uri             string `json:"uri" binding:"required"`
-		IsPrivate       bool   `json:"is_private"`
-		Password        string `json:"password" binding:"omitempty,max=32,alphanum"`
-		RemainDownloads int    `json:"downloads"`
-		Expire          int    `json:"expire"`
-		ShareView       bool   `json:"share_view"`
-		ShowReadMe      bool   `json:"show_readme"`
+		Uri             string   `json:"uri" binding:"required"`
+		IsPrivate       bool     `json:"is_private"`
+		Password        string   `json:"password" binding:"omitempty,max=32,alphanum"`
+		RemainDownloads int      `json:"downloads"`
+		Expire          int      `json:"expire"`
+		ShareView       bool     `json:"share_view"`
+		ShowReadMe      bool     `json:"show_readme"`
+		IPAllowList     []string `json:"ip_allow_list" binding:"max=100"`
+		IPDenyList      []string `json:"ip_deny_list" binding:"max=100"`
 	}
 	ShareCreateParamCtx struct{}
 )
@@ -46,6 +52,12 @@ func (service *ShareCreateService) Upsert(c *gin.Context, existed int) (string,
 		return "", serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
 	}
 
+	for _, list := range [][]string{service.IPAllowList, service.IPDenyList} {
+		if err := util.ValidateCIDRList(list); err != nil {
+			return "", serializer.NewError(serializer.CodeParamErr, err.Error(), nil)
+		}
+	}
+
 	var expires *time.Time
 	if service.Expire > 0 {
 		expires = new(time.Time)
@@ -60,11 +72,17 @@ func (service *ShareCreateService) Upsert(c *gin.Context, existed int) (string,
 		ExistedShareID:  existed,
 		ShareView:       service.ShareView,
 		ShowReadMe:      service.ShowReadMe,
+		IPAllowList:     service.IPAllowList,
+		IPDenyList:      service.IPDenyList,
 	})
 	if err != nil {
 		return "", err
 	}
 
+	audit.Record(c, lo.Ternary(existed == 0, audit.ActionShareCreate, audit.ActionShareUpdate),
+		audit.WithTarget(hashid.EncodeShareID(dep.HashIDEncoder(), share.ID)),
+		audit.WithProp("uri", service.Uri),
+	)
 	base := dep.SettingProvider().SiteURL(c)
 	return explorer.BuildShareLink(share, dep.HashIDEncoder(), base, true), nil
 }
@@ -92,5 +110,7 @@ func DeleteShare(c *gin.Context, shareId int) error {
 		return serializer.NewError(serializer.CodeDBError, "Failed to delete share", err)
 	}
 
+	audit.Record(c, audit.ActionShareDelete, audit.WithTarget(hashid.EncodeShareID(dep.HashIDEncoder(), share.ID)))
+
 	return nil
 }
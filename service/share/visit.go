@@ -76,6 +76,10 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 		return nil, serializer.NewError(serializer.CodeNotFound, "Share link expired", err)
 	}
 
+	if share.Edges.User.ID != u.ID && !inventory.IsShareIPAllowed(share, c.ClientIP()) {
+		return nil, serializer.NewError(serializer.CodeIPNotAllowed, "Access from current network is not allowed", nil)
+	}
+
 	if s.CountViews {
 		_ = shareClient.Viewed(c, share)
 	}
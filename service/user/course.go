@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// ListMyCourses 列出当前用户加入的课程空间
+func ListMyCourses(c *gin.Context) ([]Course, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	ctx := context.WithValue(c, inventory.LoadCourseMemberCourse{}, true)
+	members, err := dep.CourseClient().ListByUser(ctx, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list courses", err)
+	}
+
+	return lo.FilterMap(members, func(m *ent.CourseMember, _ int) (Course, bool) {
+		if m.Edges.Course == nil {
+			return Course{}, false
+		}
+		return BuildCourse(m.Edges.Course, m.Role, hasher), true
+	}), nil
+}
@@ -0,0 +1,181 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/lockout"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ldap"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sso"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// loginWithLDAP 使用 LDAP 验证用户名密码并返回对应的本地用户，首次登录时自动创建用户。
+// LDAP 凭据无效或服务器不可用时返回 nil，由调用方决定是否回退到本地密码。
+func loginWithLDAP(c *gin.Context, config *setting.LDAP, limiter *lockout.Limiter, username, password string) (*ent.User, error) {
+	l := logging.FromContext(c)
+	var lockErr error
+	entry, err := ldap.AuthenticateWith(c, config, username, password, func(entry *ldap.Entry) error {
+		// 绑定前检查关联的本地账号是否被锁定，被锁定的账号不再向 LDAP 校验密码
+		lockErr = checkLDAPLockout(c, config, limiter, entry)
+		return lockErr
+	})
+	if lockErr != nil {
+		audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(username))
+		return nil, lockErr
+	}
+
+	if err != nil {
+		if !errors.Is(err, ldap.ErrInvalidCredentials) {
+			l.Warning("LDAP authentication of %q failed: %s", username, err)
+		}
+		return nil, nil
+	}
+
+	subject := ldap.Subject(config, entry)
+	if subject == "" {
+		l.Warning("LDAP entry %q has no subject attribute %q.", entry.DN, config.SubjectAttr)
+		return nil, nil
+	}
+
+	profile := ldapProfile(config, entry)
+	u, err := resolveLDAPUser(c, config, subject, username, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncLDAPGroup(c, config, u, profile.GroupID)
+}
+
+// checkLDAPLockout 检查 LDAP 条目关联或按邮箱关联的本地账号是否被锁定
+func checkLDAPLockout(c *gin.Context, config *setting.LDAP, limiter *lockout.Limiter, entry *ldap.Entry) error {
+	dep := dependency.FromContext(c)
+	if subject := ldap.Subject(config, entry); subject != "" {
+		linked, err := dep.ExternalIdentityClient().GetBySubject(c, ldap.IdentityProvider, subject)
+		if err == nil {
+			return limiter.Check(lockout.User(linked.UserID))
+		}
+	}
+
+	if config.LinkByEmail {
+		if mail := entry.First(config.Attributes.Email); mail != "" {
+			if u, err := dep.UserClient().GetByEmail(c, mail); err == nil {
+				return limiter.Check(lockout.User(u.ID))
+			}
+		}
+	}
+
+	return nil
+}
+
+// localPasswordAllowed 仅使用 LDAP 认证时，只有管理员可以使用本地密码登录，防止 LDAP 不可用时无法登录后台
+func localPasswordAllowed(config *setting.LDAP, u *ent.User) bool {
+	if config.Mode != setting.LDAPModeExclusive {
+		return true
+	}
+
+	return u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
+}
+
+// resolveLDAPUser 查找 LDAP 账号关联的用户，未关联时按邮箱关联已有用户或创建新用户
+func resolveLDAPUser(c *gin.Context, config *setting.LDAP, subject, username string, profile *sso.Profile) (*ent.User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	identityClient := dep.ExternalIdentityClient()
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+
+	linked, err := identityClient.GetBySubject(c, ldap.IdentityProvider, subject)
+	if err == nil {
+		u, err := userClient.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+
+		return u, nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to query linked identity", err)
+	}
+
+	if config.LinkByEmail && profile.Email != "" {
+		u, err := userClient.GetByEmail(ctx, profile.Email)
+		if err == nil {
+			return u, linkExternalIdentity(c, identityClient, u, ldap.IdentityProvider, subject)
+		}
+
+		if !ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to query user", err)
+		}
+	}
+
+	university, major, err := canonicalizeProfile(c, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Nick == "" && profile.StudentID == "" {
+		profile.Nick = username
+	}
+
+	return provisionUser(c, ldap.IdentityProvider, subject, profile, university, major)
+}
+
+// syncLDAPGroup 将用户组同步为 LDAP 组映射的用户组。未匹配任何规则时，由映射分配的用户组会被重置为默认用户组；
+// 管理员不参与同步，避免误降权。
+func syncLDAPGroup(c *gin.Context, config *setting.LDAP, u *ent.User, groupID int) (*ent.User, error) {
+	if len(config.GroupMapping) == 0 || u.ID == 1 ||
+		(u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))) {
+		return u, nil
+	}
+
+	dep := dependency.FromContext(c)
+	if groupID == 0 {
+		if !lo.ContainsBy(config.GroupMapping, func(rule setting.LDAPGroupMapping) bool {
+			return rule.GroupID == u.GroupUsers
+		}) {
+			return u, nil
+		}
+		groupID = dep.SettingProvider().DefaultGroup(c)
+	}
+
+	if groupID == u.GroupUsers {
+		return u, nil
+	}
+
+	userClient := dep.UserClient()
+	if _, err := userClient.UpdateProfile(c, u, &inventory.NewUserArgs{GroupID: groupID}); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to sync user group", err)
+	}
+
+	logging.FromContext(c).Info("Group of user %d synced to %d from LDAP.", u.ID, groupID)
+	return userClient.GetByID(context.WithValue(c, inventory.LoadUserGroup{}, true), u.ID)
+}
+
+// ldapProfile 按属性映射从 LDAP 条目中读取用户资料
+func ldapProfile(config *setting.LDAP, entry *ldap.Entry) *sso.Profile {
+	mapping := config.Attributes
+	profile := &sso.Profile{
+		StudentID:  entry.First(mapping.StudentID),
+		University: entry.First(mapping.University),
+		Major:      entry.First(mapping.Major),
+		Nick:       entry.First(mapping.Nick),
+		Email:      entry.First(mapping.Email),
+		GroupID:    ldap.MapGroup(config, entry),
+	}
+
+	if profile.University == "" {
+		profile.University = config.DefaultUniversity
+	}
+
+	return profile
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/lockout"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
+	"github.com/gin-gonic/gin"
+)
+
+// isInvalidPassword 是否为用户名或密码错误，其余登录失败原因（如账号被封禁）不计入失败次数
+func isInvalidPassword(err error) bool {
+	var appErr serializer.AppError
+	return errors.As(err, &appErr) && appErr.Code == serializer.CodeInvalidPassword
+}
+
+// recordLoginFailure 记录一次密码登录失败，u 为空表示用户不存在。账号或 IP 因此被锁定时
+// 写入审计日志，并通知账号所有者。
+func recordLoginFailure(c *gin.Context, limiter *lockout.Limiter, u *ent.User) {
+	subjects := []lockout.Subject{lockout.IP(c.ClientIP())}
+	if u != nil {
+		subjects = append(subjects, lockout.User(u.ID))
+	}
+
+	for _, locked := range limiter.Fail(subjects...) {
+		opts := []audit.Option{
+			audit.WithTarget(locked.Subject().String()),
+			audit.WithProp("until", locked.Until.Format(time.RFC3339)),
+		}
+		if locked.Kind == lockout.KindUser {
+			opts = append(opts, audit.WithUser(u.ID))
+			notifyLockout(c, u, locked)
+		}
+
+		audit.Record(c, audit.ActionLoginLocked, opts...)
+	}
+}
+
+// notifyLockout 通过邮件和短信通知账号所有者账号已被锁定。通知在后台发送，不阻塞登录请求，
+// 发送失败仅记录日志
+func notifyLockout(c *gin.Context, u *ent.User, locked lockout.Locked) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider()
+	if !settings.LoginLimit(c).Notify {
+		return
+	}
+
+	l := dep.Logger()
+	var title, body string
+	if u.Email != "" {
+		resetUrl, err := newResetUrl(c, u)
+		if err == nil {
+			title, body, err = email.NewLockoutEmail(c, settings, u, resetUrl, c.ClientIP(), locked.Until)
+		}
+
+		if err != nil {
+			l.Warning("Failed to build lockout email for user %d: %s", u.ID, err)
+		}
+	}
+
+	notifier, _ := dep.SMSProvider(c).(sms.Notifier)
+	message := fmt.Sprintf("【%s】你的账号因多次登录失败已被锁定至 %s，如非本人操作请及时重设密码。",
+		settings.SiteBasic(c).Name, locked.Until.Format("2006-01-02 15:04"))
+	emailClient := dep.EmailClient(c)
+
+	// 请求结束后 gin.Context 会被复用，后台发送使用不会被取消的请求上下文
+	ctx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		if body != "" {
+			if err := emailClient.Send(ctx, u.Email, title, body); err != nil {
+				l.Warning("Failed to send lockout email to user %d: %s", u.ID, err)
+			}
+		}
+
+		if u.Phone != "" && notifier != nil {
+			if err := notifier.Notify(ctx, u.Phone, message); err != nil {
+				l.Warning("Failed to send lockout SMS to user %d: %s", u.ID, err)
+			}
+		}
+	}()
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,30 +10,36 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/lockout"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/pquerna/otp/totp"
+	"github.com/samber/lo"
 )
 
 // LoginParameterCtx define key fore UserLoginService
 type LoginParameterCtx struct{}
 
-// UserLoginService 管理用户登录的服务（支持邮箱和手机号登录）
+// UserLoginService 管理用户登录的服务（支持邮箱、手机号和学号登录）
 type UserLoginService struct {
-	UserName string `form:"email" json:"email"` // 可以是邮箱或手机号
-	Password string `form:"password" json:"password" binding:"required,min=4,max=128"`
+	UserName   string `form:"email" json:"email"` // 可以是邮箱、手机号或学号
+	Password   string `form:"password" json:"password" binding:"required,min=4,max=128"`
+	University string `form:"university" json:"university"` // 使用学号登录时所在院校，学号在多个院校中重复时必填
 }
 
 type (
 	// UserResetService 密码重设服务
 	UserResetService struct {
-		Password string `form:"password" json:"password" binding:"required,min=6,max=128"`
+		Password string `form:"password" json:"password" binding:"required,max=128"`
 		Secret   string `json:"secret" binding:"required"`
 	}
 	UserResetParameterCtx struct{}
@@ -50,15 +57,20 @@ func (service *UserResetService) Reset(c *gin.Context) (*User, error) {
 		return nil, serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
 	}
 
-	if err := kv.Delete(fmt.Sprintf("user_reset_%d", uid)); err != nil {
-		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to delete reset session", err)
-	}
-
 	u, err := userClient.GetActiveByID(c, uid)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
 	}
 
+	// 新密码不满足密码策略时保留重设链接，以便用户重新提交
+	if err := passwordpolicy.NewChecker(dep.SettingProvider().PasswordPolicy(c)).Check(service.Password, u); err != nil {
+		return nil, err
+	}
+
+	if err := kv.Delete(fmt.Sprintf("user_reset_%d", uid)); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to delete reset session", err)
+	}
+
 	u, err = userClient.UpdatePassword(c, u, service.Password)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to update password", err)
@@ -97,19 +109,12 @@ func (service *UserResetEmailService) Reset(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeUserNotActivated, "This user is not activated", nil)
 	}
 
-	secret := util.RandStringRunes(32)
-	if err := dep.KV().Set(fmt.Sprintf("%s%d", userResetPrefix, u.ID), secret, 3600); err != nil {
-		return serializer.NewError(serializer.CodeInternalSetting, "Failed to create reset session", err)
+	resetUrl, err := newResetUrl(c, u)
+	if err != nil {
+		return err
 	}
 
-	base := dep.SettingProvider().SiteURL(c)
-	resetUrl := routes.MasterUserResetUrl(base)
-	queries := resetUrl.Query()
-	queries.Add("id", hashid.EncodeUserID(dep.HashIDEncoder(), u.ID))
-	queries.Add("secret", secret)
-	resetUrl.RawQuery = queries.Encode()
-
-	title, body, err := email.NewResetEmail(c, dep.SettingProvider(), u, resetUrl.String())
+	title, body, err := email.NewResetEmail(c, dep.SettingProvider(), u, resetUrl)
 	if err != nil {
 		return serializer.NewError(serializer.CodeFailedSendEmail, "Failed to send activation email", err)
 	}
@@ -121,28 +126,82 @@ func (service *UserResetEmailService) Reset(c *gin.Context) error {
 	return nil
 }
 
+// newResetUrl 创建有效期 1 小时的密码重设会话，返回重设页面地址
+func newResetUrl(c *gin.Context, u *ent.User) (string, error) {
+	dep := dependency.FromContext(c)
+	secret := util.RandStringRunes(32)
+	if err := dep.KV().Set(fmt.Sprintf("%s%d", userResetPrefix, u.ID), secret, 3600); err != nil {
+		return "", serializer.NewError(serializer.CodeInternalSetting, "Failed to create reset session", err)
+	}
+
+	base := dep.SettingProvider().SiteURL(c)
+	resetUrl := routes.MasterUserResetUrl(base)
+	queries := resetUrl.Query()
+	queries.Add("id", hashid.EncodeUserID(dep.HashIDEncoder(), u.ID))
+	queries.Add("secret", secret)
+	resetUrl.RawQuery = queries.Encode()
+	return resetUrl.String(), nil
+}
+
 // Login 用户登录函数
 func (service *UserLoginService) Login(c *gin.Context) (*ent.User, string, error) {
 	dep := dependency.FromContext(c)
-	userClient := dep.UserClient()
 
 	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
-	
-	// 判断是手机号还是邮箱
-	var expectedUser *ent.User
-	var err error
-	
-	// 简单判断：如果包含@则是邮箱，否则是手机号
-	if strings.Contains(service.UserName, "@") {
-		expectedUser, err = userClient.GetByEmail(ctx, service.UserName)
-	} else {
-		expectedUser, err = userClient.GetByPhone(ctx, service.UserName)
+
+	// 登录失败次数过多的 IP 暂时不再校验密码
+	limiter := lockout.NewLimiter(dep.KV(), dep.SettingProvider().LoginLimit(c))
+	if err := limiter.Check(lockout.IP(c.ClientIP())); err != nil {
+		audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(service.UserName))
+		return nil, "", err
+	}
+
+	// 先按登录名查找本地账号，被锁定的账号在校验任何密码（包括 LDAP）之前即被拒绝
+	expectedUser, err := service.findUser(ctx, dep)
+	if err == nil {
+		if lockErr := limiter.Check(lockout.User(expectedUser.ID)); lockErr != nil {
+			audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(service.UserName), audit.WithUser(expectedUser.ID))
+			return nil, "", lockErr
+		}
+	}
+
+	// 启用 LDAP 时优先使用 LDAP 验证密码
+	ldapSettings := dep.SettingProvider().LDAP(c)
+	ldapAuthenticated := false
+	if ldapSettings.Mode != setting.LDAPModeDisabled {
+		ldapUser, ldapErr := loginWithLDAP(c, ldapSettings, limiter, service.UserName, service.Password)
+		if ldapErr != nil {
+			return nil, "", ldapErr
+		}
+		if ldapUser != nil {
+			expectedUser, err, ldapAuthenticated = ldapUser, nil, true
+		}
+	}
+
+	// 学号查找失败的原因（如学号在多个院校中重复）直接返回
+	var appErr serializer.AppError
+	if !ldapAuthenticated && errors.As(err, &appErr) {
+		audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(service.UserName))
+		if isInvalidPassword(err) {
+			recordLoginFailure(c, limiter, nil)
+		}
+		return nil, "", err
+	}
+
+	// 被锁定或需要等待的账号无论密码是否正确均拒绝登录
+	if ldapAuthenticated {
+		if lockErr := limiter.Check(lockout.User(expectedUser.ID)); lockErr != nil {
+			audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(service.UserName), audit.WithUser(expectedUser.ID))
+			return nil, "", lockErr
+		}
 	}
 
 	// 一系列校验
 	if err != nil {
 		err = serializer.NewError(serializer.CodeInvalidPassword, "用户名或密码错误", err)
-	} else if checkErr := inventory.CheckPassword(expectedUser, service.Password); checkErr != nil {
+	} else if !ldapAuthenticated && !localPasswordAllowed(ldapSettings, expectedUser) {
+		err = serializer.NewError(serializer.CodeInvalidPassword, "用户名或密码错误", nil)
+	} else if !ldapAuthenticated && inventory.CheckPassword(expectedUser, service.Password) != nil {
 		err = serializer.NewError(serializer.CodeInvalidPassword, "用户名或密码错误", err)
 	} else if expectedUser.Status == user.StatusManualBanned || expectedUser.Status == user.StatusSysBanned {
 		err = serializer.NewError(serializer.CodeUserBaned, "This account has been blocked", nil)
@@ -151,16 +210,93 @@ func (service *UserLoginService) Login(c *gin.Context) (*ent.User, string, error
 	}
 
 	if err != nil {
+		opts := []audit.Option{audit.WithTarget(service.UserName)}
+		if expectedUser != nil {
+			opts = append(opts, audit.WithUser(expectedUser.ID))
+		}
+		audit.Record(c, audit.ActionLoginFailed, opts...)
+		if isInvalidPassword(err) {
+			recordLoginFailure(c, limiter, expectedUser)
+		}
 		return nil, "", err
 	}
 
-	if expectedUser.TwoFactorSecret != "" {
-		twoFaSessionID := uuid.Must(uuid.NewV4())
-		dep.KV().Set(fmt.Sprintf("user_2fa_%s", twoFaSessionID), expectedUser.ID, 600)
-		return expectedUser, twoFaSessionID.String(), nil
+	limiter.Succeed(lockout.User(expectedUser.ID))
+	twoFaSession, err := newTwoFASession(c, expectedUser)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return expectedUser, "", nil
+	return expectedUser, twoFaSession, nil
+}
+
+// newTwoFASession 已启用二步验证（TOTP 或通行密钥）的用户在任何登录方式下都需完成第二步验证，
+// 返回二步验证会话 ID；未启用时返回空字符串
+func newTwoFASession(c *gin.Context, u *ent.User) (string, error) {
+	dep := dependency.FromContext(c)
+	enrolled, err := dep.UserClient().TwoFAEnrolled(c, u)
+	if err != nil {
+		return "", serializer.NewError(serializer.CodeDBError, "Failed to check 2FA enrollment", err)
+	}
+
+	if !enrolled {
+		return "", nil
+	}
+
+	twoFaSessionID := uuid.Must(uuid.NewV4()).String()
+	if err := dep.KV().Set(fmt.Sprintf("user_2fa_%s", twoFaSessionID), u.ID, 600); err != nil {
+		return "", serializer.NewError(serializer.CodeInternalSetting, "Failed to create 2FA session", err)
+	}
+
+	return twoFaSessionID, nil
+}
+
+// findUser 按登录名查找本地用户：包含@则是邮箱，指定院校时为学号，否则优先按手机号查找，找不到再按学号查找
+func (service *UserLoginService) findUser(ctx context.Context, dep dependency.Dep) (*ent.User, error) {
+	userClient := dep.UserClient()
+	if strings.Contains(service.UserName, "@") {
+		return userClient.GetByEmail(ctx, service.UserName)
+	}
+
+	if service.University != "" {
+		// 院校名称可能是别名，映射为目录中的规范名称后再查找
+		university, _, err := dep.InstitutionClient().Canonicalize(ctx, service.University, "", false)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to query institution directory", err)
+		}
+		return userClient.GetByStudentID(ctx, university, service.UserName)
+	}
+
+	u, err := userClient.GetByPhone(ctx, service.UserName)
+	if ent.IsNotFound(err) {
+		return service.findByStudentID(ctx, userClient)
+	}
+
+	return u, err
+}
+
+// findByStudentID 学号仅在院校内唯一，未指定院校时只有唯一匹配才允许登录
+func (service *UserLoginService) findByStudentID(ctx context.Context, userClient inventory.UserClient) (*ent.User, error) {
+	users, err := userClient.ListByStudentID(ctx, service.UserName)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to query user", err)
+	}
+
+	if len(users) > 1 {
+		// 仅保留密码匹配的用户，避免泄露学号是否在其他院校存在
+		users = lo.Filter(users, func(u *ent.User, _ int) bool {
+			return inventory.CheckPassword(u, service.Password) == nil
+		})
+	}
+
+	switch len(users) {
+	case 0:
+		return nil, serializer.NewError(serializer.CodeInvalidPassword, "用户名或密码错误", nil)
+	case 1:
+		return users[0], nil
+	default:
+		return nil, serializer.NewError(serializer.CodeStudentIDAmbiguous, "该学号存在于多个院校，请选择院校后登录", nil)
+	}
 }
 
 type (
@@ -175,10 +311,22 @@ func IssueToken(c *gin.Context) (*BuiltinLoginResponse, error) {
 		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to issue token pair", err)
 	}
 
-	return &BuiltinLoginResponse{
+	res := &BuiltinLoginResponse{
 		User:  BuildUser(u, dep.HashIDEncoder()),
 		Token: *token,
-	}, nil
+	}
+	if inventory.IsTwoFARequired(u) {
+		enrolled, err := dep.UserClient().TwoFAEnrolled(c, u)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to check 2FA enrollment", err)
+		}
+
+		res.TwoFAEnrollRequired = !enrolled
+	}
+	res.PasswordExpired = passwordpolicy.Expired(dep.SettingProvider().PasswordPolicy(c), u)
+
+	audit.Record(c, audit.ActionLogin)
+	return res, nil
 }
 
 // RefreshTokenParameterCtx define key fore RefreshTokenService
@@ -208,8 +356,14 @@ func (s *RefreshTokenService) Delete(c *gin.Context) (string, error) {
 
 	// Block root token
 	if claims.RootTokenID != nil {
-		tokenSettings := dep.SettingProvider().TokenAuth(c)
-		dep.KV().Set(fmt.Sprintf("%s%s", auth.RevokeTokenPrefix, claims.RootTokenID.String()), true, int(tokenSettings.RefreshTokenTTL.Seconds()+10))
+		uid, err := dep.HashIDEncoder().Decode(claims.Subject, hashid.UserID)
+		if err != nil {
+			return "", serializer.NewError(serializer.CodeCredentialInvalid, "Invalid token subject", err)
+		}
+
+		if err := dep.TokenAuth().Revoke(c, uid, claims.RootTokenID.String()); err != nil {
+			return "", serializer.NewError(serializer.CodeDBError, "Failed to revoke session", err)
+		}
 	}
 
 	return "", nil
@@ -218,8 +372,11 @@ func (s *RefreshTokenService) Delete(c *gin.Context) (string, error) {
 type (
 	OtpValidationParameterCtx struct{}
 	OtpValidationService      struct {
-		OTP       string `json:"otp" binding:"required"`
+		OTP       string `json:"otp" binding:"required_without=PasskeyResponse"`
 		SessionID string `json:"session_id" binding:"required"`
+		// PasskeyResponse 与 PasskeySessionID 用于仅启用了通行密钥的用户完成第二步验证
+		PasskeyResponse  string `json:"passkey_response"`
+		PasskeySessionID string `json:"passkey_session_id" binding:"required_with=PasskeyResponse"`
 	}
 )
 
@@ -240,11 +397,19 @@ func (service *OtpValidationService) Verify2FA(c *gin.Context) (*ent.User, error
 		return nil, serializer.NewError(serializer.CodeNotFound, "User not found", err)
 	}
 
-	if expectedUser.TwoFactorSecret != "" {
-		if !totp.Validate(service.OTP, expectedUser.TwoFactorSecret) {
-			err := serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
-			return nil, err
-		}
+	// 必须通过 TOTP 或该用户自己的通行密钥之一完成验证
+	verified := false
+	if service.OTP != "" {
+		verified = expectedUser.TwoFactorSecret != "" && totp.Validate(service.OTP, expectedUser.TwoFactorSecret)
+	} else {
+		passkeyService := &FinishPasskeyLoginService{Response: service.PasskeyResponse, SessionID: service.PasskeySessionID}
+		passkeyUser, err := passkeyService.FinishPasskeyLogin(c)
+		verified = err == nil && passkeyUser.ID == uid
+	}
+
+	if !verified {
+		audit.Record(c, audit.ActionLoginFailed, audit.WithUser(uid), audit.WithProp("reason", "2fa"))
+		return nil, serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
 	}
 
 	kv.Delete("user_2fa_", service.SessionID)
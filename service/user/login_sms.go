@@ -2,12 +2,12 @@ package user
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
@@ -25,7 +25,7 @@ type SMSLoginService struct {
 }
 
 // Login 手机号+验证码登录
-func (service *SMSLoginService) Login(c *gin.Context) (*ent.User, error) {
+func (service *SMSLoginService) Login(c *gin.Context) (*ent.User, string, error) {
 	dep := dependency.FromContext(c)
 	logger := logging.FromContext(c)
 	userClient := dep.UserClient()
@@ -33,31 +33,37 @@ func (service *SMSLoginService) Login(c *gin.Context) (*ent.User, error) {
 	// 规范化并验证手机号格式
 	normalizedPhone := util.NormalizePhone(service.Phone)
 	if !util.ValidatePhone(normalizedPhone) {
-		return nil, serializer.NewError(serializer.CodeParamErr, "手机号格式不正确", nil)
+		return nil, "", serializer.NewError(serializer.CodeParamErr, "手机号格式不正确", nil)
 	}
 
 	// 验证短信验证码
-	smsProvider := sms.GetSMSProvider(dep, logger)
-	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider)
-	if err := smsService.VerifyCode(c, normalizedPhone, service.Code); err != nil {
-		return nil, err
+	smsProvider := dep.SMSProvider(c)
+	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider, dep.SettingProvider().SMSLimit(c))
+	if err := smsService.VerifyCode(c, normalizedPhone, service.Code, sms.PurposeLogin); err != nil {
+		audit.Record(c, audit.ActionLoginFailed, audit.WithTarget(normalizedPhone), audit.WithProp("reason", "sms"))
+		return nil, "", err
 	}
 
 	// 查找用户
 	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
 	expectedUser, err := userClient.GetByPhone(ctx, normalizedPhone)
 	if err != nil {
-		return nil, serializer.NewError(serializer.CodeUserNotFound, "用户不存在", err)
+		return nil, "", serializer.NewError(serializer.CodeUserNotFound, "用户不存在", err)
 	}
 
 	// 检查用户状态
 	if expectedUser.Status == user.StatusManualBanned || expectedUser.Status == user.StatusSysBanned {
-		return nil, serializer.NewError(serializer.CodeUserBaned, "该账号已被封禁", nil)
+		return nil, "", serializer.NewError(serializer.CodeUserBaned, "该账号已被封禁", nil)
 	}
 	if expectedUser.Status == user.StatusInactive {
-		return nil, serializer.NewError(serializer.CodeUserNotActivated, "该账号未激活", nil)
+		return nil, "", serializer.NewError(serializer.CodeUserNotActivated, "该账号未激活", nil)
 	}
 
-	return expectedUser, nil
+	twoFaSession, err := newTwoFASession(c, expectedUser)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return expectedUser, twoFaSession, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
@@ -98,7 +99,7 @@ func (s *FinishPasskeyLoginService) FinishPasskeyLogin(c *gin.Context) (*ent.Use
 		return nil, serializer.NewError(serializer.CodeNotFound, "Session not found", nil)
 	}
 
-	_ = kv.Delete(authnSessionKey, s.Response)
+	_ = kv.Delete(authnSessionKey, s.SessionID)
 
 	webAuthn, err := dep.WebAuthn(c)
 	if err != nil {
@@ -247,6 +248,8 @@ func (s *FinishPasskeyRegisterService) FinishPasskeyRegister(c *gin.Context) (*P
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to add passkey", err)
 	}
 
+	audit.Record(c, audit.ActionPasskeyRegister, audit.WithTarget(passkey.Name))
+
 	res := BuildPasskey(passkey)
 	return &res, nil
 }
@@ -280,9 +283,16 @@ func (s *DeletePasskeyService) DeletePasskey(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeNotFound, "Passkey not found", nil)
 	}
 
+	// 用户组要求二步验证时，不能移除最后一种二步验证方式
+	if inventory.IsTwoFARequired(u) && u.TwoFactorSecret == "" && len(existingKeys) == 1 {
+		return serializer.NewError(serializer.CodeTwoFAEnrollRequired, "Your group requires two-factor authentication", nil)
+	}
+
 	if err := userClient.RemovePasskey(c, u.ID, s.ID); err != nil {
 		return serializer.NewError(serializer.CodeDBError, "Failed to delete passkey", err)
 	}
 
+	audit.Record(c, audit.ActionPasskeyDelete, audit.WithTarget(existing.Name))
+
 	return nil
 }
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// maxPersonalAccessTokens 每个用户可创建的个人访问令牌数量上限
+const maxPersonalAccessTokens = 20
+
+// PersonalAccessToken 个人访问令牌
+type PersonalAccessToken struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Hint       string     `json:"hint"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	// Token 令牌明文，仅在创建时返回
+	Token string `json:"token,omitempty"`
+}
+
+func BuildPersonalAccessToken(t *ent.PersonalAccessToken) PersonalAccessToken {
+	return PersonalAccessToken{
+		ID:         t.ID,
+		Name:       t.Name,
+		Hint:       t.TokenHint,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		LastUsedIP: t.LastUsedIP,
+	}
+}
+
+// ListPersonalAccessTokens 列出当前用户的个人访问令牌
+func ListPersonalAccessTokens(c *gin.Context) ([]PersonalAccessToken, error) {
+	dep := dependency.FromContext(c)
+	tokens, err := dep.PersonalAccessTokenClient().ListByUser(c, inventory.UserFromContext(c).ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list personal access tokens", err)
+	}
+
+	return lo.Map(tokens, func(item *ent.PersonalAccessToken, index int) PersonalAccessToken {
+		return BuildPersonalAccessToken(item)
+	}), nil
+}
+
+type (
+	// CreatePersonalAccessTokenService 创建个人访问令牌
+	CreatePersonalAccessTokenService struct {
+		Name   string   `json:"name" binding:"required,max=255"`
+		Scopes []string `json:"scopes" binding:"required,min=1"`
+		// ExpiresIn 有效天数，为 0 时永不过期
+		ExpiresIn int `json:"expires_in" binding:"min=0,max=3650"`
+	}
+	CreatePersonalAccessTokenParamCtx struct{}
+)
+
+func (s *CreatePersonalAccessTokenService) Create(c *gin.Context) (*PersonalAccessToken, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	patClient := dep.PersonalAccessTokenClient()
+
+	scopes := lo.Uniq(s.Scopes)
+	for _, scope := range scopes {
+		if !auth.IsValidScope(scope) {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown scope: "+scope, nil)
+		}
+	}
+
+	if lo.Contains(scopes, string(types.TokenScopeAdminRead)) && !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Only administrators can grant admin scope", nil)
+	}
+
+	existing, err := patClient.ListByUser(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list personal access tokens", err)
+	}
+
+	if len(existing) >= maxPersonalAccessTokens {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many personal access tokens", nil)
+	}
+
+	token, hash, err := auth.NewPersonalAccessToken()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate token", err)
+	}
+
+	args := &inventory.PersonalAccessTokenArgs{
+		UserID:    u.ID,
+		Name:      s.Name,
+		TokenHash: hash,
+		TokenHint: auth.PersonalAccessTokenHint(token),
+		Scopes:    scopes,
+	}
+	if s.ExpiresIn > 0 {
+		args.ExpiresAt = lo.ToPtr(time.Now().AddDate(0, 0, s.ExpiresIn))
+	}
+
+	pat, err := patClient.Create(c, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create personal access token", err)
+	}
+
+	res := BuildPersonalAccessToken(pat)
+	res.Token = token
+	return &res, nil
+}
+
+type (
+	// RevokePersonalAccessTokenService 撤销个人访问令牌
+	RevokePersonalAccessTokenService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	RevokePersonalAccessTokenParamCtx struct{}
+)
+
+func (s *RevokePersonalAccessTokenService) Revoke(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.PersonalAccessTokenClient().Delete(c, inventory.UserFromContext(c).ID, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke personal access token", err)
+	}
+
+	return nil
+}
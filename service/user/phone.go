@@ -0,0 +1,133 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sms"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// UserResetSMSService 通过手机验证码重设密码服务
+	UserResetSMSService struct {
+		Phone    string `form:"phone" json:"phone" binding:"required"`
+		Code     string `form:"code" json:"code" binding:"required"`
+		Password string `form:"password" json:"password" binding:"required,max=128"`
+	}
+	UserResetSMSParameterCtx struct{}
+)
+
+// Reset 验证手机验证码后重设密码，已签发的刷新令牌随密码变更失效
+func (service *UserResetSMSService) Reset(c *gin.Context) (*User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+
+	normalizedPhone := util.NormalizePhone(service.Phone)
+	if !util.ValidatePhone(normalizedPhone) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "手机号格式不正确", nil)
+	}
+
+	// 在消耗验证码前校验密码策略，是否重复使用历史密码需在确定用户后校验
+	checker := passwordpolicy.NewChecker(dep.SettingProvider().PasswordPolicy(c))
+	if err := checker.Check(service.Password, nil); err != nil {
+		return nil, err
+	}
+
+	if err := newSMSService(c).VerifyCode(c, normalizedPhone, service.Code, sms.PurposeReset); err != nil {
+		return nil, err
+	}
+
+	u, err := userClient.GetByPhone(c, normalizedPhone)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "用户不存在", err)
+	}
+
+	if u.Status == user.StatusManualBanned || u.Status == user.StatusSysBanned {
+		return nil, serializer.NewError(serializer.CodeUserBaned, "该账号已被封禁", nil)
+	}
+
+	if u.Status == user.StatusInactive {
+		return nil, serializer.NewError(serializer.CodeUserNotActivated, "该账号未激活", nil)
+	}
+
+	if err := checker.Check(service.Password, u); err != nil {
+		return nil, err
+	}
+
+	u, err = userClient.UpdatePassword(c, u, service.Password)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update password", err)
+	}
+
+	userRes := BuildUser(u, dep.HashIDEncoder())
+	return &userRes, nil
+}
+
+type (
+	// ChangePhoneService 更换绑定手机号服务
+	ChangePhoneService struct {
+		// OldCode 原手机号收到的验证码，未绑定手机号时无需填写
+		OldCode string `json:"old_code"`
+		Phone   string `json:"phone" binding:"required"`
+		Code    string `json:"code" binding:"required"`
+	}
+	ChangePhoneParamsCtx struct{}
+)
+
+// Change 同时验证原手机号与新手机号后更换绑定，已签发的刷新令牌随之失效
+func (s *ChangePhoneService) Change(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	normalizedPhone := util.NormalizePhone(s.Phone)
+	if !util.ValidatePhone(normalizedPhone) {
+		return serializer.NewError(serializer.CodeParamErr, "手机号格式不正确", nil)
+	}
+
+	if normalizedPhone == u.Phone {
+		return serializer.NewError(serializer.CodeParamErr, "新手机号与当前绑定的手机号相同", nil)
+	}
+
+	// 两个验证码均通过后才使其失效，避免一个验证码错误时另一个被白白消耗
+	smsService := newSMSService(c)
+	if u.Phone != "" {
+		if s.OldCode == "" {
+			return serializer.NewError(serializer.CodeParamErr, "请填写原手机号收到的验证码", nil)
+		}
+
+		if err := smsService.CheckCode(c, u.Phone, s.OldCode, sms.PurposeUnbind); err != nil {
+			return err
+		}
+	}
+
+	if err := smsService.CheckCode(c, normalizedPhone, s.Code, sms.PurposeBind); err != nil {
+		return err
+	}
+
+	if u.Phone != "" {
+		smsService.ConsumeCode(u.Phone, sms.PurposeUnbind)
+	}
+	smsService.ConsumeCode(normalizedPhone, sms.PurposeBind)
+
+	if _, err := dep.UserClient().UpdatePhone(c, u, normalizedPhone); err != nil {
+		if errors.Is(err, inventory.ErrUserPhoneExisted) {
+			return serializer.NewError(serializer.CodeEmailExisted, "手机号已被其他账号绑定", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to update phone", err)
+	}
+
+	return nil
+}
+
+func newSMSService(ctx context.Context) *sms.SMSService {
+	dep := dependency.FromContext(ctx)
+	return sms.NewSMSService(dep.KV(), logging.FromContext(ctx), dep.SMSProvider(ctx), dep.SettingProvider().SMSLimit(ctx))
+}
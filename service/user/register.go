@@ -4,9 +4,9 @@ import (
 	"errors"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
-	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -22,11 +22,11 @@ type RegisterParameterCtx struct{}
 // UserRegisterService 管理用户注册的服务（仅支持手机号注册）
 type UserRegisterService struct {
 	// 手机号注册
-	Phone        string `form:"phone" json:"phone" binding:"required"`                  // 手机号
-	Code         string `form:"code" json:"code" binding:"required"`                  // 验证码
-	Password     string `form:"password" json:"password" binding:"required,min=6,max=128"`          // 密码
-	University   string `form:"university" json:"university" binding:"required"`      // 院校（必填）
-	Major        string `form:"major" json:"major" binding:"required"`                // 专业（必填）
+	Phone      string `form:"phone" json:"phone" binding:"required"`               // 手机号
+	Code       string `form:"code" json:"code" binding:"required"`                 // 验证码
+	Password   string `form:"password" json:"password" binding:"required,max=128"` // 密码
+	University string `form:"university" json:"university" binding:"required"`     // 院校（必填）
+	Major      string `form:"major" json:"major" binding:"required"`               // 专业（必填）
 }
 
 // Register 新用户注册（仅支持手机号注册）
@@ -55,8 +55,8 @@ func (service *UserRegisterService) registerWithPhone(c *gin.Context, dep depend
 	if service.Password == "" {
 		return serializer.ErrWithDetails(c, serializer.CodeParamErr, "密码不能为空", nil)
 	}
-	if len(service.Password) < 6 || len(service.Password) > 128 {
-		return serializer.ErrWithDetails(c, serializer.CodeParamErr, "密码长度必须在6-128位之间", nil)
+	if len(service.Password) > 128 {
+		return serializer.ErrWithDetails(c, serializer.CodeParamErr, "密码长度不能超过128位", nil)
 	}
 	if service.University == "" {
 		return serializer.ErrWithDetails(c, serializer.CodeParamErr, "院校不能为空", nil)
@@ -71,20 +71,49 @@ func (service *UserRegisterService) registerWithPhone(c *gin.Context, dep depend
 		return serializer.ErrWithDetails(c, serializer.CodeParamErr, "手机号格式不正确", nil)
 	}
 
+	// 在消耗验证码前校验密码策略
+	if err := passwordpolicy.NewChecker(settings.PasswordPolicy(c)).Check(service.Password, nil); err != nil {
+		return serializer.Err(c, err)
+	}
+
 	// 验证短信验证码
-	smsProvider := sms.GetSMSProvider(dep, logger)
-	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider)
-	if err := smsService.VerifyCode(c, normalizedPhone, service.Code); err != nil {
+	smsProvider := dep.SMSProvider(c)
+	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider, settings.SMSLimit(c))
+	if err := smsService.VerifyCode(c, normalizedPhone, service.Code, sms.PurposeRegister); err != nil {
 		return serializer.Err(c, err)
 	}
 
+	// 院校与专业映射为目录中的规范名称
+	university, major, err := dep.InstitutionClient().Canonicalize(c, service.University, service.Major, settings.InstitutionDirectoryEnforced(c))
+	if err != nil {
+		if errors.Is(err, inventory.ErrInstitutionNotFound) {
+			return serializer.ErrWithDetails(c, serializer.CodeNotInDirectory, "院校不在目录中", err)
+		}
+		if errors.Is(err, inventory.ErrMajorNotFound) {
+			return serializer.ErrWithDetails(c, serializer.CodeNotInDirectory, "专业不在该院校目录中", err)
+		}
+		return serializer.DBErr(c, "Failed to query institution directory", err)
+	}
+
 	args := &inventory.NewUserArgs{
 		Phone:         normalizedPhone,
 		PlainPassword: service.Password,
 		Status:        user.StatusActive, // 手机号注册直接激活
 		GroupID:       settings.DefaultGroup(c),
-		University:    service.University,
-		Major:        service.Major,
+		University:    university,
+		Major:         major,
+	}
+
+	// 按院校、专业映射分配用户组与额外容量
+	mapping, err := dep.InstitutionClient().Mapping(c, university, major)
+	if err != nil {
+		return serializer.DBErr(c, "Failed to query institution directory", err)
+	}
+	if mapping != nil {
+		if mapping.GroupID != 0 {
+			args.GroupID = mapping.GroupID
+		}
+		args.ExtraStorage = mapping.ExtraStorage
 	}
 
 	userClient := dep.UserClient()
@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/coursemember"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
@@ -95,6 +97,10 @@ func BuildNode(node *ent.Node, idEncoder hashid.Encoder) *Node {
 type BuiltinLoginResponse struct {
 	User  User       `json:"user"`
 	Token auth.Token `json:"token"`
+	// TwoFAEnrollRequired 用户组要求二步验证但用户尚未启用，需先完成启用
+	TwoFAEnrollRequired bool `json:"two_fa_enroll_required,omitempty"`
+	// PasswordExpired 密码已超过最长使用期限，需先修改密码
+	PasswordExpired bool `json:"password_expired,omitempty"`
 }
 
 // User 用户序列化器
@@ -102,6 +108,8 @@ type User struct {
 	ID                  string                         `json:"id"`
 	Email               string                         `json:"email,omitempty"`
 	Nickname            string                         `json:"nickname"`
+	StudentID           string                         `json:"student_id,omitempty"`
+	University          string                         `json:"university,omitempty"`
 	Status              user.Status                    `json:"status,omitempty"`
 	Avatar              string                         `json:"avatar,omitempty"`
 	CreatedAt           time.Time                      `json:"created_at"`
@@ -159,6 +167,8 @@ func BuildUser(user *ent.User, idEncoder hashid.Encoder) User {
 		ID:                  hashid.EncodeUserID(idEncoder, user.ID),
 		Email:               user.Email,
 		Nickname:            user.Nick,
+		StudentID:           user.StudentID,
+		University:          user.University,
 		Status:              user.Status,
 		Avatar:              user.Avatar,
 		CreatedAt:           user.CreatedAt,
@@ -210,6 +220,8 @@ func BuildUserRedacted(u *ent.User, level int, idEncoder hashid.Encoder) User {
 
 	if level == RedactLevelUser {
 		user.Email = userRaw.Email
+		user.StudentID = userRaw.StudentID
+		user.University = userRaw.University
 	}
 
 	return user
@@ -226,3 +238,26 @@ func RedactedGroup(g *Group) *Group {
 		Name: g.Name,
 	}
 }
+
+type Course struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Code        string    `json:"code"`
+	Description string    `json:"description,omitempty"`
+	Role        string    `json:"role"`
+	Uri         string    `json:"uri"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func BuildCourse(course *ent.Course, role coursemember.Role, hasher hashid.Encoder) Course {
+	id := hashid.EncodeCourseID(hasher, course.ID)
+	return Course{
+		ID:          id,
+		Name:        course.Name,
+		Code:        course.Code,
+		Description: course.Description,
+		Role:        string(role),
+		Uri:         fs.NewCourseUri(id),
+		CreatedAt:   course.CreatedAt,
+	}
+}
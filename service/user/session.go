@@ -0,0 +1,96 @@
+package user
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// LoginSession 已登录的设备会话
+type LoginSession struct {
+	ID         int       `json:"id"`
+	OS         string    `json:"os"`
+	Browser    string    `json:"browser"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	// Current 是否为发起请求的会话
+	Current bool `json:"current"`
+}
+
+// ListLoginSessions 列出当前用户的有效登录会话
+func ListLoginSessions(c *gin.Context) ([]LoginSession, error) {
+	dep := dependency.FromContext(c)
+	sessions, err := dep.LoginSessionClient().ListActiveByUser(c, inventory.UserFromContext(c).ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list login sessions", err)
+	}
+
+	current, _ := c.Value(auth.TokenIDContextKey{}).(string)
+	parser := dep.UAParser()
+	return lo.Map(sessions, func(item *ent.LoginSession, index int) LoginSession {
+		client := parser.Parse(item.UserAgent)
+		return LoginSession{
+			ID:         item.ID,
+			OS:         client.Os.ToString(),
+			Browser:    client.UserAgent.ToString(),
+			Device:     client.Device.Family,
+			IP:         item.IP,
+			CreatedAt:  item.CreatedAt,
+			LastUsedAt: item.LastUsedAt,
+			Current:    item.RootTokenID == current,
+		}
+	}), nil
+}
+
+type (
+	// RevokeLoginSessionService 撤销登录会话
+	RevokeLoginSessionService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	RevokeLoginSessionParamCtx struct{}
+)
+
+// Revoke 撤销当前用户的指定会话
+func (s *RevokeLoginSessionService) Revoke(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	sessions, err := dep.LoginSessionClient().ListActiveByUser(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list login sessions", err)
+	}
+
+	session, found := lo.Find(sessions, func(item *ent.LoginSession) bool {
+		return item.ID == s.ID
+	})
+	if !found {
+		return serializer.NewError(serializer.CodeNotFound, "Session not found", nil)
+	}
+
+	if err := dep.TokenAuth().Revoke(c, u.ID, session.RootTokenID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke session", err)
+	}
+
+	return nil
+}
+
+// RevokeOtherLoginSessions 撤销当前用户除当前会话外的所有会话
+func RevokeOtherLoginSessions(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	current, _ := c.Value(auth.TokenIDContextKey{}).(string)
+	if err := dep.TokenAuth().RevokeAll(c, u, current); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke sessions", err)
+	}
+
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -218,7 +220,7 @@ type (
 		VersionRetentionExt     *[]string `json:"version_retention_ext" binding:"omitempty"`
 		VersionRetentionMax     *int      `json:"version_retention_max" binding:"omitempty,min=0"`
 		CurrentPassword         *string   `json:"current_password" binding:"omitempty,min=4,max=128"`
-		NewPassword             *string   `json:"new_password" binding:"omitempty,min=6,max=128"`
+		NewPassword             *string   `json:"new_password" binding:"omitempty,max=128"`
 		TwoFAEnabled            *bool     `json:"two_fa_enabled" binding:"omitempty"`
 		TwoFACode               *string   `json:"two_fa_code" binding:"omitempty"`
 		DisableViewSync         *bool     `json:"disable_view_sync" binding:"omitempty"`
@@ -279,6 +281,10 @@ func (s *PatchUserSetting) Patch(c *gin.Context) error {
 			return serializer.NewError(serializer.CodeIncorrectPassword, "Incorrect password", err)
 		}
 
+		if err := passwordpolicy.NewChecker(dep.SettingProvider().PasswordPolicy(c)).Check(*s.NewPassword, u); err != nil {
+			return err
+		}
+
 		if _, err := userClient.UpdatePassword(c, u, *s.NewPassword); err != nil {
 			return serializer.NewError(serializer.CodeDBError, "Failed to update user password", err)
 		}
@@ -299,15 +305,29 @@ func (s *PatchUserSetting) Patch(c *gin.Context) error {
 			if _, err := userClient.UpdateTwoFASecret(c, u, secret.(string)); err != nil {
 				return serializer.NewError(serializer.CodeDBError, "Failed to update user 2FA", err)
 			}
+			audit.Record(c, audit.ActionTwoFAEnable)
 
 		} else {
 			if !totp.Validate(*s.TwoFACode, u.TwoFactorSecret) {
 				return serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
 			}
 
+			// 用户组要求二步验证时，未注册通行密钥的用户不能关闭 TOTP
+			if inventory.IsTwoFARequired(u) {
+				passkeys, err := userClient.ListPasskeys(c, u.ID)
+				if err != nil {
+					return serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+				}
+
+				if len(passkeys) == 0 {
+					return serializer.NewError(serializer.CodeTwoFAEnrollRequired, "Your group requires two-factor authentication", nil)
+				}
+			}
+
 			if _, err := userClient.UpdateTwoFASecret(c, u, ""); err != nil {
 				return serializer.NewError(serializer.CodeDBError, "Failed to update user 2FA", err)
 			}
+			audit.Record(c, audit.ActionTwoFADisable)
 
 		}
 	}
@@ -14,7 +14,8 @@ type SendSMSCodeParameterCtx struct{}
 
 // SendSMSCodeService 发送短信验证码服务
 type SendSMSCodeService struct {
-	Phone string `form:"phone" json:"phone" binding:"required"`
+	Phone   string `form:"phone" json:"phone" binding:"required"`
+	Purpose string `form:"purpose" json:"purpose" binding:"required,oneof=register login reset bind unbind"`
 }
 
 // SendCode 发送验证码
@@ -29,13 +30,13 @@ func (service *SendSMSCodeService) SendCode(c *gin.Context) serializer.Response
 	}
 
 	// 获取短信服务提供商
-	smsProvider := sms.GetSMSProvider(dep, logger)
+	smsProvider := dep.SMSProvider(c)
 
 	// 创建短信服务
-	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider)
+	smsService := sms.NewSMSService(dep.KV(), logger, smsProvider, dep.SettingProvider().SMSLimit(c))
 
 	// 发送验证码
-	if err := smsService.SendCode(c, normalizedPhone); err != nil {
+	if err := smsService.SendCode(c, normalizedPhone, c.ClientIP(), sms.Purpose(service.Purpose)); err != nil {
 		return serializer.Err(c, err)
 	}
 
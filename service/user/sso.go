@@ -0,0 +1,392 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/sso"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	ssoSessionKey = "sso_session_"
+	ssoSessionTTL = 600
+	// ssoStateSessionKey 发起外部登录的浏览器会话中记录的 state，回调时校验，防止登录 CSRF
+	ssoStateSessionKey = "sso_state"
+)
+
+type (
+	SSOProviderService struct {
+		ID string `uri:"id" binding:"required"`
+	}
+	SSOProviderParamCtx struct{}
+	PrepareSSOResponse  struct {
+		URL string `json:"url"`
+	}
+)
+
+// Prepare 创建外部登录会话，返回身份提供方登录地址
+func (s *SSOProviderService) Prepare(c *gin.Context) (*PrepareSSOResponse, error) {
+	return prepareSSO(c, s.ID, 0)
+}
+
+// PrepareLink 创建关联会话，完成外部登录后将外部账号关联到当前用户
+func (s *SSOProviderService) PrepareLink(c *gin.Context) (*PrepareSSOResponse, error) {
+	return prepareSSO(c, s.ID, inventory.UserFromContext(c).ID)
+}
+
+func prepareSSO(c *gin.Context, providerID string, uid int) (*PrepareSSOResponse, error) {
+	dep := dependency.FromContext(c)
+	config, provider, err := ssoProvider(c, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := sso.NewSession(config.ID, uid)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to create SSO session", err)
+	}
+
+	loginURL, err := provider.LoginURL(c, ssoCallback(c, config.ID), session)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get login URL of identity provider", err)
+	}
+
+	if err := dep.KV().Set(ssoSessionKey+session.State, *session, ssoSessionTTL); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to store SSO session", err)
+	}
+
+	util.SetSession(c, map[string]interface{}{ssoStateSessionKey: session.State})
+	return &PrepareSSOResponse{URL: loginURL}, nil
+}
+
+type (
+	// CASLoginService 使用 CAS 票据登录
+	CASLoginService struct {
+		State  string `json:"state" binding:"required"`
+		Ticket string `json:"ticket" binding:"required"`
+	}
+	CASLoginParameterCtx struct{}
+)
+
+// Login 校验 CAS 票据并返回对应用户
+func (s *CASLoginService) Login(c *gin.Context) (*ent.User, string, error) {
+	return ssoLogin(c, setting.SSOProviderCAS, s.State, url.Values{"ticket": {s.Ticket}})
+}
+
+type (
+	// OIDCLoginService 使用 OpenID Connect 授权码登录
+	OIDCLoginService struct {
+		State string `json:"state" binding:"required"`
+		Code  string `json:"code" binding:"required"`
+	}
+	OIDCLoginParameterCtx struct{}
+)
+
+// Login 使用授权码换取 ID Token 并返回对应用户
+func (s *OIDCLoginService) Login(c *gin.Context) (*ent.User, string, error) {
+	return ssoLogin(c, setting.SSOProviderOIDC, s.State, url.Values{"code": {s.Code}})
+}
+
+func ssoLogin(c *gin.Context, providerType setting.SSOProviderType, state string, params url.Values) (*ent.User, string, error) {
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+
+	// 只有发起登录的浏览器可以完成回调
+	bound, _ := util.GetSession(c, ssoStateSessionKey).(string)
+	if bound == "" || subtle.ConstantTimeCompare([]byte(bound), []byte(state)) != 1 {
+		return nil, "", serializer.NewError(serializer.CodeNotFound, "SSO session not found or expired", nil)
+	}
+	util.DeleteSession(c, ssoStateSessionKey)
+
+	sessionRaw, ok := kv.Get(ssoSessionKey + state)
+	if !ok {
+		return nil, "", serializer.NewError(serializer.CodeNotFound, "SSO session not found or expired", nil)
+	}
+
+	// 会话仅可使用一次
+	_ = kv.Delete(ssoSessionKey, state)
+	session := sessionRaw.(sso.Session)
+
+	// 关联会话必须由发起关联的用户完成
+	if session.UserID != 0 {
+		if current := inventory.UserFromContext(c); current == nil || current.ID != session.UserID {
+			return nil, "", serializer.NewError(serializer.CodeCheckLogin, "Sign in as the user who started linking", nil)
+		}
+	}
+
+	config, provider, err := ssoProvider(c, session.ProviderID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if config.Type != providerType {
+		return nil, "", serializer.NewError(serializer.CodeParamErr, "Identity provider type mismatch", nil)
+	}
+
+	identity, err := provider.Authenticate(c, ssoCallback(c, config.ID), &session, params)
+	if err != nil {
+		if errors.Is(err, sso.ErrAuthFailed) {
+			return nil, "", serializer.NewError(serializer.CodeCredentialInvalid, "External authentication failed", err)
+		}
+		return nil, "", serializer.NewError(serializer.CodeInternalSetting, "Failed to authenticate with identity provider", err)
+	}
+
+	u, err := resolveSSOUser(c, config, identity, session.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if u.Status == user.StatusManualBanned || u.Status == user.StatusSysBanned {
+		return nil, "", serializer.NewError(serializer.CodeUserBaned, "This user is banned", nil)
+	}
+
+	if u.Status == user.StatusInactive {
+		return nil, "", serializer.NewError(serializer.CodeUserNotActivated, "This user is not activated", nil)
+	}
+
+	twoFaSession, err := newTwoFASession(c, u)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return u, twoFaSession, nil
+}
+
+// resolveSSOUser 查找外部账号关联的用户；未关联时按设置关联当前用户、按学号关联已有用户或自动创建用户
+func resolveSSOUser(c *gin.Context, config *setting.SSOProvider, identity *sso.Identity, uid int) (*ent.User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	identityClient := dep.ExternalIdentityClient()
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+
+	linked, err := identityClient.GetBySubject(c, config.ID, identity.Subject)
+	if err == nil {
+		if uid != 0 && linked.UserID != uid {
+			return nil, serializer.NewError(serializer.CodeSSOIdentityLinked, "External account is linked to another user", nil)
+		}
+
+		u, err := userClient.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+
+		return u, nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to query linked identity", err)
+	}
+
+	if uid != 0 {
+		u, err := userClient.GetByID(ctx, uid)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+
+		return u, linkExternalIdentity(c, identityClient, u, config.ID, identity.Subject)
+	}
+
+	profile := sso.MapProfile(config, identity)
+	university, major, err := canonicalizeProfile(c, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.LinkByStudentID && profile.StudentID != "" && university != "" {
+		u, err := userClient.GetByStudentID(ctx, university, profile.StudentID)
+		if err == nil {
+			return u, linkExternalIdentity(c, identityClient, u, config.ID, identity.Subject)
+		}
+
+		if !ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to query user", err)
+		}
+	}
+
+	if !config.AutoProvision {
+		return nil, serializer.NewError(serializer.CodeSSONotLinked, "External account is not linked to any user", nil)
+	}
+
+	return provisionUser(c, config.ID, identity.Subject, profile, university, major)
+}
+
+// canonicalizeProfile 将外部账号资料中的院校、专业转换为目录中的标准名称
+func canonicalizeProfile(c *gin.Context, profile *sso.Profile) (string, string, error) {
+	dep := dependency.FromContext(c)
+	university, major, err := dep.InstitutionClient().Canonicalize(c, profile.University, profile.Major, dep.SettingProvider().InstitutionDirectoryEnforced(c))
+	if err != nil {
+		if errors.Is(err, inventory.ErrInstitutionNotFound) || errors.Is(err, inventory.ErrMajorNotFound) {
+			return "", "", serializer.NewError(serializer.CodeNotInDirectory, "University or major released by identity provider is not in directory", err)
+		}
+		return "", "", serializer.NewError(serializer.CodeDBError, "Failed to query institution directory", err)
+	}
+
+	return university, major, nil
+}
+
+// provisionUser 按外部账号资料创建用户，并在同一事务中关联外部账号
+func provisionUser(c *gin.Context, provider, subject string, profile *sso.Profile, university, major string) (*ent.User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	settings := dep.SettingProvider()
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+
+	args := &inventory.NewUserArgs{
+		Email:      profile.Email,
+		Nick:       lo.Ternary(profile.Nick != "", profile.Nick, lo.Ternary(profile.StudentID != "", profile.StudentID, subject)),
+		Status:     user.StatusActive,
+		GroupID:    settings.DefaultGroup(c),
+		University: university,
+		Major:      major,
+		StudentID:  profile.StudentID,
+	}
+
+	// 按院校、专业映射分配用户组与额外容量，身份提供方映射的用户组优先
+	mapping, err := dep.InstitutionClient().Mapping(c, university, major)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to query institution directory", err)
+	}
+	if mapping != nil {
+		if mapping.GroupID != 0 {
+			args.GroupID = mapping.GroupID
+		}
+		args.ExtraStorage = mapping.ExtraStorage
+	}
+	if profile.GroupID != 0 {
+		args.GroupID = profile.GroupID
+	}
+
+	uc, tx, txCtx, err := inventory.WithTx(ctx, userClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	u, err := uc.Create(txCtx, args)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		if errors.Is(err, inventory.ErrUserEmailExisted) || errors.Is(err, inventory.ErrInactiveUserExisted) {
+			return nil, serializer.NewError(serializer.CodeEmailExisted, "Email is used by another user, sign in and link the external account instead", err)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, serializer.NewError(serializer.CodeStudentIDExisted, "Student ID already in use", err)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create user", err)
+	}
+
+	ic, _ := inventory.InheritTx(txCtx, dep.ExternalIdentityClient())
+	if err := linkExternalIdentity(txCtx, ic, u, provider, subject); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	logging.FromContext(c).Info("User %d provisioned from identity provider %q.", u.ID, provider)
+	return userClient.GetByID(ctx, u.ID)
+}
+
+func linkExternalIdentity(ctx context.Context, identityClient inventory.ExternalIdentityClient, u *ent.User, provider, subject string) error {
+	if _, err := identityClient.Link(ctx, u.ID, provider, subject); err != nil {
+		if ent.IsConstraintError(err) {
+			return serializer.NewError(serializer.CodeSSOIdentityLinked, "User is already linked to another account of this identity provider", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to link external account", err)
+	}
+
+	return nil
+}
+
+// SSOIdentity 已关联的外部账号
+type SSOIdentity struct {
+	Provider     string    `json:"provider"`
+	ProviderName string    `json:"provider_name"`
+	Subject      string    `json:"subject"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ListSSOIdentities 列出当前用户已关联的外部账号
+func ListSSOIdentities(c *gin.Context) ([]SSOIdentity, error) {
+	dep := dependency.FromContext(c)
+	identities, err := dep.ExternalIdentityClient().ListByUser(c, inventory.UserFromContext(c).ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list linked identities", err)
+	}
+
+	providers := lo.SliceToMap(dep.SettingProvider().SSOProviders(c), func(p setting.SSOProvider) (string, string) {
+		return p.ID, p.Name
+	})
+	return lo.Map(identities, func(item *ent.ExternalIdentity, index int) SSOIdentity {
+		return SSOIdentity{
+			Provider:     item.Provider,
+			ProviderName: providers[item.Provider],
+			Subject:      item.Subject,
+			CreatedAt:    item.CreatedAt,
+		}
+	}), nil
+}
+
+// Unlink 解除当前用户与外部账号的关联
+func (s *SSOProviderService) Unlink(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	identityClient := dep.ExternalIdentityClient()
+
+	identities, err := identityClient.ListByUser(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list linked identities", err)
+	}
+
+	if !lo.ContainsBy(identities, func(item *ent.ExternalIdentity) bool { return item.Provider == s.ID }) {
+		return serializer.NewError(serializer.CodeNotFound, "Linked identity not found", nil)
+	}
+
+	// 仅能通过外部账号登录的用户不能解除最后一个关联
+	if u.Password == "" && u.Phone == "" && len(identities) == 1 {
+		return serializer.NewError(serializer.CodeParamErr, "Set a password or bind a phone number before unlinking the last external account", nil)
+	}
+
+	if err := identityClient.Unlink(c, u.ID, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to unlink external account", err)
+	}
+
+	return nil
+}
+
+// ssoProvider 返回已启用的身份提供方
+func ssoProvider(c *gin.Context, providerID string) (*setting.SSOProvider, sso.Provider, error) {
+	dep := dependency.FromContext(c)
+	config, found := lo.Find(dep.SettingProvider().SSOProviders(c), func(p setting.SSOProvider) bool {
+		return p.ID == providerID && p.Enabled
+	})
+	if !found {
+		return nil, nil, serializer.NewError(serializer.CodeNotFound, "Identity provider not found", nil)
+	}
+
+	provider, err := sso.NewProvider(&config, dep.RequestClient(request.WithLogger(logging.FromContext(c))))
+	if err != nil {
+		return nil, nil, serializer.NewError(serializer.CodeInternalSetting, "Invalid identity provider config", err)
+	}
+
+	return &config, provider, nil
+}
+
+func ssoCallback(c *gin.Context, providerID string) string {
+	return routes.MasterSSOCallbackUrl(dependency.FromContext(c).SettingProvider().SiteURL(c), providerID).String()
+}